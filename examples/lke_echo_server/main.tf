@@ -19,6 +19,7 @@ resource "linode_lke_cluster" "my-cluster" {
   region = var.region
 
   pool {
+    name = "default"
     type = "g6-standard-2"
     count = var.pool_count
   }
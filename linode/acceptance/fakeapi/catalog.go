@@ -0,0 +1,172 @@
+package fakeapi
+
+import (
+	"net/http"
+	"sort"
+)
+
+// defaultRegionCapabilities are the capabilities reported by every fake region.
+var defaultRegionCapabilities = []any{
+	"Linodes",
+	"Block Storage",
+	"Block Storage Encryption",
+	"Cloud Firewall",
+	"Disk Encryption",
+	"Kubernetes",
+	"Metadata",
+	"NodeBalancers",
+	"Placement Group",
+	"VPCs",
+	"Vlans",
+}
+
+func (s *Server) seedCatalog() {
+	s.regions = make(map[string]object)
+	for _, r := range []struct{ id, label, country string }{
+		{"us-east", "Newark, NJ", "us"},
+		{"us-ord", "Chicago, IL", "us"},
+		{"eu-west", "London, UK", "gb"},
+	} {
+		s.regions[r.id] = object{
+			"id":           r.id,
+			"label":        r.label,
+			"country":      r.country,
+			"capabilities": defaultRegionCapabilities,
+			"status":       "ok",
+			"site_type":    "core",
+			"resolvers": object{
+				"ipv4": "192.0.2.1,192.0.2.2",
+				"ipv6": "2001:db8::1,2001:db8::2",
+			},
+			"placement_group_limits": object{
+				"maximum_pgs_per_customer": 100,
+				"maximum_linodes_per_pg":   5,
+			},
+		}
+	}
+
+	s.types = make(map[string]object)
+	for _, t := range []struct {
+		id, label, class        string
+		disk, memory, vcpus     int
+		transfer, networkOut    int
+		hourlyPrice, monthPrice float64
+	}{
+		{"g6-nanode-1", "Nanode 1GB", "nanode", 25600, 1024, 1, 1000, 1000, 0.0075, 5},
+		{"g6-standard-1", "Linode 2GB", "standard", 51200, 2048, 1, 2000, 2000, 0.018, 12},
+		{"g6-standard-2", "Linode 4GB", "standard", 81920, 4096, 2, 4000, 4000, 0.036, 24},
+		{"g6-standard-4", "Linode 8GB", "standard", 163840, 8192, 4, 5000, 5000, 0.072, 48},
+	} {
+		s.types[t.id] = object{
+			"id":                  t.id,
+			"label":               t.label,
+			"class":               t.class,
+			"disk":                t.disk,
+			"memory":              t.memory,
+			"vcpus":               t.vcpus,
+			"gpus":                0,
+			"accelerated_devices": 0,
+			"transfer":            t.transfer,
+			"network_out":         t.networkOut,
+			"successor":           nil,
+			"price": object{
+				"hourly":  t.hourlyPrice,
+				"monthly": t.monthPrice,
+			},
+			"region_prices": []any{},
+			"addons": object{
+				"backups": object{
+					"price":         object{"hourly": 0.003, "monthly": 2},
+					"region_prices": []any{},
+				},
+			},
+		}
+	}
+
+	s.images = make(map[string]object)
+	for _, i := range []struct{ id, label, vendor, created string }{
+		{"linode/alpine3.19", "Alpine 3.19", "Alpine", "2024-01-29T00:00:00"},
+		{"linode/alpine3.20", "Alpine 3.20", "Alpine", "2024-05-22T00:00:00"},
+		{"linode/debian12", "Debian 12", "Debian", "2023-06-12T00:00:00"},
+		{"linode/ubuntu24.04", "Ubuntu 24.04 LTS", "Ubuntu", "2024-04-25T00:00:00"},
+	} {
+		s.images[i.id] = object{
+			"id":           i.id,
+			"label":        i.label,
+			"vendor":       i.vendor,
+			"description":  "",
+			"created":      i.created,
+			"updated":      i.created,
+			"created_by":   "linode",
+			"type":         "manual",
+			"status":       "available",
+			"is_public":    true,
+			"deprecated":   false,
+			"size":         1300,
+			"total_size":   1300,
+			"expiry":       nil,
+			"eol":          nil,
+			"capabilities": []any{"cloud-init"},
+			"tags":         []any{},
+			"regions":      []any{},
+		}
+	}
+
+	s.kernels = make(map[string]object)
+	for _, k := range []struct{ id, label string }{
+		{"linode/grub2", "GRUB 2"},
+		{"linode/direct-disk", "Direct Disk"},
+		{"linode/latest-64bit", "Latest 64 bit"},
+	} {
+		s.kernels[k.id] = object{
+			"id":           k.id,
+			"label":        k.label,
+			"version":      "",
+			"architecture": "x86_64",
+			"kvm":          true,
+			"xen":          false,
+			"pvops":        false,
+			"deprecated":   false,
+			"built":        "2018-01-01T00:00:00",
+		}
+	}
+}
+
+func (s *Server) registerCatalogRoutes() {
+	s.handle(http.MethodGet, "/regions", catalogList(&s.regions))
+	s.handle(http.MethodGet, "/regions/{id}", catalogGet(&s.regions))
+	s.handle(http.MethodGet, "/linode/types", catalogList(&s.types))
+	s.handle(http.MethodGet, "/linode/types/{id}", catalogGet(&s.types))
+	s.handle(http.MethodGet, "/images", catalogList(&s.images))
+	s.handle(http.MethodGet, "/images/{id...}", catalogGet(&s.images))
+	s.handle(http.MethodGet, "/linode/kernels", catalogList(&s.kernels))
+	s.handle(http.MethodGet, "/linode/kernels/{id...}", catalogGet(&s.kernels))
+}
+
+func catalogList(catalog *map[string]object) handlerFunc {
+	return func(r *http.Request) (any, error) {
+		ids := make([]string, 0, len(*catalog))
+		for id := range *catalog {
+			ids = append(ids, id)
+		}
+
+		sort.Strings(ids)
+
+		result := make([]object, len(ids))
+		for i, id := range ids {
+			result[i] = (*catalog)[id]
+		}
+
+		return result, nil
+	}
+}
+
+func catalogGet(catalog *map[string]object) handlerFunc {
+	return func(r *http.Request) (any, error) {
+		item, ok := (*catalog)[r.PathValue("id")]
+		if !ok {
+			return nil, errNotFound()
+		}
+		return item, nil
+	}
+}
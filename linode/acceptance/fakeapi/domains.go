@@ -0,0 +1,262 @@
+package fakeapi
+
+import (
+	"fmt"
+	"net/http"
+)
+
+func (s *Server) registerDomainRoutes() {
+	s.handle(http.MethodGet, "/domains", s.listDomains)
+	s.handle(http.MethodPost, "/domains", s.createDomain)
+	s.handle(http.MethodGet, "/domains/{id}", s.getDomain)
+	s.handle(http.MethodPut, "/domains/{id}", s.updateDomain)
+	s.handle(http.MethodDelete, "/domains/{id}", s.deleteDomain)
+	s.handle(http.MethodGet, "/domains/{id}/zone-file", s.getDomainZoneFile)
+
+	s.handle(http.MethodGet, "/domains/{id}/records", s.listDomainRecords)
+	s.handle(http.MethodPost, "/domains/{id}/records", s.createDomainRecord)
+	s.handle(http.MethodGet, "/domains/{id}/records/{recordID}", s.getDomainRecord)
+	s.handle(http.MethodPut, "/domains/{id}/records/{recordID}", s.updateDomainRecord)
+	s.handle(http.MethodDelete, "/domains/{id}/records/{recordID}", s.deleteDomainRecord)
+}
+
+var (
+	domainFields = []string{
+		"domain", "type", "group", "status", "description", "soa_email", "retry_sec",
+		"master_ips", "axfr_ips", "tags", "expire_sec", "refresh_sec", "ttl_sec",
+	}
+
+	domainRecordFields = []string{
+		"type", "name", "target", "priority", "weight", "port",
+		"service", "protocol", "ttl_sec", "tag",
+	}
+)
+
+func (s *Server) domainFromPath(r *http.Request) (object, error) {
+	id, err := pathInt(r, "id")
+	if err != nil {
+		return nil, err
+	}
+
+	return s.domains.get(0, id)
+}
+
+func (s *Server) listDomains(r *http.Request) (any, error) {
+	return s.domains.list(0), nil
+}
+
+func (s *Server) getDomain(r *http.Request) (any, error) {
+	return s.domainFromPath(r)
+}
+
+func (s *Server) createDomain(r *http.Request) (any, error) {
+	body, err := decodeBody(r)
+	if err != nil {
+		return nil, err
+	}
+
+	name := stringOr(body["domain"], "")
+	if name == "" {
+		return nil, errBadRequest("domain", "Domain is required.")
+	}
+
+	for _, d := range s.domains.list(0) {
+		if d["domain"] == name {
+			return nil, errBadRequest("domain", "Domain already exists.")
+		}
+	}
+
+	domainType := stringOr(body["type"], "master")
+	if domainType == "master" && stringOr(body["soa_email"], "") == "" {
+		return nil, errBadRequest("soa_email", "soa_email is required for master domains.")
+	}
+
+	domain := object{
+		"id":          s.newID(),
+		"domain":      name,
+		"type":        domainType,
+		"group":       "",
+		"status":      "active",
+		"description": "",
+		"soa_email":   "",
+		"retry_sec":   0,
+		"master_ips":  []any{},
+		"axfr_ips":    []any{},
+		"tags":        []any{},
+		"expire_sec":  0,
+		"refresh_sec": 0,
+		"ttl_sec":     0,
+	}
+
+	merge(domain, body, domainFields...)
+	normalizeNullLists(domain, "master_ips", "axfr_ips", "tags")
+
+	s.domains.insert(0, domain)
+	s.recordEvent("domain_create", entityRef("domain", domain), nil)
+
+	return domain, nil
+}
+
+// normalizeNullLists replaces null list values with empty lists
+// to match the API's response format.
+func normalizeNullLists(obj object, keys ...string) {
+	for _, k := range keys {
+		if obj[k] == nil {
+			obj[k] = []any{}
+		}
+	}
+}
+
+func (s *Server) updateDomain(r *http.Request) (any, error) {
+	domain, err := s.domainFromPath(r)
+	if err != nil {
+		return nil, err
+	}
+
+	body, err := decodeBody(r)
+	if err != nil {
+		return nil, err
+	}
+
+	merge(domain, body, domainFields...)
+	normalizeNullLists(domain, "master_ips", "axfr_ips", "tags")
+	s.recordEvent("domain_update", entityRef("domain", domain), nil)
+
+	return domain, nil
+}
+
+func (s *Server) deleteDomain(r *http.Request) (any, error) {
+	domain, err := s.domainFromPath(r)
+	if err != nil {
+		return nil, err
+	}
+
+	id := domain["id"].(int)
+
+	s.domains.delete(id)
+	s.domainRecords.deleteChildren(id)
+	s.recordEvent("domain_delete", entityRef("domain", domain), nil)
+
+	return nil, nil
+}
+
+func (s *Server) getDomainZoneFile(r *http.Request) (any, error) {
+	domain, err := s.domainFromPath(r)
+	if err != nil {
+		return nil, err
+	}
+
+	zoneFile := []string{
+		fmt.Sprintf("; %s [%d]", domain["domain"], domain["id"]),
+		fmt.Sprintf("$TTL %d", intOr(domain["ttl_sec"], 86400)),
+	}
+
+	for _, record := range s.domainRecords.list(domain["id"].(int)) {
+		name := stringOr(record["name"], "@")
+		zoneFile = append(zoneFile, fmt.Sprintf("%s\tIN\t%s\t%s", name, record["type"], record["target"]))
+	}
+
+	return object{"zone_file": zoneFile}, nil
+}
+
+func (s *Server) recordFromPath(r *http.Request) (object, object, error) {
+	domain, err := s.domainFromPath(r)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	recordID, err := pathInt(r, "recordID")
+	if err != nil {
+		return nil, nil, err
+	}
+
+	record, err := s.domainRecords.get(domain["id"].(int), recordID)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return domain, record, nil
+}
+
+func (s *Server) listDomainRecords(r *http.Request) (any, error) {
+	domain, err := s.domainFromPath(r)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.domainRecords.list(domain["id"].(int)), nil
+}
+
+func (s *Server) createDomainRecord(r *http.Request) (any, error) {
+	domain, err := s.domainFromPath(r)
+	if err != nil {
+		return nil, err
+	}
+
+	body, err := decodeBody(r)
+	if err != nil {
+		return nil, err
+	}
+
+	if stringOr(body["type"], "") == "" {
+		return nil, errBadRequest("type", "Type is required.")
+	}
+
+	record := object{
+		"id":       s.newID(),
+		"type":     "",
+		"name":     "",
+		"target":   "",
+		"priority": 0,
+		"weight":   0,
+		"port":     0,
+		"service":  nil,
+		"protocol": nil,
+		"ttl_sec":  0,
+		"tag":      nil,
+		"created":  now(),
+		"updated":  now(),
+	}
+
+	merge(record, body, domainRecordFields...)
+
+	s.domainRecords.insert(domain["id"].(int), record)
+	s.recordEvent("domain_record_create", entityRef("domain", domain), nil)
+
+	return record, nil
+}
+
+func (s *Server) getDomainRecord(r *http.Request) (any, error) {
+	_, record, err := s.recordFromPath(r)
+	return record, err
+}
+
+func (s *Server) updateDomainRecord(r *http.Request) (any, error) {
+	domain, record, err := s.recordFromPath(r)
+	if err != nil {
+		return nil, err
+	}
+
+	body, err := decodeBody(r)
+	if err != nil {
+		return nil, err
+	}
+
+	merge(record, body, domainRecordFields...)
+	record["updated"] = now()
+	s.recordEvent("domain_record_update", entityRef("domain", domain), nil)
+
+	return record, nil
+}
+
+func (s *Server) deleteDomainRecord(r *http.Request) (any, error) {
+	domain, record, err := s.recordFromPath(r)
+	if err != nil {
+		return nil, err
+	}
+
+	s.domainRecords.delete(record["id"].(int))
+	s.recordEvent("domain_record_delete", entityRef("domain", domain), nil)
+
+	return nil, nil
+}
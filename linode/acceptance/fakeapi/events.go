@@ -0,0 +1,69 @@
+package fakeapi
+
+import (
+	"fmt"
+	"net/http"
+)
+
+func (s *Server) registerEventRoutes() {
+	s.handle(http.MethodGet, "/account/events", s.listEvents)
+	s.handle(http.MethodGet, "/account/events/{id}", s.getEvent)
+}
+
+// entityRef returns the event entity representation of an object.
+func entityRef(entityType string, obj object) object {
+	label := obj["label"]
+	if entityType == "domain" {
+		label = obj["domain"]
+	}
+
+	return object{
+		"id":    obj["id"],
+		"type":  entityType,
+		"label": label,
+		"url":   fmt.Sprintf("/v4/%ss/%v", entityType, obj["id"]),
+	}
+}
+
+// recordEvent records a finished event for the given entity. All actions
+// against the fake API complete synchronously, so events are never pending.
+func (s *Server) recordEvent(action string, entity, secondaryEntity object) {
+	event := object{
+		"id":               s.newID(),
+		"action":           action,
+		"status":           "finished",
+		"percent_complete": 100,
+		"rate":             nil,
+		"read":             false,
+		"seen":             false,
+		"username":         "fakeapi",
+		"entity":           entity,
+		"secondary_entity": secondaryEntity,
+		"created":          now(),
+		"message":          "",
+		"duration":         0,
+	}
+
+	s.events.insert(0, event)
+}
+
+func (s *Server) listEvents(r *http.Request) (any, error) {
+	events := s.events.list(0)
+
+	// The API returns the most recent events first
+	result := make([]object, len(events))
+	for i, e := range events {
+		result[len(events)-1-i] = e
+	}
+
+	return result, nil
+}
+
+func (s *Server) getEvent(r *http.Request) (any, error) {
+	id, err := pathInt(r, "id")
+	if err != nil {
+		return nil, err
+	}
+
+	return s.events.get(0, id)
+}
@@ -0,0 +1,200 @@
+package fakeapi
+
+import (
+	"encoding/json"
+	"fmt"
+	"slices"
+	"sort"
+	"strings"
+)
+
+// applyFilter filters and orders the given objects according to a
+// Linode API X-Filter expression.
+func applyFilter(filterJSON string, items []object) ([]object, error) {
+	if filterJSON == "" {
+		return items, nil
+	}
+
+	var filter object
+	if err := json.Unmarshal([]byte(filterJSON), &filter); err != nil {
+		return nil, fmt.Errorf("failed to parse filter: %w", err)
+	}
+
+	result := make([]object, 0, len(items))
+
+	for _, item := range items {
+		ok, err := matchFilter(filter, item)
+		if err != nil {
+			return nil, err
+		}
+
+		if ok {
+			result = append(result, item)
+		}
+	}
+
+	if orderBy, ok := filter["+order_by"].(string); ok {
+		descending := filter["+order"] == "desc"
+
+		sort.SliceStable(result, func(i, j int) bool {
+			cmp := compareValues(lookupField(result[i], orderBy), lookupField(result[j], orderBy))
+			if descending {
+				return cmp > 0
+			}
+			return cmp < 0
+		})
+	}
+
+	return result, nil
+}
+
+func matchFilter(filter object, item object) (bool, error) {
+	for key, value := range filter {
+		switch key {
+		case "+order_by", "+order":
+			continue
+		case "+and", "+or":
+			clauses, ok := value.([]any)
+			if !ok {
+				return false, fmt.Errorf("%s must be a list", key)
+			}
+
+			matched := 0
+
+			for _, c := range clauses {
+				clause, ok := c.(map[string]any)
+				if !ok {
+					return false, fmt.Errorf("%s clauses must be objects", key)
+				}
+
+				ok, err := matchFilter(clause, item)
+				if err != nil {
+					return false, err
+				}
+
+				if ok {
+					matched++
+				}
+			}
+
+			if key == "+and" && matched != len(clauses) {
+				return false, nil
+			}
+
+			if key == "+or" && matched == 0 {
+				return false, nil
+			}
+		default:
+			ok, err := matchField(lookupField(item, key), value)
+			if err != nil {
+				return false, err
+			}
+
+			if !ok {
+				return false, nil
+			}
+		}
+	}
+
+	return true, nil
+}
+
+func matchField(actual, expected any) (bool, error) {
+	ops, ok := expected.(map[string]any)
+	if !ok {
+		return matchOperator("+eq", actual, expected)
+	}
+
+	for op, v := range ops {
+		ok, err := matchOperator(op, actual, v)
+		if err != nil || !ok {
+			return false, err
+		}
+	}
+
+	return true, nil
+}
+
+func matchOperator(op string, actual, expected any) (bool, error) {
+	// Filtering on a list field (e.g. tags) matches any of its elements
+	if list, ok := actual.([]any); ok {
+		return slices.ContainsFunc(list, func(v any) bool {
+			ok, _ := matchOperator(op, v, expected)
+			return ok
+		}), nil
+	}
+
+	if list, ok := actual.([]string); ok {
+		return slices.ContainsFunc(list, func(v string) bool {
+			ok, _ := matchOperator(op, v, expected)
+			return ok
+		}), nil
+	}
+
+	switch op {
+	case "+eq":
+		return compareValues(actual, expected) == 0, nil
+	case "+neq":
+		return compareValues(actual, expected) != 0, nil
+	case "+gt":
+		return compareValues(actual, expected) > 0, nil
+	case "+gte":
+		return compareValues(actual, expected) >= 0, nil
+	case "+lt":
+		return compareValues(actual, expected) < 0, nil
+	case "+lte":
+		return compareValues(actual, expected) <= 0, nil
+	case "+contains":
+		return strings.Contains(
+			strings.ToLower(fmt.Sprint(actual)),
+			strings.ToLower(fmt.Sprint(expected)),
+		), nil
+	}
+
+	return false, fmt.Errorf("unsupported filter operator %q", op)
+}
+
+// lookupField resolves a dotted field path (e.g. entity.id) on an object.
+func lookupField(item object, path string) any {
+	var current any = item
+
+	for _, key := range strings.Split(path, ".") {
+		m, ok := current.(map[string]any)
+		if !ok {
+			return nil
+		}
+		current = m[key]
+	}
+
+	return current
+}
+
+// compareValues compares two JSON-like values, treating all numbers
+// as floats and falling back to string comparison for everything else.
+func compareValues(a, b any) int {
+	af, aIsNum := toFloat(a)
+	bf, bIsNum := toFloat(b)
+
+	if aIsNum && bIsNum {
+		switch {
+		case af < bf:
+			return -1
+		case af > bf:
+			return 1
+		default:
+			return 0
+		}
+	}
+
+	return strings.Compare(fmt.Sprint(a), fmt.Sprint(b))
+}
+
+func toFloat(v any) (float64, bool) {
+	switch n := v.(type) {
+	case int:
+		return float64(n), true
+	case float64:
+		return n, true
+	}
+	return 0, false
+}
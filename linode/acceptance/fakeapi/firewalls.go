@@ -0,0 +1,322 @@
+package fakeapi
+
+import (
+	"fmt"
+	"net/http"
+)
+
+func (s *Server) registerFirewallRoutes() {
+	s.handle(http.MethodGet, "/networking/firewalls", s.listFirewalls)
+	s.handle(http.MethodPost, "/networking/firewalls", s.createFirewall)
+	s.handle(http.MethodGet, "/networking/firewalls/{id}", s.getFirewall)
+	s.handle(http.MethodPut, "/networking/firewalls/{id}", s.updateFirewall)
+	s.handle(http.MethodDelete, "/networking/firewalls/{id}", s.deleteFirewall)
+	s.handle(http.MethodGet, "/networking/firewalls/{id}/rules", s.getFirewallRules)
+	s.handle(http.MethodPut, "/networking/firewalls/{id}/rules", s.updateFirewallRules)
+
+	s.handle(http.MethodGet, "/networking/firewalls/{id}/devices", s.listFirewallDevices)
+	s.handle(http.MethodPost, "/networking/firewalls/{id}/devices", s.createFirewallDevice)
+	s.handle(http.MethodGet, "/networking/firewalls/{id}/devices/{deviceID}", s.getFirewallDevice)
+	s.handle(http.MethodDelete, "/networking/firewalls/{id}/devices/{deviceID}", s.deleteFirewallDevice)
+}
+
+func (s *Server) firewallFromPath(r *http.Request) (object, error) {
+	id, err := pathInt(r, "id")
+	if err != nil {
+		return nil, err
+	}
+
+	return s.firewalls.get(0, id)
+}
+
+func (s *Server) listFirewalls(r *http.Request) (any, error) {
+	return s.firewalls.list(0), nil
+}
+
+func (s *Server) getFirewall(r *http.Request) (any, error) {
+	return s.firewallFromPath(r)
+}
+
+func buildFirewallRules(v any) object {
+	rules := object{
+		"inbound":         []any{},
+		"outbound":        []any{},
+		"inbound_policy":  "ACCEPT",
+		"outbound_policy": "ACCEPT",
+	}
+
+	if m, ok := v.(map[string]any); ok {
+		merge(rules, m, "inbound", "outbound", "inbound_policy", "outbound_policy")
+	}
+
+	for _, direction := range []string{"inbound", "outbound"} {
+		if rules[direction] == nil {
+			rules[direction] = []any{}
+		}
+	}
+
+	return rules
+}
+
+func (s *Server) createFirewall(r *http.Request) (any, error) {
+	body, err := decodeBody(r)
+	if err != nil {
+		return nil, err
+	}
+
+	label := stringOr(body["label"], "")
+	if label == "" {
+		return nil, errBadRequest("label", "Label is required.")
+	}
+
+	firewall := object{
+		"id":      s.newID(),
+		"label":   label,
+		"status":  "enabled",
+		"tags":    stringList(body["tags"]),
+		"rules":   buildFirewallRules(body["rules"]),
+		"created": now(),
+		"updated": now(),
+	}
+
+	var devices []object
+
+	if d, ok := body["devices"].(map[string]any); ok {
+		for _, id := range stringList(d["linodes"]) {
+			instance, err := s.instances.get(0, intOr(id, 0))
+			if err != nil {
+				return nil, errBadRequest("devices.linodes", "Linode not found")
+			}
+			devices = append(devices, object{"type": "linode", "entity": instance})
+		}
+
+		for _, id := range stringList(d["nodebalancers"]) {
+			nodeBalancer, err := s.nodeBalancers.get(0, intOr(id, 0))
+			if err != nil {
+				return nil, errBadRequest("devices.nodebalancers", "NodeBalancer not found")
+			}
+			devices = append(devices, object{"type": "nodebalancer", "entity": nodeBalancer})
+		}
+	}
+
+	s.firewalls.insert(0, firewall)
+	s.recordEvent("firewall_create", entityRef("firewall", firewall), nil)
+
+	for _, d := range devices {
+		s.insertFirewallDevice(firewall, d["type"].(string), d["entity"].(object))
+	}
+
+	return firewall, nil
+}
+
+func (s *Server) updateFirewall(r *http.Request) (any, error) {
+	firewall, err := s.firewallFromPath(r)
+	if err != nil {
+		return nil, err
+	}
+
+	body, err := decodeBody(r)
+	if err != nil {
+		return nil, err
+	}
+
+	merge(firewall, body, "label", "status", "tags")
+	firewall["updated"] = now()
+	s.recordEvent("firewall_update", entityRef("firewall", firewall), nil)
+
+	return firewall, nil
+}
+
+func (s *Server) deleteFirewall(r *http.Request) (any, error) {
+	firewall, err := s.firewallFromPath(r)
+	if err != nil {
+		return nil, err
+	}
+
+	id := firewall["id"].(int)
+
+	s.firewalls.delete(id)
+	s.firewallDevices.deleteChildren(id)
+	s.recordEvent("firewall_delete", entityRef("firewall", firewall), nil)
+
+	return nil, nil
+}
+
+func (s *Server) getFirewallRules(r *http.Request) (any, error) {
+	firewall, err := s.firewallFromPath(r)
+	if err != nil {
+		return nil, err
+	}
+
+	return firewall["rules"], nil
+}
+
+func (s *Server) updateFirewallRules(r *http.Request) (any, error) {
+	firewall, err := s.firewallFromPath(r)
+	if err != nil {
+		return nil, err
+	}
+
+	body, err := decodeBody(r)
+	if err != nil {
+		return nil, err
+	}
+
+	firewall["rules"] = buildFirewallRules(body)
+	firewall["updated"] = now()
+	s.recordEvent("firewall_rules_update", entityRef("firewall", firewall), nil)
+
+	return firewall["rules"], nil
+}
+
+func (s *Server) insertFirewallDevice(firewall object, entityType string, entity object) object {
+	url := fmt.Sprintf("/v4/linode/instances/%v", entity["id"])
+	if entityType == "nodebalancer" {
+		url = fmt.Sprintf("/v4/nodebalancers/%v", entity["id"])
+	}
+
+	device := object{
+		"id": s.newID(),
+		"entity": object{
+			"id":    entity["id"],
+			"type":  entityType,
+			"label": entity["label"],
+			"url":   url,
+		},
+		"created": now(),
+		"updated": now(),
+	}
+
+	s.firewallDevices.insert(firewall["id"].(int), device)
+	s.recordEvent("firewall_device_add", entityRef("firewall", firewall), device["entity"].(object))
+
+	return device
+}
+
+// deleteEntityFirewallDevices removes the given entity from all firewalls.
+func (s *Server) deleteEntityFirewallDevices(entityType string, entityID int) {
+	for _, device := range s.firewallDevices.list(0) {
+		entity := device["entity"].(object)
+		if entity["type"] == entityType && entity["id"] == entityID {
+			s.firewallDevices.delete(device["id"].(int))
+		}
+	}
+}
+
+// listEntityFirewalls returns a handler that lists all firewalls that
+// have the entity referenced by the request path as a device.
+func (s *Server) listEntityFirewalls(entityType string) handlerFunc {
+	return func(r *http.Request) (any, error) {
+		entityID, err := pathInt(r, "id")
+		if err != nil {
+			return nil, err
+		}
+
+		entities := s.instances
+		if entityType == "nodebalancer" {
+			entities = s.nodeBalancers
+		}
+
+		if _, err := entities.get(0, entityID); err != nil {
+			return nil, err
+		}
+
+		result := []object{}
+
+		for _, firewall := range s.firewalls.list(0) {
+			for _, device := range s.firewallDevices.list(firewall["id"].(int)) {
+				entity := device["entity"].(object)
+				if entity["type"] == entityType && entity["id"] == entityID {
+					result = append(result, firewall)
+					break
+				}
+			}
+		}
+
+		return result, nil
+	}
+}
+
+func (s *Server) listFirewallDevices(r *http.Request) (any, error) {
+	firewall, err := s.firewallFromPath(r)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.firewallDevices.list(firewall["id"].(int)), nil
+}
+
+func (s *Server) createFirewallDevice(r *http.Request) (any, error) {
+	firewall, err := s.firewallFromPath(r)
+	if err != nil {
+		return nil, err
+	}
+
+	body, err := decodeBody(r)
+	if err != nil {
+		return nil, err
+	}
+
+	entityType := stringOr(body["type"], "")
+	entityID := intOr(body["id"], 0)
+
+	var entity object
+
+	switch entityType {
+	case "linode":
+		entity, err = s.instances.get(0, entityID)
+	case "nodebalancer":
+		entity, err = s.nodeBalancers.get(0, entityID)
+	default:
+		return nil, errBadRequest("type", "type must be one of linode, nodebalancer")
+	}
+
+	if err != nil {
+		return nil, errBadRequest("id", fmt.Sprintf("%s not found", entityType))
+	}
+
+	for _, device := range s.firewallDevices.list(firewall["id"].(int)) {
+		e := device["entity"].(object)
+		if e["type"] == entityType && e["id"] == entityID {
+			return nil, errBadRequest("id", "This entity is already assigned to this firewall")
+		}
+	}
+
+	return s.insertFirewallDevice(firewall, entityType, entity), nil
+}
+
+func (s *Server) firewallDeviceFromPath(r *http.Request) (object, object, error) {
+	firewall, err := s.firewallFromPath(r)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	deviceID, err := pathInt(r, "deviceID")
+	if err != nil {
+		return nil, nil, err
+	}
+
+	device, err := s.firewallDevices.get(firewall["id"].(int), deviceID)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return firewall, device, nil
+}
+
+func (s *Server) getFirewallDevice(r *http.Request) (any, error) {
+	_, device, err := s.firewallDeviceFromPath(r)
+	return device, err
+}
+
+func (s *Server) deleteFirewallDevice(r *http.Request) (any, error) {
+	firewall, device, err := s.firewallDeviceFromPath(r)
+	if err != nil {
+		return nil, err
+	}
+
+	s.firewallDevices.delete(device["id"].(int))
+	s.recordEvent("firewall_device_remove", entityRef("firewall", firewall), device["entity"].(object))
+
+	return nil, nil
+}
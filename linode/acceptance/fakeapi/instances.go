@@ -0,0 +1,796 @@
+package fakeapi
+
+import (
+	"fmt"
+	"net/http"
+)
+
+const defaultSwapSize = 512
+
+func (s *Server) registerInstanceRoutes() {
+	s.handle(http.MethodGet, "/linode/instances", s.listInstances)
+	s.handle(http.MethodPost, "/linode/instances", s.createInstance)
+	s.handle(http.MethodGet, "/linode/instances/{id}", s.getInstance)
+	s.handle(http.MethodPut, "/linode/instances/{id}", s.updateInstance)
+	s.handle(http.MethodDelete, "/linode/instances/{id}", s.deleteInstance)
+
+	s.handle(http.MethodPost, "/linode/instances/{id}/boot", s.powerAction("linode_boot", "running"))
+	s.handle(http.MethodPost, "/linode/instances/{id}/reboot", s.powerAction("linode_reboot", "running"))
+	s.handle(http.MethodPost, "/linode/instances/{id}/shutdown", s.powerAction("linode_shutdown", "offline"))
+	s.handle(http.MethodPost, "/linode/instances/{id}/rescue", s.powerAction("linode_reboot", "running"))
+	s.handle(http.MethodPost, "/linode/instances/{id}/resize", s.resizeInstance)
+	s.handle(http.MethodPost, "/linode/instances/{id}/backups/enable", s.setInstanceBackups(true))
+	s.handle(http.MethodPost, "/linode/instances/{id}/backups/cancel", s.setInstanceBackups(false))
+	s.handle(http.MethodGet, "/linode/instances/{id}/backups", s.getInstanceBackups)
+	s.handle(http.MethodGet, "/linode/instances/{id}/ips", s.getInstanceIPs)
+	s.handle(http.MethodGet, "/linode/instances/{id}/volumes", s.listInstanceVolumes)
+	s.handle(http.MethodGet, "/linode/instances/{id}/firewalls", s.listEntityFirewalls("linode"))
+
+	s.handle(http.MethodGet, "/linode/instances/{id}/configs", s.listInstanceConfigs)
+	s.handle(http.MethodPost, "/linode/instances/{id}/configs", s.createInstanceConfig)
+	s.handle(http.MethodGet, "/linode/instances/{id}/configs/{configID}", s.getInstanceConfig)
+	s.handle(http.MethodPut, "/linode/instances/{id}/configs/{configID}", s.updateInstanceConfig)
+	s.handle(http.MethodDelete, "/linode/instances/{id}/configs/{configID}", s.deleteInstanceConfig)
+
+	s.handle(http.MethodGet, "/linode/instances/{id}/disks", s.listInstanceDisks)
+	s.handle(http.MethodPost, "/linode/instances/{id}/disks", s.createInstanceDisk)
+	s.handle(http.MethodGet, "/linode/instances/{id}/disks/{diskID}", s.getInstanceDisk)
+	s.handle(http.MethodPut, "/linode/instances/{id}/disks/{diskID}", s.updateInstanceDisk)
+	s.handle(http.MethodDelete, "/linode/instances/{id}/disks/{diskID}", s.deleteInstanceDisk)
+	s.handle(http.MethodPost, "/linode/instances/{id}/disks/{diskID}/resize", s.resizeInstanceDisk)
+	s.handle(http.MethodPost, "/linode/instances/{id}/disks/{diskID}/password", s.resetInstanceDiskPassword)
+}
+
+func (s *Server) instanceFromPath(r *http.Request) (object, error) {
+	id, err := pathInt(r, "id")
+	if err != nil {
+		return nil, err
+	}
+
+	return s.instances.get(0, id)
+}
+
+func (s *Server) listInstances(r *http.Request) (any, error) {
+	return s.instances.list(0), nil
+}
+
+func (s *Server) getInstance(r *http.Request) (any, error) {
+	return s.instanceFromPath(r)
+}
+
+func (s *Server) createInstance(r *http.Request) (any, error) {
+	body, err := decodeBody(r)
+	if err != nil {
+		return nil, err
+	}
+
+	region, ok := s.regions[stringOr(body["region"], "")]
+	if !ok {
+		return nil, errBadRequest("region", "region is not valid")
+	}
+
+	linodeType, ok := s.types[stringOr(body["type"], "")]
+	if !ok {
+		return nil, errBadRequest("type", "A valid plan type by that ID was not found")
+	}
+
+	image := stringOr(body["image"], "")
+	if image != "" {
+		if _, ok := s.images[image]; !ok {
+			return nil, errBadRequest("image", "image is not valid")
+		}
+	}
+
+	id := s.newID()
+
+	instance := object{
+		"id":               id,
+		"label":            stringOr(body["label"], fmt.Sprintf("linode%d", id)),
+		"group":            stringOr(body["group"], ""),
+		"region":           region["id"],
+		"type":             linodeType["id"],
+		"image":            nilIfEmpty(image),
+		"status":           "offline",
+		"tags":             stringList(body["tags"]),
+		"ipv4":             []any{},
+		"ipv6":             fmt.Sprintf("2001:db8::%x/128", id),
+		"hypervisor":       "kvm",
+		"host_uuid":        fmt.Sprintf("fake-host-%d", id),
+		"watchdog_enabled": true,
+		"has_user_data":    body["metadata"] != nil,
+		"disk_encryption":  stringOr(body["disk_encryption"], "disabled"),
+		"lke_cluster_id":   0,
+		"placement_group":  nil,
+		"capabilities":     []any{},
+		"specs":            specsFromType(linodeType),
+		"alerts": object{
+			"cpu":            90 * intOr(linodeType["vcpus"], 1),
+			"io":             10000,
+			"network_in":     10,
+			"network_out":    10,
+			"transfer_quota": 80,
+		},
+		"backups": object{
+			"enabled":         boolOr(body["backups_enabled"], false),
+			"available":       false,
+			"last_successful": nil,
+			"schedule": object{
+				"day":    "Scheduling",
+				"window": "Scheduling",
+			},
+		},
+		"created": now(),
+		"updated": now(),
+	}
+
+	s.allocateIP(instance, "ipv4", true)
+
+	if boolOr(body["private_ip"], false) {
+		s.allocateIP(instance, "ipv4", false)
+	}
+
+	s.instances.insert(0, instance)
+	s.recordEvent("linode_create", entityRef("linode", instance), nil)
+
+	if image != "" {
+		swapSize := intOr(body["swap_size"], defaultSwapSize)
+		mainSize := intOr(linodeType["disk"], 0) - swapSize
+
+		mainDisk := s.insertDisk(instance, object{
+			"label":      fmt.Sprintf("%s Disk", s.images[image]["label"]),
+			"size":       mainSize,
+			"filesystem": "ext4",
+		})
+
+		devices := object{"sda": object{"disk_id": mainDisk["id"], "volume_id": nil}}
+
+		if swapSize > 0 {
+			swapDisk := s.insertDisk(instance, object{
+				"label":      fmt.Sprintf("%d MB Swap Image", swapSize),
+				"size":       swapSize,
+				"filesystem": "swap",
+			})
+			devices["sdb"] = object{"disk_id": swapDisk["id"], "volume_id": nil}
+		}
+
+		s.insertConfig(instance, object{
+			"label":      fmt.Sprintf("My %s Disk Profile", linodeType["label"]),
+			"devices":    devices,
+			"interfaces": body["interfaces"],
+		})
+
+		if boolOr(body["booted"], true) {
+			instance["status"] = "running"
+			s.recordEvent("linode_boot", entityRef("linode", instance), nil)
+		}
+	}
+
+	if firewallID := intOr(body["firewall_id"], 0); firewallID != 0 {
+		firewall, err := s.firewalls.get(0, firewallID)
+		if err != nil {
+			return nil, errBadRequest("firewall_id", "Firewall not found")
+		}
+
+		s.insertFirewallDevice(firewall, "linode", instance)
+	}
+
+	return instance, nil
+}
+
+func nilIfEmpty(v string) any {
+	if v == "" {
+		return nil
+	}
+	return v
+}
+
+func specsFromType(linodeType object) object {
+	return object{
+		"disk":                linodeType["disk"],
+		"memory":              linodeType["memory"],
+		"vcpus":               linodeType["vcpus"],
+		"gpus":                linodeType["gpus"],
+		"transfer":            linodeType["transfer"],
+		"accelerated_devices": linodeType["accelerated_devices"],
+	}
+}
+
+// allocateIP assigns a new IPv4 address to the given instance.
+func (s *Server) allocateIP(instance object, ipType string, public bool) object {
+	s.nextIP++
+
+	address := fmt.Sprintf("192.168.%d.%d", s.nextIP/250, s.nextIP%250+2)
+	prefix := 17
+	gateway := "192.168.128.1"
+	subnetMask := "255.255.128.0"
+
+	if public {
+		address = fmt.Sprintf("203.0.%d.%d", s.nextIP/250, s.nextIP%250+2)
+		prefix = 24
+		gateway = fmt.Sprintf("203.0.%d.1", s.nextIP/250)
+		subnetMask = "255.255.255.0"
+	}
+
+	ip := object{
+		"address":     address,
+		"gateway":     gateway,
+		"subnet_mask": subnetMask,
+		"prefix":      prefix,
+		"type":        ipType,
+		"public":      public,
+		"rdns":        "",
+		"linode_id":   instance["id"],
+		"region":      instance["region"],
+		"vpc_nat_1_1": nil,
+		"reserved":    false,
+	}
+
+	id := instance["id"].(int)
+	s.instanceIPs[id] = append(s.instanceIPs[id], ip)
+	instance["ipv4"] = append(instance["ipv4"].([]any), address)
+
+	return ip
+}
+
+func (s *Server) updateInstance(r *http.Request) (any, error) {
+	instance, err := s.instanceFromPath(r)
+	if err != nil {
+		return nil, err
+	}
+
+	body, err := decodeBody(r)
+	if err != nil {
+		return nil, err
+	}
+
+	merge(instance, body, "label", "group", "tags", "watchdog_enabled")
+
+	if alerts, ok := body["alerts"].(map[string]any); ok {
+		for k, v := range alerts {
+			instance["alerts"].(object)[k] = v
+		}
+	}
+
+	if backups, ok := body["backups"].(map[string]any); ok {
+		if schedule, ok := backups["schedule"].(map[string]any); ok {
+			merge(instance["backups"].(object)["schedule"].(object), schedule, "day", "window")
+		}
+	}
+
+	instance["updated"] = now()
+
+	return instance, nil
+}
+
+func (s *Server) deleteInstance(r *http.Request) (any, error) {
+	instance, err := s.instanceFromPath(r)
+	if err != nil {
+		return nil, err
+	}
+
+	id := instance["id"].(int)
+
+	s.instances.delete(id)
+	delete(s.instanceIPs, id)
+	s.instanceConfigs.deleteChildren(id)
+	s.instanceDisks.deleteChildren(id)
+
+	for _, volume := range s.volumes.list(0) {
+		if volume["linode_id"] == id {
+			volume["linode_id"] = nil
+			volume["linode_label"] = nil
+		}
+	}
+
+	s.deleteEntityFirewallDevices("linode", id)
+	s.recordEvent("linode_delete", entityRef("linode", instance), nil)
+
+	return nil, nil
+}
+
+func (s *Server) powerAction(action, status string) handlerFunc {
+	return func(r *http.Request) (any, error) {
+		instance, err := s.instanceFromPath(r)
+		if err != nil {
+			return nil, err
+		}
+
+		instance["status"] = status
+		s.recordEvent(action, entityRef("linode", instance), nil)
+
+		return nil, nil
+	}
+}
+
+func (s *Server) resizeInstance(r *http.Request) (any, error) {
+	instance, err := s.instanceFromPath(r)
+	if err != nil {
+		return nil, err
+	}
+
+	body, err := decodeBody(r)
+	if err != nil {
+		return nil, err
+	}
+
+	linodeType, ok := s.types[stringOr(body["type"], "")]
+	if !ok {
+		return nil, errBadRequest("type", "A valid plan type by that ID was not found")
+	}
+
+	instance["type"] = linodeType["id"]
+	instance["specs"] = specsFromType(linodeType)
+	instance["updated"] = now()
+
+	s.recordEvent("linode_resize", entityRef("linode", instance), nil)
+
+	return nil, nil
+}
+
+func (s *Server) setInstanceBackups(enabled bool) handlerFunc {
+	return func(r *http.Request) (any, error) {
+		instance, err := s.instanceFromPath(r)
+		if err != nil {
+			return nil, err
+		}
+
+		instance["backups"].(object)["enabled"] = enabled
+
+		return nil, nil
+	}
+}
+
+func (s *Server) getInstanceBackups(r *http.Request) (any, error) {
+	if _, err := s.instanceFromPath(r); err != nil {
+		return nil, err
+	}
+
+	return object{
+		"automatic": []any{},
+		"snapshot": object{
+			"current":     nil,
+			"in_progress": nil,
+		},
+	}, nil
+}
+
+func (s *Server) getInstanceIPs(r *http.Request) (any, error) {
+	instance, err := s.instanceFromPath(r)
+	if err != nil {
+		return nil, err
+	}
+
+	public := []object{}
+	private := []object{}
+
+	for _, ip := range s.instanceIPs[instance["id"].(int)] {
+		if ip["public"].(bool) {
+			public = append(public, ip)
+		} else {
+			private = append(private, ip)
+		}
+	}
+
+	slaac := object{
+		"address":     fmt.Sprintf("2001:db8::%x", instance["id"]),
+		"gateway":     "fe80::1",
+		"subnet_mask": "ffff:ffff:ffff:ffff::",
+		"prefix":      64,
+		"type":        "ipv6",
+		"public":      true,
+		"rdns":        "",
+		"linode_id":   instance["id"],
+		"region":      instance["region"],
+	}
+
+	linkLocal := object{
+		"address":     fmt.Sprintf("fe80::%x", instance["id"]),
+		"gateway":     "fe80::1",
+		"subnet_mask": "ffff:ffff:ffff:ffff::",
+		"prefix":      64,
+		"type":        "ipv6",
+		"public":      false,
+		"rdns":        "",
+		"linode_id":   instance["id"],
+		"region":      instance["region"],
+	}
+
+	return object{
+		"ipv4": object{
+			"public":   public,
+			"private":  private,
+			"shared":   []any{},
+			"reserved": []any{},
+			"vpc":      s.instanceVPCIPs(instance),
+		},
+		"ipv6": object{
+			"link_local": linkLocal,
+			"slaac":      slaac,
+			"global":     []any{},
+		},
+	}, nil
+}
+
+func (s *Server) listInstanceVolumes(r *http.Request) (any, error) {
+	instance, err := s.instanceFromPath(r)
+	if err != nil {
+		return nil, err
+	}
+
+	result := []object{}
+	for _, volume := range s.volumes.list(0) {
+		if volume["linode_id"] == instance["id"] {
+			result = append(result, volume)
+		}
+	}
+
+	return result, nil
+}
+
+func (s *Server) insertConfig(instance object, body object) object {
+	id := s.newID()
+
+	config := object{
+		"id":           id,
+		"label":        stringOr(body["label"], fmt.Sprintf("config%d", id)),
+		"comments":     stringOr(body["comments"], ""),
+		"kernel":       stringOr(body["kernel"], "linode/grub2"),
+		"memory_limit": intOr(body["memory_limit"], 0),
+		"root_device":  stringOr(body["root_device"], "/dev/sda"),
+		"run_level":    stringOr(body["run_level"], "default"),
+		"virt_mode":    stringOr(body["virt_mode"], "paravirt"),
+		"init_rd":      body["init_rd"],
+		"devices":      normalizeDevices(body["devices"]),
+		"helpers": object{
+			"updatedb_disabled":  true,
+			"distro":             true,
+			"modules_dep":        true,
+			"network":            true,
+			"devtmpfs_automount": true,
+		},
+		"interfaces": s.buildInterfaces(instance, body["interfaces"]),
+		"created":    now(),
+		"updated":    now(),
+	}
+
+	if helpers, ok := body["helpers"].(map[string]any); ok {
+		merge(config["helpers"].(object), helpers,
+			"updatedb_disabled", "distro", "modules_dep", "network", "devtmpfs_automount")
+	}
+
+	s.instanceConfigs.insert(instance["id"].(int), config)
+
+	return config
+}
+
+func normalizeDevices(v any) object {
+	result := object{}
+
+	devices, ok := v.(map[string]any)
+	if !ok {
+		return result
+	}
+
+	for slot, d := range devices {
+		device, ok := d.(map[string]any)
+		if !ok || device == nil {
+			continue
+		}
+
+		result[slot] = object{
+			"disk_id":   nilIfZero(intOr(device["disk_id"], 0)),
+			"volume_id": nilIfZero(intOr(device["volume_id"], 0)),
+		}
+	}
+
+	return result
+}
+
+func nilIfZero(v int) any {
+	if v == 0 {
+		return nil
+	}
+	return v
+}
+
+// buildInterfaces converts interface create options into the
+// representation returned by the API.
+func (s *Server) buildInterfaces(instance object, v any) []any {
+	result := []any{}
+
+	for _, i := range stringList(v) {
+		opts, ok := i.(map[string]any)
+		if !ok {
+			continue
+		}
+
+		iface := object{
+			"id":           s.newID(),
+			"purpose":      stringOr(opts["purpose"], "public"),
+			"label":        stringOr(opts["label"], ""),
+			"ipam_address": stringOr(opts["ipam_address"], ""),
+			"primary":      boolOr(opts["primary"], false),
+			"active":       instance["status"] == "running",
+			"vpc_id":       nil,
+			"subnet_id":    nil,
+			"ipv4":         nil,
+			"ip_ranges":    []any{},
+		}
+
+		if iface["purpose"] == "vpc" {
+			subnetID := intOr(opts["subnet_id"], 0)
+			iface["subnet_id"] = subnetID
+			iface["vpc_id"] = s.vpcSubnets.parentOf(subnetID)
+
+			ipv4 := object{"vpc": fmt.Sprintf("10.0.0.%d", iface["id"].(int)%250+2), "nat_1_1": nil}
+			if o, ok := opts["ipv4"].(map[string]any); ok {
+				if addr := stringOr(o["vpc"], ""); addr != "" {
+					ipv4["vpc"] = addr
+				}
+				if nat := stringOr(o["nat_1_1"], ""); nat != "" {
+					ipv4["nat_1_1"] = nat
+					if nat == "any" {
+						ipv4["nat_1_1"] = instance["ipv4"].([]any)[0]
+					}
+				}
+			}
+
+			iface["ipv4"] = ipv4
+			iface["ip_ranges"] = stringList(opts["ip_ranges"])
+		}
+
+		result = append(result, iface)
+	}
+
+	return result
+}
+
+func (s *Server) configFromPath(r *http.Request) (object, object, error) {
+	instance, err := s.instanceFromPath(r)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	configID, err := pathInt(r, "configID")
+	if err != nil {
+		return nil, nil, err
+	}
+
+	config, err := s.instanceConfigs.get(instance["id"].(int), configID)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return instance, config, nil
+}
+
+func (s *Server) listInstanceConfigs(r *http.Request) (any, error) {
+	instance, err := s.instanceFromPath(r)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.instanceConfigs.list(instance["id"].(int)), nil
+}
+
+func (s *Server) createInstanceConfig(r *http.Request) (any, error) {
+	instance, err := s.instanceFromPath(r)
+	if err != nil {
+		return nil, err
+	}
+
+	body, err := decodeBody(r)
+	if err != nil {
+		return nil, err
+	}
+
+	if stringOr(body["label"], "") == "" {
+		return nil, errBadRequest("label", "Label is required.")
+	}
+
+	config := s.insertConfig(instance, body)
+	s.recordEvent("linode_config_create", entityRef("linode", instance), nil)
+
+	return config, nil
+}
+
+func (s *Server) getInstanceConfig(r *http.Request) (any, error) {
+	_, config, err := s.configFromPath(r)
+	return config, err
+}
+
+func (s *Server) updateInstanceConfig(r *http.Request) (any, error) {
+	instance, config, err := s.configFromPath(r)
+	if err != nil {
+		return nil, err
+	}
+
+	body, err := decodeBody(r)
+	if err != nil {
+		return nil, err
+	}
+
+	merge(config, body,
+		"label", "comments", "kernel", "memory_limit", "root_device", "run_level", "virt_mode", "init_rd")
+
+	if devices, ok := body["devices"]; ok && devices != nil {
+		config["devices"] = normalizeDevices(devices)
+	}
+
+	if helpers, ok := body["helpers"].(map[string]any); ok {
+		merge(config["helpers"].(object), helpers,
+			"updatedb_disabled", "distro", "modules_dep", "network", "devtmpfs_automount")
+	}
+
+	if interfaces, ok := body["interfaces"]; ok && interfaces != nil {
+		config["interfaces"] = s.buildInterfaces(instance, interfaces)
+	}
+
+	config["updated"] = now()
+	s.recordEvent("linode_config_update", entityRef("linode", instance), nil)
+
+	return config, nil
+}
+
+func (s *Server) deleteInstanceConfig(r *http.Request) (any, error) {
+	instance, config, err := s.configFromPath(r)
+	if err != nil {
+		return nil, err
+	}
+
+	s.instanceConfigs.delete(config["id"].(int))
+	s.recordEvent("linode_config_delete", entityRef("linode", instance), nil)
+
+	return nil, nil
+}
+
+// usedDiskSpace returns the total size of all disks on the given instance.
+func (s *Server) usedDiskSpace(instanceID int) int {
+	used := 0
+	for _, disk := range s.instanceDisks.list(instanceID) {
+		used += disk["size"].(int)
+	}
+	return used
+}
+
+func (s *Server) insertDisk(instance object, body object) object {
+	disk := object{
+		"id":              s.newID(),
+		"label":           stringOr(body["label"], ""),
+		"status":          "ready",
+		"size":            intOr(body["size"], 0),
+		"filesystem":      stringOr(body["filesystem"], "ext4"),
+		"disk_encryption": instance["disk_encryption"],
+		"created":         now(),
+		"updated":         now(),
+	}
+
+	s.instanceDisks.insert(instance["id"].(int), disk)
+
+	return disk
+}
+
+func (s *Server) diskFromPath(r *http.Request) (object, object, error) {
+	instance, err := s.instanceFromPath(r)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	diskID, err := pathInt(r, "diskID")
+	if err != nil {
+		return nil, nil, err
+	}
+
+	disk, err := s.instanceDisks.get(instance["id"].(int), diskID)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return instance, disk, nil
+}
+
+func (s *Server) listInstanceDisks(r *http.Request) (any, error) {
+	instance, err := s.instanceFromPath(r)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.instanceDisks.list(instance["id"].(int)), nil
+}
+
+func (s *Server) createInstanceDisk(r *http.Request) (any, error) {
+	instance, err := s.instanceFromPath(r)
+	if err != nil {
+		return nil, err
+	}
+
+	body, err := decodeBody(r)
+	if err != nil {
+		return nil, err
+	}
+
+	size := intOr(body["size"], 0)
+	if size < 1 {
+		return nil, errBadRequest("size", "Size must be at least 1 MB")
+	}
+
+	if s.usedDiskSpace(instance["id"].(int))+size > instance["specs"].(object)["disk"].(int) {
+		return nil, errBadRequest("size", "Insufficient space to create this disk.")
+	}
+
+	if image := stringOr(body["image"], ""); image != "" {
+		if _, ok := s.images[image]; !ok {
+			return nil, errBadRequest("image", "image is not valid")
+		}
+	}
+
+	disk := s.insertDisk(instance, body)
+	s.recordEvent("disk_create", entityRef("linode", instance), entityRef("disk", disk))
+
+	return disk, nil
+}
+
+func (s *Server) getInstanceDisk(r *http.Request) (any, error) {
+	_, disk, err := s.diskFromPath(r)
+	return disk, err
+}
+
+func (s *Server) updateInstanceDisk(r *http.Request) (any, error) {
+	_, disk, err := s.diskFromPath(r)
+	if err != nil {
+		return nil, err
+	}
+
+	body, err := decodeBody(r)
+	if err != nil {
+		return nil, err
+	}
+
+	merge(disk, body, "label")
+	disk["updated"] = now()
+
+	return disk, nil
+}
+
+func (s *Server) deleteInstanceDisk(r *http.Request) (any, error) {
+	instance, disk, err := s.diskFromPath(r)
+	if err != nil {
+		return nil, err
+	}
+
+	s.instanceDisks.delete(disk["id"].(int))
+	s.recordEvent("disk_delete", entityRef("linode", instance), entityRef("disk", disk))
+
+	return nil, nil
+}
+
+func (s *Server) resizeInstanceDisk(r *http.Request) (any, error) {
+	instance, disk, err := s.diskFromPath(r)
+	if err != nil {
+		return nil, err
+	}
+
+	body, err := decodeBody(r)
+	if err != nil {
+		return nil, err
+	}
+
+	size := intOr(body["size"], 0)
+	available := instance["specs"].(object)["disk"].(int) - s.usedDiskSpace(instance["id"].(int)) + disk["size"].(int)
+
+	if size < 1 || size > available {
+		return nil, errBadRequest("size", "Insufficient space to resize this disk.")
+	}
+
+	disk["size"] = size
+	disk["updated"] = now()
+
+	s.recordEvent("disk_resize", entityRef("linode", instance), entityRef("disk", disk))
+
+	return nil, nil
+}
+
+func (s *Server) resetInstanceDiskPassword(r *http.Request) (any, error) {
+	_, _, err := s.diskFromPath(r)
+	return nil, err
+}
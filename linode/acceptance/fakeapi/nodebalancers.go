@@ -0,0 +1,381 @@
+package fakeapi
+
+import (
+	"fmt"
+	"net/http"
+)
+
+func (s *Server) registerNodeBalancerRoutes() {
+	s.handle(http.MethodGet, "/nodebalancers", s.listNodeBalancers)
+	s.handle(http.MethodPost, "/nodebalancers", s.createNodeBalancer)
+	s.handle(http.MethodGet, "/nodebalancers/{id}", s.getNodeBalancer)
+	s.handle(http.MethodPut, "/nodebalancers/{id}", s.updateNodeBalancer)
+	s.handle(http.MethodDelete, "/nodebalancers/{id}", s.deleteNodeBalancer)
+	s.handle(http.MethodGet, "/nodebalancers/{id}/firewalls", s.listEntityFirewalls("nodebalancer"))
+
+	s.handle(http.MethodGet, "/nodebalancers/{id}/configs", s.listNodeBalancerConfigs)
+	s.handle(http.MethodPost, "/nodebalancers/{id}/configs", s.createNodeBalancerConfig)
+	s.handle(http.MethodGet, "/nodebalancers/{id}/configs/{configID}", s.getNodeBalancerConfig)
+	s.handle(http.MethodPut, "/nodebalancers/{id}/configs/{configID}", s.updateNodeBalancerConfig)
+	s.handle(http.MethodDelete, "/nodebalancers/{id}/configs/{configID}", s.deleteNodeBalancerConfig)
+
+	s.handle(http.MethodGet, "/nodebalancers/{id}/configs/{configID}/nodes", s.listNodeBalancerNodes)
+	s.handle(http.MethodPost, "/nodebalancers/{id}/configs/{configID}/nodes", s.createNodeBalancerNode)
+	s.handle(http.MethodGet, "/nodebalancers/{id}/configs/{configID}/nodes/{nodeID}", s.getNodeBalancerNode)
+	s.handle(http.MethodPut, "/nodebalancers/{id}/configs/{configID}/nodes/{nodeID}", s.updateNodeBalancerNode)
+	s.handle(http.MethodDelete, "/nodebalancers/{id}/configs/{configID}/nodes/{nodeID}", s.deleteNodeBalancerNode)
+}
+
+var nodeBalancerConfigFields = []string{
+	"port", "protocol", "proxy_protocol", "algorithm", "stickiness", "check",
+	"check_interval", "check_attempts", "check_path", "check_body", "check_passive",
+	"check_timeout", "cipher_suite", "ssl_cert", "ssl_key",
+}
+
+func (s *Server) nodeBalancerFromPath(r *http.Request) (object, error) {
+	id, err := pathInt(r, "id")
+	if err != nil {
+		return nil, err
+	}
+
+	return s.nodeBalancers.get(0, id)
+}
+
+func (s *Server) listNodeBalancers(r *http.Request) (any, error) {
+	return s.nodeBalancers.list(0), nil
+}
+
+func (s *Server) getNodeBalancer(r *http.Request) (any, error) {
+	return s.nodeBalancerFromPath(r)
+}
+
+func (s *Server) createNodeBalancer(r *http.Request) (any, error) {
+	body, err := decodeBody(r)
+	if err != nil {
+		return nil, err
+	}
+
+	region := stringOr(body["region"], "")
+	if _, ok := s.regions[region]; !ok {
+		return nil, errBadRequest("region", "region is not valid")
+	}
+
+	id := s.newID()
+	s.nextIP++
+	ipv4 := fmt.Sprintf("198.51.%d.%d", s.nextIP/250, s.nextIP%250+2)
+
+	nodeBalancer := object{
+		"id":                   id,
+		"label":                stringOr(body["label"], fmt.Sprintf("balancer%d", id)),
+		"region":               region,
+		"hostname":             fmt.Sprintf("nb-%d.%s.nodebalancer.linode.com", id, region),
+		"ipv4":                 ipv4,
+		"ipv6":                 fmt.Sprintf("2001:db8:1::%x", id),
+		"client_conn_throttle": intOr(body["client_conn_throttle"], 0),
+		"tags":                 stringList(body["tags"]),
+		"transfer": object{
+			"total": nil,
+			"out":   nil,
+			"in":    nil,
+		},
+		"created": now(),
+		"updated": now(),
+	}
+
+	s.nodeBalancers.insert(0, nodeBalancer)
+	s.recordEvent("nodebalancer_create", entityRef("nodebalancer", nodeBalancer), nil)
+
+	for _, c := range stringList(body["configs"]) {
+		opts, ok := c.(map[string]any)
+		if !ok {
+			continue
+		}
+
+		config := s.insertNodeBalancerConfig(nodeBalancer, opts)
+
+		for _, n := range stringList(opts["nodes"]) {
+			if nodeOpts, ok := n.(map[string]any); ok {
+				s.insertNodeBalancerNode(config, nodeOpts)
+			}
+		}
+	}
+
+	if firewallID := intOr(body["firewall_id"], 0); firewallID != 0 {
+		firewall, err := s.firewalls.get(0, firewallID)
+		if err != nil {
+			return nil, errBadRequest("firewall_id", "Firewall not found")
+		}
+
+		s.insertFirewallDevice(firewall, "nodebalancer", nodeBalancer)
+	}
+
+	return nodeBalancer, nil
+}
+
+func (s *Server) updateNodeBalancer(r *http.Request) (any, error) {
+	nodeBalancer, err := s.nodeBalancerFromPath(r)
+	if err != nil {
+		return nil, err
+	}
+
+	body, err := decodeBody(r)
+	if err != nil {
+		return nil, err
+	}
+
+	merge(nodeBalancer, body, "label", "client_conn_throttle", "tags")
+	nodeBalancer["updated"] = now()
+	s.recordEvent("nodebalancer_update", entityRef("nodebalancer", nodeBalancer), nil)
+
+	return nodeBalancer, nil
+}
+
+func (s *Server) deleteNodeBalancer(r *http.Request) (any, error) {
+	nodeBalancer, err := s.nodeBalancerFromPath(r)
+	if err != nil {
+		return nil, err
+	}
+
+	id := nodeBalancer["id"].(int)
+
+	for _, config := range s.nbConfigs.list(id) {
+		s.nbNodes.deleteChildren(config["id"].(int))
+	}
+
+	s.nodeBalancers.delete(id)
+	s.nbConfigs.deleteChildren(id)
+	s.deleteEntityFirewallDevices("nodebalancer", id)
+	s.recordEvent("nodebalancer_delete", entityRef("nodebalancer", nodeBalancer), nil)
+
+	return nil, nil
+}
+
+func (s *Server) insertNodeBalancerConfig(nodeBalancer object, body object) object {
+	config := object{
+		"id":              s.newID(),
+		"nodebalancer_id": nodeBalancer["id"],
+		"port":            80,
+		"protocol":        "http",
+		"proxy_protocol":  "none",
+		"algorithm":       "roundrobin",
+		"stickiness":      "none",
+		"check":           "none",
+		"check_interval":  5,
+		"check_attempts":  3,
+		"check_timeout":   3,
+		"check_path":      "",
+		"check_body":      "",
+		"check_passive":   true,
+		"cipher_suite":    "recommended",
+		"ssl_commonname":  "",
+		"ssl_fingerprint": "",
+		"ssl_cert":        nil,
+		"ssl_key":         nil,
+	}
+
+	merge(config, body, nodeBalancerConfigFields...)
+	s.nbConfigs.insert(nodeBalancer["id"].(int), config)
+	s.updateNodesStatus(config)
+
+	return config
+}
+
+// updateNodesStatus recomputes the node status summary of a config.
+// All fake nodes are reported as up.
+func (s *Server) updateNodesStatus(config object) {
+	config["nodes_status"] = object{
+		"up":   len(s.nbNodes.list(config["id"].(int))),
+		"down": 0,
+	}
+}
+
+func (s *Server) nbConfigFromPath(r *http.Request) (object, object, error) {
+	nodeBalancer, err := s.nodeBalancerFromPath(r)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	configID, err := pathInt(r, "configID")
+	if err != nil {
+		return nil, nil, err
+	}
+
+	config, err := s.nbConfigs.get(nodeBalancer["id"].(int), configID)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return nodeBalancer, config, nil
+}
+
+func (s *Server) listNodeBalancerConfigs(r *http.Request) (any, error) {
+	nodeBalancer, err := s.nodeBalancerFromPath(r)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.nbConfigs.list(nodeBalancer["id"].(int)), nil
+}
+
+func (s *Server) createNodeBalancerConfig(r *http.Request) (any, error) {
+	nodeBalancer, err := s.nodeBalancerFromPath(r)
+	if err != nil {
+		return nil, err
+	}
+
+	body, err := decodeBody(r)
+	if err != nil {
+		return nil, err
+	}
+
+	port := intOr(body["port"], 80)
+	for _, c := range s.nbConfigs.list(nodeBalancer["id"].(int)) {
+		if intOr(c["port"], 0) == port {
+			return nil, errBadRequest("port", "Port is already in use")
+		}
+	}
+
+	body["port"] = port
+	config := s.insertNodeBalancerConfig(nodeBalancer, body)
+	s.recordEvent("nodebalancer_config_create", entityRef("nodebalancer", nodeBalancer), nil)
+
+	return config, nil
+}
+
+func (s *Server) getNodeBalancerConfig(r *http.Request) (any, error) {
+	_, config, err := s.nbConfigFromPath(r)
+	return config, err
+}
+
+func (s *Server) updateNodeBalancerConfig(r *http.Request) (any, error) {
+	nodeBalancer, config, err := s.nbConfigFromPath(r)
+	if err != nil {
+		return nil, err
+	}
+
+	body, err := decodeBody(r)
+	if err != nil {
+		return nil, err
+	}
+
+	merge(config, body, nodeBalancerConfigFields...)
+	s.recordEvent("nodebalancer_config_update", entityRef("nodebalancer", nodeBalancer), nil)
+
+	return config, nil
+}
+
+func (s *Server) deleteNodeBalancerConfig(r *http.Request) (any, error) {
+	nodeBalancer, config, err := s.nbConfigFromPath(r)
+	if err != nil {
+		return nil, err
+	}
+
+	id := config["id"].(int)
+
+	s.nbConfigs.delete(id)
+	s.nbNodes.deleteChildren(id)
+	s.recordEvent("nodebalancer_config_delete", entityRef("nodebalancer", nodeBalancer), nil)
+
+	return nil, nil
+}
+
+func (s *Server) insertNodeBalancerNode(config object, body object) object {
+	node := object{
+		"id":              s.newID(),
+		"nodebalancer_id": config["nodebalancer_id"],
+		"config_id":       config["id"],
+		"address":         stringOr(body["address"], ""),
+		"label":           stringOr(body["label"], ""),
+		"status":          "UP",
+		"weight":          intOr(body["weight"], 100),
+		"mode":            stringOr(body["mode"], "accept"),
+	}
+
+	s.nbNodes.insert(config["id"].(int), node)
+	s.updateNodesStatus(config)
+
+	return node
+}
+
+func (s *Server) nbNodeFromPath(r *http.Request) (object, object, object, error) {
+	nodeBalancer, config, err := s.nbConfigFromPath(r)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+
+	nodeID, err := pathInt(r, "nodeID")
+	if err != nil {
+		return nil, nil, nil, err
+	}
+
+	node, err := s.nbNodes.get(config["id"].(int), nodeID)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+
+	return nodeBalancer, config, node, nil
+}
+
+func (s *Server) listNodeBalancerNodes(r *http.Request) (any, error) {
+	_, config, err := s.nbConfigFromPath(r)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.nbNodes.list(config["id"].(int)), nil
+}
+
+func (s *Server) createNodeBalancerNode(r *http.Request) (any, error) {
+	nodeBalancer, config, err := s.nbConfigFromPath(r)
+	if err != nil {
+		return nil, err
+	}
+
+	body, err := decodeBody(r)
+	if err != nil {
+		return nil, err
+	}
+
+	if stringOr(body["address"], "") == "" {
+		return nil, errBadRequest("address", "Address is required.")
+	}
+
+	node := s.insertNodeBalancerNode(config, body)
+	s.recordEvent("nodebalancer_node_create", entityRef("nodebalancer", nodeBalancer), nil)
+
+	return node, nil
+}
+
+func (s *Server) getNodeBalancerNode(r *http.Request) (any, error) {
+	_, _, node, err := s.nbNodeFromPath(r)
+	return node, err
+}
+
+func (s *Server) updateNodeBalancerNode(r *http.Request) (any, error) {
+	_, _, node, err := s.nbNodeFromPath(r)
+	if err != nil {
+		return nil, err
+	}
+
+	body, err := decodeBody(r)
+	if err != nil {
+		return nil, err
+	}
+
+	merge(node, body, "address", "label", "weight", "mode")
+
+	return node, nil
+}
+
+func (s *Server) deleteNodeBalancerNode(r *http.Request) (any, error) {
+	nodeBalancer, config, node, err := s.nbNodeFromPath(r)
+	if err != nil {
+		return nil, err
+	}
+
+	s.nbNodes.delete(node["id"].(int))
+	s.updateNodesStatus(config)
+	s.recordEvent("nodebalancer_node_delete", entityRef("nodebalancer", nodeBalancer), nil)
+
+	return nil, nil
+}
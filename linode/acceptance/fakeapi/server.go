@@ -0,0 +1,352 @@
+// Package fakeapi implements a stateful, in-process fake of the Linode API.
+//
+// The server is built on net/http/httptest and implements the subset of
+// endpoints used by the provider for instances, configs, disks, volumes,
+// firewalls, NodeBalancers, VPCs, domains and events. Its URL can be used
+// as helper.Config.APIURL (or the LINODE_URL environment variable) so that
+// resource lifecycle tests can run without network access.
+package fakeapi
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"sort"
+	"strconv"
+	"sync"
+	"time"
+)
+
+const (
+	timeLayout      = "2006-01-02T15:04:05"
+	defaultPageSize = 100
+)
+
+type object = map[string]any
+
+// Server is a fake Linode API server. All state is held in memory and
+// is discarded when the server is closed.
+type Server struct {
+	*httptest.Server
+
+	mu     sync.Mutex
+	mux    *http.ServeMux
+	nextID int
+	nextIP int
+
+	regions map[string]object
+	types   map[string]object
+	images  map[string]object
+	kernels map[string]object
+
+	instances       *table
+	instanceIPs     map[int][]object
+	instanceConfigs *table
+	instanceDisks   *table
+	volumes         *table
+	firewalls       *table
+	firewallDevices *table
+	nodeBalancers   *table
+	nbConfigs       *table
+	nbNodes         *table
+	vpcs            *table
+	vpcSubnets      *table
+	domains         *table
+	domainRecords   *table
+	events          *table
+}
+
+// NewServer starts and returns a new fake Linode API server.
+// The caller should call Close when finished to shut it down.
+func NewServer() *Server {
+	s := newServer()
+	s.Server = httptest.NewServer(s.mux)
+	return s
+}
+
+func newServer() *Server {
+	s := &Server{
+		mux:    http.NewServeMux(),
+		nextID: 1000,
+		nextIP: 2,
+
+		instances:       newTable(),
+		instanceIPs:     make(map[int][]object),
+		instanceConfigs: newTable(),
+		instanceDisks:   newTable(),
+		volumes:         newTable(),
+		firewalls:       newTable(),
+		firewallDevices: newTable(),
+		nodeBalancers:   newTable(),
+		nbConfigs:       newTable(),
+		nbNodes:         newTable(),
+		vpcs:            newTable(),
+		vpcSubnets:      newTable(),
+		domains:         newTable(),
+		domainRecords:   newTable(),
+		events:          newTable(),
+	}
+
+	s.seedCatalog()
+
+	s.registerCatalogRoutes()
+	s.registerInstanceRoutes()
+	s.registerVolumeRoutes()
+	s.registerFirewallRoutes()
+	s.registerNodeBalancerRoutes()
+	s.registerVPCRoutes()
+	s.registerDomainRoutes()
+	s.registerEventRoutes()
+
+	return s
+}
+
+// handlerFunc is the signature of all fake API endpoint implementations.
+// Returned values of type []object are paginated and filtered according
+// to the request; all other values are encoded as-is.
+type handlerFunc func(r *http.Request) (any, error)
+
+// apiError is an error that is returned to the client in the format
+// used by the Linode API.
+type apiError struct {
+	Status int
+	Field  string
+	Reason string
+}
+
+func (e *apiError) Error() string {
+	return fmt.Sprintf("[%03d] %s", e.Status, e.Reason)
+}
+
+func errNotFound() error {
+	return &apiError{Status: http.StatusNotFound, Reason: "Not found"}
+}
+
+func errBadRequest(field, reason string) error {
+	return &apiError{Status: http.StatusBadRequest, Field: field, Reason: reason}
+}
+
+// handle registers the given handler for a method and path pattern.
+// The pattern is automatically prefixed with the API version segment.
+func (s *Server) handle(method, pattern string, fn handlerFunc) {
+	s.mux.HandleFunc(
+		fmt.Sprintf("%s /{apiVersion}%s", method, pattern),
+		func(w http.ResponseWriter, r *http.Request) {
+			s.mu.Lock()
+			result, err := fn(r)
+			if err == nil {
+				if page, ok := result.([]object); ok {
+					result, err = paginate(r, page)
+				}
+			}
+			s.mu.Unlock()
+
+			if err != nil {
+				writeError(w, err)
+				return
+			}
+
+			if result == nil {
+				result = object{}
+			}
+
+			writeJSON(w, http.StatusOK, result)
+		},
+	)
+}
+
+func writeJSON(w http.ResponseWriter, status int, body any) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	_ = json.NewEncoder(w).Encode(body)
+}
+
+func writeError(w http.ResponseWriter, err error) {
+	var apiErr *apiError
+	if !errors.As(err, &apiErr) {
+		apiErr = &apiError{Status: http.StatusInternalServerError, Reason: err.Error()}
+	}
+
+	reason := object{"reason": apiErr.Reason}
+	if apiErr.Field != "" {
+		reason["field"] = apiErr.Field
+	}
+
+	writeJSON(w, apiErr.Status, object{"errors": []object{reason}})
+}
+
+// paginate applies the X-Filter header and page query parameters
+// of the request to the given list of objects.
+func paginate(r *http.Request, items []object) (any, error) {
+	items, err := applyFilter(r.Header.Get("X-Filter"), items)
+	if err != nil {
+		return nil, errBadRequest("X-Filter", err.Error())
+	}
+
+	page := queryInt(r, "page", 1)
+	pageSize := queryInt(r, "page_size", defaultPageSize)
+
+	pages := (len(items) + pageSize - 1) / pageSize
+	if pages == 0 {
+		pages = 1
+	}
+
+	start := min((page-1)*pageSize, len(items))
+	end := min(start+pageSize, len(items))
+
+	return object{
+		"data":    items[start:end],
+		"page":    page,
+		"pages":   pages,
+		"results": len(items),
+	}, nil
+}
+
+func queryInt(r *http.Request, key string, defaultValue int) int {
+	v, err := strconv.Atoi(r.URL.Query().Get(key))
+	if err != nil || v < 1 {
+		return defaultValue
+	}
+	return v
+}
+
+func pathInt(r *http.Request, name string) (int, error) {
+	v, err := strconv.Atoi(r.PathValue(name))
+	if err != nil {
+		return 0, errNotFound()
+	}
+	return v, nil
+}
+
+func decodeBody(r *http.Request) (object, error) {
+	body := make(object)
+
+	if r.Body == nil || r.ContentLength == 0 {
+		return body, nil
+	}
+
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		return nil, errBadRequest("", fmt.Sprintf("Invalid JSON: %s", err))
+	}
+
+	return body, nil
+}
+
+func (s *Server) newID() int {
+	s.nextID++
+	return s.nextID
+}
+
+func now() string {
+	return time.Now().UTC().Format(timeLayout)
+}
+
+// table is an ordered collection of objects, optionally scoped
+// to a parent object such as an instance or a domain.
+type table struct {
+	rows map[int]*row
+}
+
+type row struct {
+	parent int
+	data   object
+}
+
+func newTable() *table {
+	return &table{rows: make(map[int]*row)}
+}
+
+func (t *table) insert(parent int, data object) {
+	t.rows[data["id"].(int)] = &row{parent: parent, data: data}
+}
+
+// get returns the object with the given ID. If parent is non-zero, the
+// object must also belong to the given parent.
+func (t *table) get(parent, id int) (object, error) {
+	r, ok := t.rows[id]
+	if !ok || (parent != 0 && r.parent != parent) {
+		return nil, errNotFound()
+	}
+	return r.data, nil
+}
+
+func (t *table) parentOf(id int) int {
+	if r, ok := t.rows[id]; ok {
+		return r.parent
+	}
+	return 0
+}
+
+// list returns all objects sorted by ID. If parent is non-zero, only
+// objects that belong to the given parent are returned.
+func (t *table) list(parent int) []object {
+	ids := make([]int, 0, len(t.rows))
+	for id, r := range t.rows {
+		if parent == 0 || r.parent == parent {
+			ids = append(ids, id)
+		}
+	}
+
+	sort.Ints(ids)
+
+	result := make([]object, len(ids))
+	for i, id := range ids {
+		result[i] = t.rows[id].data
+	}
+
+	return result
+}
+
+func (t *table) delete(id int) {
+	delete(t.rows, id)
+}
+
+func (t *table) deleteChildren(parent int) {
+	for id, r := range t.rows {
+		if r.parent == parent {
+			delete(t.rows, id)
+		}
+	}
+}
+
+// merge copies the given keys from src into dst if they are present.
+func merge(dst, src object, keys ...string) {
+	for _, k := range keys {
+		if v, ok := src[k]; ok {
+			dst[k] = v
+		}
+	}
+}
+
+func stringOr(v any, defaultValue string) string {
+	if s, ok := v.(string); ok && s != "" {
+		return s
+	}
+	return defaultValue
+}
+
+func intOr(v any, defaultValue int) int {
+	switch n := v.(type) {
+	case float64:
+		return int(n)
+	case int:
+		return n
+	}
+	return defaultValue
+}
+
+func boolOr(v any, defaultValue bool) bool {
+	if b, ok := v.(bool); ok {
+		return b
+	}
+	return defaultValue
+}
+
+func stringList(v any) []any {
+	if l, ok := v.([]any); ok {
+		return l
+	}
+	return []any{}
+}
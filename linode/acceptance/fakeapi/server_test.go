@@ -0,0 +1,363 @@
+//go:build unit
+
+package fakeapi_test
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/linode/linodego"
+	"github.com/linode/terraform-provider-linode/v2/linode/acceptance/fakeapi"
+	"github.com/linode/terraform-provider-linode/v2/linode/helper"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func newTestClient(t *testing.T) *linodego.Client {
+	t.Helper()
+
+	server := fakeapi.NewServer()
+	t.Cleanup(server.Close)
+
+	config := &helper.Config{
+		AccessToken:           "fake-token",
+		APIURL:                server.URL,
+		APIVersion:            "v4beta",
+		EventPollMilliseconds: 10,
+	}
+
+	client, err := config.Client(context.Background())
+	require.NoError(t, err)
+
+	return client
+}
+
+func requireNotFound(t *testing.T, err error) {
+	t.Helper()
+
+	var apiErr *linodego.Error
+	require.True(t, errors.As(err, &apiErr), "expected API error, got %v", err)
+	require.Equal(t, http.StatusNotFound, apiErr.Code)
+}
+
+func TestCatalog(t *testing.T) {
+	ctx := context.Background()
+	client := newTestClient(t)
+
+	types, err := client.ListTypes(ctx, nil)
+	require.NoError(t, err)
+	assert.NotEmpty(t, types)
+
+	region, err := client.GetRegion(ctx, "us-east")
+	require.NoError(t, err)
+	assert.Contains(t, region.Capabilities, linodego.CapabilityVPCs)
+
+	image, err := client.GetImage(ctx, "linode/alpine3.20")
+	require.NoError(t, err)
+	assert.Equal(t, "Alpine", image.Vendor)
+
+	filter := linodego.Filter{}
+	filter.AddField(linodego.Eq, "vendor", "Alpine")
+	filterJSON, err := filter.MarshalJSON()
+	require.NoError(t, err)
+
+	images, err := client.ListImages(ctx, linodego.NewListOptions(0, string(filterJSON)))
+	require.NoError(t, err)
+	assert.Len(t, images, 2)
+}
+
+func TestInstanceLifecycle(t *testing.T) {
+	ctx := context.Background()
+	client := newTestClient(t)
+
+	start := time.Now().Add(-time.Second)
+
+	instance, err := client.CreateInstance(ctx, linodego.InstanceCreateOptions{
+		Label:    "fake-instance",
+		Region:   "us-east",
+		Type:     "g6-nanode-1",
+		Image:    "linode/alpine3.20",
+		RootPass: "fake-Password-123",
+		Tags:     []string{"fake"},
+	})
+	require.NoError(t, err)
+	assert.Equal(t, linodego.InstanceRunning, instance.Status)
+	assert.Len(t, instance.IPv4, 1)
+
+	_, err = client.WaitForEventFinished(ctx, instance.ID, linodego.EntityLinode, linodego.ActionLinodeCreate, start, 5)
+	require.NoError(t, err)
+
+	disks, err := client.ListInstanceDisks(ctx, instance.ID, nil)
+	require.NoError(t, err)
+	require.Len(t, disks, 2)
+	assert.Equal(t, instance.Specs.Disk, disks[0].Size+disks[1].Size)
+
+	configs, err := client.ListInstanceConfigs(ctx, instance.ID, nil)
+	require.NoError(t, err)
+	require.Len(t, configs, 1)
+	assert.Equal(t, disks[0].ID, configs[0].Devices.SDA.DiskID)
+
+	// There is no space left for a new disk until the main disk is shrunk
+	_, err = client.CreateInstanceDisk(ctx, instance.ID, linodego.InstanceDiskCreateOptions{Label: "extra", Size: 1024})
+	require.Error(t, err)
+
+	p, err := client.NewEventPollerWithSecondary(ctx, instance.ID, linodego.EntityLinode, disks[0].ID, linodego.ActionDiskResize)
+	require.NoError(t, err)
+
+	require.NoError(t, client.ResizeInstanceDisk(ctx, instance.ID, disks[0].ID, disks[0].Size-1024))
+
+	_, err = p.WaitForFinished(ctx, 5)
+	require.NoError(t, err)
+
+	disk, err := client.CreateInstanceDisk(ctx, instance.ID, linodego.InstanceDiskCreateOptions{Label: "extra", Size: 1024})
+	require.NoError(t, err)
+	assert.Equal(t, linodego.DiskReady, disk.Status)
+
+	require.NoError(t, client.ShutdownInstance(ctx, instance.ID))
+
+	instance, err = client.WaitForInstanceStatus(ctx, instance.ID, linodego.InstanceOffline, 5)
+	require.NoError(t, err)
+
+	updated, err := client.UpdateInstance(ctx, instance.ID, linodego.InstanceUpdateOptions{Label: "fake-renamed"})
+	require.NoError(t, err)
+	assert.Equal(t, "fake-renamed", updated.Label)
+
+	ips, err := client.GetInstanceIPAddresses(ctx, instance.ID)
+	require.NoError(t, err)
+	require.Len(t, ips.IPv4.Public, 1)
+	assert.Equal(t, instance.IPv4[0].String(), ips.IPv4.Public[0].Address)
+
+	require.NoError(t, client.DeleteInstance(ctx, instance.ID))
+
+	_, err = client.GetInstance(ctx, instance.ID)
+	requireNotFound(t, err)
+}
+
+func TestVolumeLifecycle(t *testing.T) {
+	ctx := context.Background()
+	client := newTestClient(t)
+
+	instance, err := client.CreateInstance(ctx, linodego.InstanceCreateOptions{
+		Region: "us-east",
+		Type:   "g6-nanode-1",
+	})
+	require.NoError(t, err)
+
+	volume, err := client.CreateVolume(ctx, linodego.VolumeCreateOptions{
+		Label:  "fake-volume",
+		Region: "us-east",
+		Size:   20,
+	})
+	require.NoError(t, err)
+
+	_, err = client.WaitForVolumeStatus(ctx, volume.ID, linodego.VolumeActive, 5)
+	require.NoError(t, err)
+
+	_, err = client.AttachVolume(ctx, volume.ID, &linodego.VolumeAttachOptions{LinodeID: instance.ID})
+	require.NoError(t, err)
+
+	volume, err = client.WaitForVolumeLinodeID(ctx, volume.ID, &instance.ID, 5)
+	require.NoError(t, err)
+	assert.Equal(t, instance.Label, volume.LinodeLabel)
+
+	// Attached volumes cannot be deleted
+	require.Error(t, client.DeleteVolume(ctx, volume.ID))
+
+	require.NoError(t, client.ResizeVolume(ctx, volume.ID, 30))
+	require.Error(t, client.ResizeVolume(ctx, volume.ID, 10))
+
+	require.NoError(t, client.DetachVolume(ctx, volume.ID))
+
+	_, err = client.WaitForVolumeLinodeID(ctx, volume.ID, nil, 5)
+	require.NoError(t, err)
+
+	require.NoError(t, client.DeleteVolume(ctx, volume.ID))
+
+	_, err = client.GetVolume(ctx, volume.ID)
+	requireNotFound(t, err)
+}
+
+func TestFirewallLifecycle(t *testing.T) {
+	ctx := context.Background()
+	client := newTestClient(t)
+
+	instance, err := client.CreateInstance(ctx, linodego.InstanceCreateOptions{
+		Region: "us-east",
+		Type:   "g6-nanode-1",
+	})
+	require.NoError(t, err)
+
+	firewall, err := client.CreateFirewall(ctx, linodego.FirewallCreateOptions{
+		Label: "fake-firewall",
+		Rules: linodego.FirewallRuleSet{
+			InboundPolicy:  "DROP",
+			OutboundPolicy: "ACCEPT",
+		},
+		Devices: linodego.DevicesCreationOptions{
+			Linodes: []int{instance.ID},
+		},
+	})
+	require.NoError(t, err)
+	assert.Equal(t, linodego.FirewallEnabled, firewall.Status)
+
+	devices, err := client.ListFirewallDevices(ctx, firewall.ID, nil)
+	require.NoError(t, err)
+	require.Len(t, devices, 1)
+	assert.Equal(t, instance.ID, devices[0].Entity.ID)
+
+	firewalls, err := client.ListInstanceFirewalls(ctx, instance.ID, nil)
+	require.NoError(t, err)
+	require.Len(t, firewalls, 1)
+
+	rules, err := client.UpdateFirewallRules(ctx, firewall.ID, linodego.FirewallRuleSet{
+		Inbound: []linodego.FirewallRule{
+			{
+				Action:   "ACCEPT",
+				Label:    "ssh",
+				Ports:    "22",
+				Protocol: linodego.TCP,
+			},
+		},
+		InboundPolicy:  "DROP",
+		OutboundPolicy: "DROP",
+	})
+	require.NoError(t, err)
+	require.Len(t, rules.Inbound, 1)
+	assert.Equal(t, "DROP", rules.OutboundPolicy)
+
+	require.NoError(t, client.DeleteFirewallDevice(ctx, firewall.ID, devices[0].ID))
+	require.NoError(t, client.DeleteFirewall(ctx, firewall.ID))
+
+	_, err = client.GetFirewall(ctx, firewall.ID)
+	requireNotFound(t, err)
+}
+
+func TestNodeBalancerLifecycle(t *testing.T) {
+	ctx := context.Background()
+	client := newTestClient(t)
+
+	label := "fake-nb"
+
+	nodeBalancer, err := client.CreateNodeBalancer(ctx, linodego.NodeBalancerCreateOptions{
+		Label:  &label,
+		Region: "us-east",
+	})
+	require.NoError(t, err)
+	require.NotNil(t, nodeBalancer.IPv4)
+
+	config, err := client.CreateNodeBalancerConfig(ctx, nodeBalancer.ID, linodego.NodeBalancerConfigCreateOptions{
+		Port:     8080,
+		Protocol: linodego.ProtocolTCP,
+	})
+	require.NoError(t, err)
+	assert.Equal(t, linodego.AlgorithmRoundRobin, config.Algorithm)
+
+	node, err := client.CreateNodeBalancerNode(ctx, nodeBalancer.ID, config.ID, linodego.NodeBalancerNodeCreateOptions{
+		Address: "192.168.0.10:80",
+		Label:   "fake-node",
+	})
+	require.NoError(t, err)
+	assert.Equal(t, 100, node.Weight)
+
+	config, err = client.GetNodeBalancerConfig(ctx, nodeBalancer.ID, config.ID)
+	require.NoError(t, err)
+	assert.Equal(t, 1, config.NodesStatus.Up)
+
+	require.NoError(t, client.DeleteNodeBalancer(ctx, nodeBalancer.ID))
+
+	_, err = client.GetNodeBalancerNode(ctx, nodeBalancer.ID, config.ID, node.ID)
+	requireNotFound(t, err)
+}
+
+func TestVPCLifecycle(t *testing.T) {
+	ctx := context.Background()
+	client := newTestClient(t)
+
+	vpc, err := client.CreateVPC(ctx, linodego.VPCCreateOptions{
+		Label:  "fake-vpc",
+		Region: "us-east",
+		Subnets: []linodego.VPCSubnetCreateOptions{
+			{Label: "fake-subnet", IPv4: "10.0.0.0/24"},
+		},
+	})
+	require.NoError(t, err)
+	require.Len(t, vpc.Subnets, 1)
+
+	_, err = client.CreateVPCSubnet(ctx, linodego.VPCSubnetCreateOptions{
+		Label: "public",
+		IPv4:  "8.8.8.0/24",
+	}, vpc.ID)
+	require.Error(t, err)
+
+	instance, err := client.CreateInstance(ctx, linodego.InstanceCreateOptions{
+		Region: "us-east",
+		Type:   "g6-nanode-1",
+		Image:  "linode/alpine3.20",
+		Interfaces: []linodego.InstanceConfigInterfaceCreateOptions{
+			{
+				Purpose:  linodego.InterfacePurposeVPC,
+				SubnetID: &vpc.Subnets[0].ID,
+			},
+		},
+	})
+	require.NoError(t, err)
+
+	subnet, err := client.GetVPCSubnet(ctx, vpc.ID, vpc.Subnets[0].ID)
+	require.NoError(t, err)
+	require.Len(t, subnet.Linodes, 1)
+	assert.Equal(t, instance.ID, subnet.Linodes[0].ID)
+
+	ips, err := client.GetInstanceIPAddresses(ctx, instance.ID)
+	require.NoError(t, err)
+	require.Len(t, ips.IPv4.VPC, 1)
+	assert.Equal(t, vpc.ID, ips.IPv4.VPC[0].VPCID)
+
+	// Subnets with attached Linodes cannot be deleted
+	require.Error(t, client.DeleteVPCSubnet(ctx, vpc.ID, subnet.ID))
+
+	require.NoError(t, client.DeleteInstance(ctx, instance.ID))
+	require.NoError(t, client.DeleteVPC(ctx, vpc.ID))
+}
+
+func TestDomainLifecycle(t *testing.T) {
+	ctx := context.Background()
+	client := newTestClient(t)
+
+	domain, err := client.CreateDomain(ctx, linodego.DomainCreateOptions{
+		Domain:   "fake.example",
+		Type:     linodego.DomainTypeMaster,
+		SOAEmail: "admin@fake.example",
+	})
+	require.NoError(t, err)
+	assert.Equal(t, linodego.DomainStatusActive, domain.Status)
+
+	record, err := client.CreateDomainRecord(ctx, domain.ID, linodego.DomainRecordCreateOptions{
+		Type:   linodego.RecordTypeA,
+		Name:   "www",
+		Target: "192.0.2.10",
+	})
+	require.NoError(t, err)
+
+	record, err = client.UpdateDomainRecord(ctx, domain.ID, record.ID, linodego.DomainRecordUpdateOptions{
+		Target: "192.0.2.20",
+	})
+	require.NoError(t, err)
+	assert.Equal(t, "192.0.2.20", record.Target)
+
+	filter := linodego.Filter{}
+	filter.AddField(linodego.Eq, "domain", "fake.example")
+	filterJSON, err := filter.MarshalJSON()
+	require.NoError(t, err)
+
+	domains, err := client.ListDomains(ctx, linodego.NewListOptions(0, string(filterJSON)))
+	require.NoError(t, err)
+	require.Len(t, domains, 1)
+
+	require.NoError(t, client.DeleteDomain(ctx, domain.ID))
+
+	_, err = client.GetDomainRecord(ctx, domain.ID, record.ID)
+	requireNotFound(t, err)
+}
@@ -0,0 +1,236 @@
+package fakeapi
+
+import (
+	"fmt"
+	"net/http"
+	"strings"
+)
+
+const defaultVolumeSize = 20
+
+func (s *Server) registerVolumeRoutes() {
+	s.handle(http.MethodGet, "/volumes", s.listVolumes)
+	s.handle(http.MethodPost, "/volumes", s.createVolume)
+	s.handle(http.MethodGet, "/volumes/{id}", s.getVolume)
+	s.handle(http.MethodPut, "/volumes/{id}", s.updateVolume)
+	s.handle(http.MethodDelete, "/volumes/{id}", s.deleteVolume)
+	s.handle(http.MethodPost, "/volumes/{id}/attach", s.attachVolume)
+	s.handle(http.MethodPost, "/volumes/{id}/detach", s.detachVolume)
+	s.handle(http.MethodPost, "/volumes/{id}/resize", s.resizeVolume)
+	s.handle(http.MethodPost, "/volumes/{id}/clone", s.cloneVolume)
+}
+
+func (s *Server) volumeFromPath(r *http.Request) (object, error) {
+	id, err := pathInt(r, "id")
+	if err != nil {
+		return nil, err
+	}
+
+	return s.volumes.get(0, id)
+}
+
+func (s *Server) listVolumes(r *http.Request) (any, error) {
+	return s.volumes.list(0), nil
+}
+
+func (s *Server) getVolume(r *http.Request) (any, error) {
+	return s.volumeFromPath(r)
+}
+
+func (s *Server) insertVolume(label, region string, size int, encryption string, tags []any) object {
+	id := s.newID()
+
+	if label == "" {
+		label = fmt.Sprintf("volume%d", id)
+	}
+
+	volume := object{
+		"id":              id,
+		"label":           label,
+		"status":          "active",
+		"region":          region,
+		"size":            size,
+		"linode_id":       nil,
+		"linode_label":    nil,
+		"filesystem_path": "/dev/disk/by-id/scsi-0Linode_Volume_" + strings.ReplaceAll(label, " ", "_"),
+		"tags":            tags,
+		"hardware_type":   "nvme",
+		"encryption":      encryption,
+		"created":         now(),
+		"updated":         now(),
+	}
+
+	s.volumes.insert(0, volume)
+	s.recordEvent("volume_create", entityRef("volume", volume), nil)
+
+	return volume
+}
+
+func (s *Server) createVolume(r *http.Request) (any, error) {
+	body, err := decodeBody(r)
+	if err != nil {
+		return nil, err
+	}
+
+	var instance object
+
+	region := stringOr(body["region"], "")
+
+	if linodeID := intOr(body["linode_id"], 0); linodeID != 0 {
+		instance, err = s.instances.get(0, linodeID)
+		if err != nil {
+			return nil, errBadRequest("linode_id", "Linode not found")
+		}
+
+		region = instance["region"].(string)
+	}
+
+	if _, ok := s.regions[region]; !ok {
+		return nil, errBadRequest("region", "region is not valid")
+	}
+
+	size := intOr(body["size"], defaultVolumeSize)
+	if size < 10 {
+		return nil, errBadRequest("size", "Size must be between 10 and 16384")
+	}
+
+	volume := s.insertVolume(
+		stringOr(body["label"], ""),
+		region,
+		size,
+		stringOr(body["encryption"], "disabled"),
+		stringList(body["tags"]),
+	)
+
+	if instance != nil {
+		s.attachVolumeToInstance(volume, instance)
+	}
+
+	return volume, nil
+}
+
+func (s *Server) updateVolume(r *http.Request) (any, error) {
+	volume, err := s.volumeFromPath(r)
+	if err != nil {
+		return nil, err
+	}
+
+	body, err := decodeBody(r)
+	if err != nil {
+		return nil, err
+	}
+
+	merge(volume, body, "label", "tags")
+	volume["updated"] = now()
+
+	return volume, nil
+}
+
+func (s *Server) deleteVolume(r *http.Request) (any, error) {
+	volume, err := s.volumeFromPath(r)
+	if err != nil {
+		return nil, err
+	}
+
+	if volume["linode_id"] != nil {
+		return nil, errBadRequest("", "Volume must be detached before it can be deleted.")
+	}
+
+	s.volumes.delete(volume["id"].(int))
+	s.recordEvent("volume_delete", entityRef("volume", volume), nil)
+
+	return nil, nil
+}
+
+func (s *Server) attachVolumeToInstance(volume, instance object) {
+	volume["linode_id"] = instance["id"]
+	volume["linode_label"] = instance["label"]
+	s.recordEvent("volume_attach", entityRef("volume", volume), nil)
+}
+
+func (s *Server) attachVolume(r *http.Request) (any, error) {
+	volume, err := s.volumeFromPath(r)
+	if err != nil {
+		return nil, err
+	}
+
+	body, err := decodeBody(r)
+	if err != nil {
+		return nil, err
+	}
+
+	instance, err := s.instances.get(0, intOr(body["linode_id"], 0))
+	if err != nil {
+		return nil, errBadRequest("linode_id", "Linode not found")
+	}
+
+	if volume["linode_id"] != nil && volume["linode_id"] != instance["id"] {
+		return nil, errBadRequest("", "Volume is already attached to a Linode")
+	}
+
+	if instance["region"] != volume["region"] {
+		return nil, errBadRequest("linode_id", "Volume and Linode must be in the same region")
+	}
+
+	s.attachVolumeToInstance(volume, instance)
+
+	return volume, nil
+}
+
+func (s *Server) detachVolume(r *http.Request) (any, error) {
+	volume, err := s.volumeFromPath(r)
+	if err != nil {
+		return nil, err
+	}
+
+	volume["linode_id"] = nil
+	volume["linode_label"] = nil
+	s.recordEvent("volume_detach", entityRef("volume", volume), nil)
+
+	return nil, nil
+}
+
+func (s *Server) resizeVolume(r *http.Request) (any, error) {
+	volume, err := s.volumeFromPath(r)
+	if err != nil {
+		return nil, err
+	}
+
+	body, err := decodeBody(r)
+	if err != nil {
+		return nil, err
+	}
+
+	size := intOr(body["size"], 0)
+	if size <= volume["size"].(int) {
+		return nil, errBadRequest("size", "Volumes can only be resized up")
+	}
+
+	volume["size"] = size
+	volume["updated"] = now()
+	s.recordEvent("volume_resize", entityRef("volume", volume), nil)
+
+	return volume, nil
+}
+
+func (s *Server) cloneVolume(r *http.Request) (any, error) {
+	source, err := s.volumeFromPath(r)
+	if err != nil {
+		return nil, err
+	}
+
+	body, err := decodeBody(r)
+	if err != nil {
+		return nil, err
+	}
+
+	volume := s.insertVolume(
+		stringOr(body["label"], ""),
+		source["region"].(string),
+		source["size"].(int),
+		source["encryption"].(string),
+		source["tags"].([]any),
+	)
+
+	return volume, nil
+}
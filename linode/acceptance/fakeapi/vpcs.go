@@ -0,0 +1,375 @@
+package fakeapi
+
+import (
+	"fmt"
+	"net/http"
+	"net/netip"
+)
+
+func (s *Server) registerVPCRoutes() {
+	s.handle(http.MethodGet, "/vpcs", s.listVPCs)
+	s.handle(http.MethodPost, "/vpcs", s.createVPC)
+	s.handle(http.MethodGet, "/vpcs/{id}", s.getVPC)
+	s.handle(http.MethodPut, "/vpcs/{id}", s.updateVPC)
+	s.handle(http.MethodDelete, "/vpcs/{id}", s.deleteVPC)
+
+	s.handle(http.MethodGet, "/vpcs/{id}/subnets", s.listVPCSubnets)
+	s.handle(http.MethodPost, "/vpcs/{id}/subnets", s.createVPCSubnet)
+	s.handle(http.MethodGet, "/vpcs/{id}/subnets/{subnetID}", s.getVPCSubnet)
+	s.handle(http.MethodPut, "/vpcs/{id}/subnets/{subnetID}", s.updateVPCSubnet)
+	s.handle(http.MethodDelete, "/vpcs/{id}/subnets/{subnetID}", s.deleteVPCSubnet)
+}
+
+// vpcInterface describes a VPC interface attached to an instance config.
+type vpcInterface struct {
+	instance object
+	config   object
+	iface    object
+}
+
+// vpcInterfaces returns all config interfaces attached to the given subnet.
+func (s *Server) vpcInterfaces(subnetID int) []vpcInterface {
+	var result []vpcInterface
+
+	for _, config := range s.instanceConfigs.list(0) {
+		instance, err := s.instances.get(0, s.instanceConfigs.parentOf(config["id"].(int)))
+		if err != nil {
+			continue
+		}
+
+		for _, i := range config["interfaces"].([]any) {
+			iface := i.(object)
+			if iface["purpose"] == "vpc" && iface["subnet_id"] == subnetID {
+				result = append(result, vpcInterface{instance: instance, config: config, iface: iface})
+			}
+		}
+	}
+
+	return result
+}
+
+// subnetView returns the API representation of a subnet, including
+// the Linodes currently attached to it.
+func (s *Server) subnetView(subnet object) object {
+	linodes := []object{}
+	byInstance := make(map[int]object)
+
+	for _, vi := range s.vpcInterfaces(subnet["id"].(int)) {
+		instanceID := vi.instance["id"].(int)
+
+		linode, ok := byInstance[instanceID]
+		if !ok {
+			linode = object{"id": instanceID, "interfaces": []object{}}
+			byInstance[instanceID] = linode
+			linodes = append(linodes, linode)
+		}
+
+		linode["interfaces"] = append(linode["interfaces"].([]object), object{
+			"id":     vi.iface["id"],
+			"active": vi.instance["status"] == "running",
+		})
+	}
+
+	result := make(object, len(subnet)+1)
+	for k, v := range subnet {
+		result[k] = v
+	}
+	result["linodes"] = linodes
+
+	return result
+}
+
+func (s *Server) vpcView(vpc object) object {
+	subnets := []object{}
+	for _, subnet := range s.vpcSubnets.list(vpc["id"].(int)) {
+		subnets = append(subnets, s.subnetView(subnet))
+	}
+
+	result := make(object, len(vpc)+1)
+	for k, v := range vpc {
+		result[k] = v
+	}
+	result["subnets"] = subnets
+
+	return result
+}
+
+// instanceVPCIPs returns the VPC IP addresses of the given instance
+// in the format returned by the instance IPs endpoint.
+func (s *Server) instanceVPCIPs(instance object) []object {
+	result := []object{}
+
+	for _, config := range s.instanceConfigs.list(instance["id"].(int)) {
+		for _, i := range config["interfaces"].([]any) {
+			iface := i.(object)
+			if iface["purpose"] != "vpc" {
+				continue
+			}
+
+			ipv4, _ := iface["ipv4"].(object)
+			address := ipv4["vpc"]
+
+			subnet, err := s.vpcSubnets.get(0, intOr(iface["subnet_id"], 0))
+			if err != nil {
+				continue
+			}
+
+			prefix, _ := netip.ParsePrefix(subnet["ipv4"].(string))
+
+			result = append(result, object{
+				"address":       address,
+				"address_range": nil,
+				"gateway":       prefix.Addr().Next().String(),
+				"subnet_mask":   "255.255.255.0",
+				"prefix":        prefix.Bits(),
+				"linode_id":     instance["id"],
+				"region":        instance["region"],
+				"active":        instance["status"] == "running",
+				"nat_1_1":       ipv4["nat_1_1"],
+				"vpc_id":        iface["vpc_id"],
+				"subnet_id":     iface["subnet_id"],
+				"config_id":     config["id"],
+				"interface_id":  iface["id"],
+			})
+		}
+	}
+
+	return result
+}
+
+func (s *Server) vpcFromPath(r *http.Request) (object, error) {
+	id, err := pathInt(r, "id")
+	if err != nil {
+		return nil, err
+	}
+
+	return s.vpcs.get(0, id)
+}
+
+func (s *Server) listVPCs(r *http.Request) (any, error) {
+	vpcs := s.vpcs.list(0)
+
+	result := make([]object, len(vpcs))
+	for i, vpc := range vpcs {
+		result[i] = s.vpcView(vpc)
+	}
+
+	return result, nil
+}
+
+func (s *Server) getVPC(r *http.Request) (any, error) {
+	vpc, err := s.vpcFromPath(r)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.vpcView(vpc), nil
+}
+
+func (s *Server) createVPC(r *http.Request) (any, error) {
+	body, err := decodeBody(r)
+	if err != nil {
+		return nil, err
+	}
+
+	label := stringOr(body["label"], "")
+	if label == "" {
+		return nil, errBadRequest("label", "Label is required.")
+	}
+
+	region := stringOr(body["region"], "")
+	if _, ok := s.regions[region]; !ok {
+		return nil, errBadRequest("region", "region is not valid")
+	}
+
+	var subnetOpts []object
+	for _, o := range stringList(body["subnets"]) {
+		opts, ok := o.(map[string]any)
+		if !ok {
+			continue
+		}
+
+		if err := validateSubnetIPv4(opts); err != nil {
+			return nil, err
+		}
+
+		subnetOpts = append(subnetOpts, opts)
+	}
+
+	vpc := object{
+		"id":          s.newID(),
+		"label":       label,
+		"description": stringOr(body["description"], ""),
+		"region":      region,
+		"created":     now(),
+		"updated":     now(),
+	}
+
+	s.vpcs.insert(0, vpc)
+
+	for _, opts := range subnetOpts {
+		s.insertVPCSubnet(vpc, opts)
+	}
+
+	return s.vpcView(vpc), nil
+}
+
+func (s *Server) updateVPC(r *http.Request) (any, error) {
+	vpc, err := s.vpcFromPath(r)
+	if err != nil {
+		return nil, err
+	}
+
+	body, err := decodeBody(r)
+	if err != nil {
+		return nil, err
+	}
+
+	merge(vpc, body, "label", "description")
+	vpc["updated"] = now()
+
+	return s.vpcView(vpc), nil
+}
+
+func (s *Server) deleteVPC(r *http.Request) (any, error) {
+	vpc, err := s.vpcFromPath(r)
+	if err != nil {
+		return nil, err
+	}
+
+	id := vpc["id"].(int)
+
+	for _, subnet := range s.vpcSubnets.list(id) {
+		if len(s.vpcInterfaces(subnet["id"].(int))) > 0 {
+			return nil, errBadRequest("", "Cannot delete a VPC with subnets that have attached Linodes")
+		}
+	}
+
+	s.vpcs.delete(id)
+	s.vpcSubnets.deleteChildren(id)
+
+	return nil, nil
+}
+
+func validateSubnetIPv4(opts object) error {
+	ipv4 := stringOr(opts["ipv4"], "")
+
+	prefix, err := netip.ParsePrefix(ipv4)
+	if err != nil || !prefix.Addr().Is4() || !prefix.Addr().IsPrivate() {
+		return errBadRequest("ipv4", fmt.Sprintf("%q must be a private IPv4 range in CIDR notation", ipv4))
+	}
+
+	return nil
+}
+
+func (s *Server) insertVPCSubnet(vpc object, opts object) object {
+	subnet := object{
+		"id":      s.newID(),
+		"label":   stringOr(opts["label"], ""),
+		"ipv4":    stringOr(opts["ipv4"], ""),
+		"created": now(),
+		"updated": now(),
+	}
+
+	s.vpcSubnets.insert(vpc["id"].(int), subnet)
+
+	return subnet
+}
+
+func (s *Server) subnetFromPath(r *http.Request) (object, object, error) {
+	vpc, err := s.vpcFromPath(r)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	subnetID, err := pathInt(r, "subnetID")
+	if err != nil {
+		return nil, nil, err
+	}
+
+	subnet, err := s.vpcSubnets.get(vpc["id"].(int), subnetID)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return vpc, subnet, nil
+}
+
+func (s *Server) listVPCSubnets(r *http.Request) (any, error) {
+	vpc, err := s.vpcFromPath(r)
+	if err != nil {
+		return nil, err
+	}
+
+	subnets := s.vpcSubnets.list(vpc["id"].(int))
+
+	result := make([]object, len(subnets))
+	for i, subnet := range subnets {
+		result[i] = s.subnetView(subnet)
+	}
+
+	return result, nil
+}
+
+func (s *Server) createVPCSubnet(r *http.Request) (any, error) {
+	vpc, err := s.vpcFromPath(r)
+	if err != nil {
+		return nil, err
+	}
+
+	body, err := decodeBody(r)
+	if err != nil {
+		return nil, err
+	}
+
+	if stringOr(body["label"], "") == "" {
+		return nil, errBadRequest("label", "Label is required.")
+	}
+
+	if err := validateSubnetIPv4(body); err != nil {
+		return nil, err
+	}
+
+	return s.subnetView(s.insertVPCSubnet(vpc, body)), nil
+}
+
+func (s *Server) getVPCSubnet(r *http.Request) (any, error) {
+	_, subnet, err := s.subnetFromPath(r)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.subnetView(subnet), nil
+}
+
+func (s *Server) updateVPCSubnet(r *http.Request) (any, error) {
+	_, subnet, err := s.subnetFromPath(r)
+	if err != nil {
+		return nil, err
+	}
+
+	body, err := decodeBody(r)
+	if err != nil {
+		return nil, err
+	}
+
+	merge(subnet, body, "label")
+	subnet["updated"] = now()
+
+	return s.subnetView(subnet), nil
+}
+
+func (s *Server) deleteVPCSubnet(r *http.Request) (any, error) {
+	_, subnet, err := s.subnetFromPath(r)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(s.vpcInterfaces(subnet["id"].(int))) > 0 {
+		return nil, errBadRequest("", "Cannot delete a subnet with attached Linodes")
+	}
+
+	s.vpcSubnets.delete(subnet["id"].(int))
+
+	return nil, nil
+}
@@ -3,10 +3,10 @@ package acceptance
 import (
 	"context"
 
-	"github.com/hashicorp/terraform-plugin-framework/providerserver"
 	"github.com/hashicorp/terraform-plugin-go/tfprotov5"
 	"github.com/hashicorp/terraform-plugin-mux/tf5muxserver"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/linode/terraform-provider-linode/v2/linode"
 )
 
 var ProtoV5ProviderFactories = map[string]func() (tfprotov5.ProviderServer, error){
@@ -14,7 +14,7 @@ var ProtoV5ProviderFactories = map[string]func() (tfprotov5.ProviderServer, erro
 		ctx := context.Background()
 		providers := []func() tfprotov5.ProviderServer{
 			TestAccProviders["linode"].GRPCProvider,
-			providerserver.NewProtocol5(
+			linode.NewFrameworkProviderServer(
 				TestAccFrameworkProvider,
 			),
 		}
@@ -33,3 +33,13 @@ var HttpExternalProviders = map[string]resource.ExternalProvider{
 		Source: "hashicorp/http",
 	},
 }
+
+// SDKv2ExternalProviders provides the last release of this provider that implemented
+// the resources since migrated to the plugin framework with SDKv2, so that their
+// state upgrades can be tested.
+var SDKv2ExternalProviders = map[string]resource.ExternalProvider{
+	"linode": {
+		Source:            "linode/linode",
+		VersionConstraint: "~> 2.34.0",
+	},
+}
@@ -24,6 +24,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-testing/terraform"
 	"github.com/linode/linodego"
 	"github.com/linode/terraform-provider-linode/v2/linode"
+	"github.com/linode/terraform-provider-linode/v2/linode/acceptance/fakeapi"
 	"github.com/linode/terraform-provider-linode/v2/linode/helper"
 	"github.com/linode/terraform-provider-linode/v2/version"
 	"golang.org/x/crypto/ssh"
@@ -31,6 +32,7 @@ import (
 
 const (
 	optInTestsEnvVar         = "ACC_OPT_IN_TESTS"
+	fakeAPIEnvVar            = "LINODE_FAKE_API"
 	SkipInstanceReadyPollKey = "skip_instance_ready_poll"
 
 	runLongTestsEnvVar  = "RUN_LONG_TESTS"
@@ -54,8 +56,34 @@ var (
 	ConfigTemplates          *template.Template
 	TestImageLatest          string
 	TestImagePrevious        string
+
+	// FakeAPIServer is the in-process fake Linode API used when
+	// LINODE_FAKE_API is set. It is nil when running against the live API.
+	FakeAPIServer *fakeapi.Server
 )
 
+// initFakeAPI starts an in-process fake Linode API and points the provider
+// and test client at it if the LINODE_FAKE_API environment variable is set.
+func initFakeAPI() {
+	useFakeAPI, _ := strconv.ParseBool(os.Getenv(fakeAPIEnvVar))
+	if !useFakeAPI {
+		return
+	}
+
+	FakeAPIServer = fakeapi.NewServer()
+
+	for key, value := range map[string]string{
+		"LINODE_URL":           FakeAPIServer.URL,
+		"LINODE_TOKEN":         "fake-api-token",
+		"LINODE_API_VERSION":   "v4beta",
+		"LINODE_EVENT_POLL_MS": "100",
+	} {
+		if err := os.Setenv(key, value); err != nil {
+			log.Fatalf("failed to set %s for the fake API: %s", key, err)
+		}
+	}
+}
+
 func initOptInTests() {
 	optInTests = make(map[string]struct{})
 
@@ -105,6 +133,7 @@ func init() {
 		log.Fatalf("Failed to generate random SSH key pair for testing: %s", err)
 	}
 
+	initFakeAPI()
 	initOptInTests()
 
 	TestAccProvider = linode.Provider()
@@ -0,0 +1,43 @@
+//go:build integration || cloudinitconfig
+
+package cloudinitconfig_test
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/linode/terraform-provider-linode/v2/linode/acceptance"
+	"github.com/linode/terraform-provider-linode/v2/linode/cloudinitconfig/tmpl"
+)
+
+func TestAccDataSourceCloudInitConfig_basic(t *testing.T) {
+	t.Parallel()
+
+	dataSourceName := "data.linode_cloudinit_config.foobar"
+	instanceName := acctest.RandomWithPrefix("tf_test")
+
+	region, err := acceptance.GetRandomRegionWithCaps([]string{"Metadata"}, "core")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	rootPass := acctest.RandString(64)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acceptance.PreCheck(t) },
+		ProtoV5ProviderFactories: acceptance.ProtoV5ProviderFactories,
+		CheckDestroy:             acceptance.CheckInstanceDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: tmpl.DataBasic(t, instanceName, region, acceptance.TestImageLatest, rootPass),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet(dataSourceName, "id"),
+					resource.TestCheckResourceAttrSet(dataSourceName, "rendered"),
+					resource.TestCheckResourceAttr(dataSourceName, "part.#", "2"),
+					resource.TestCheckResourceAttr("linode_instance.foobar", "has_user_data", "true"),
+				),
+			},
+		},
+	})
+}
@@ -0,0 +1,76 @@
+package cloudinitconfig
+
+import (
+	"context"
+	"fmt"
+	"slices"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/linode/terraform-provider-linode/v2/linode/helper"
+)
+
+// imageCapabilityCloudInit is the capability of images that support cloud-init
+const imageCapabilityCloudInit = "cloud-init"
+
+func NewDataSource() datasource.DataSource {
+	return &DataSource{
+		BaseDataSource: helper.NewBaseDataSource(
+			helper.BaseDataSourceConfig{
+				Name:   "linode_cloudinit_config",
+				Schema: &frameworkDatasourceSchema,
+			},
+		),
+	}
+}
+
+type DataSource struct {
+	helper.BaseDataSource
+}
+
+func (d *DataSource) Read(
+	ctx context.Context,
+	req datasource.ReadRequest,
+	resp *datasource.ReadResponse,
+) {
+	tflog.Debug(ctx, "Read data.linode_cloudinit_config")
+
+	var data DataSourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	data.ValidateParts(&resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !data.Image.IsNull() {
+		imageID := data.Image.ValueString()
+
+		image, err := d.Meta.Client.GetImage(ctx, imageID)
+		if err != nil {
+			resp.Diagnostics.AddError(fmt.Sprintf("Failed to Get Image %s", imageID), err.Error())
+			return
+		}
+
+		if !slices.Contains(image.Capabilities, imageCapabilityCloudInit) {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("image"),
+				"Image Does Not Support Cloud-Init",
+				fmt.Sprintf("Image %s does not have the %s capability.", imageID, imageCapabilityCloudInit),
+			)
+			return
+		}
+	}
+
+	data.Render(&resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
@@ -0,0 +1,70 @@
+package cloudinitconfig
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+)
+
+var frameworkDatasourceSchema = schema.Schema{
+	Attributes: map[string]schema.Attribute{
+		"id": schema.StringAttribute{
+			Description: "The SHA-256 hash of the rendered user data.",
+			Computed:    true,
+		},
+		"gzip": schema.BoolAttribute{
+			Description: "Whether the rendered document is compressed with gzip. Defaults to true.",
+			Optional:    true,
+		},
+		"boundary": schema.StringAttribute{
+			Description: "The boundary separating the parts of the MIME multi-part document.",
+			Optional:    true,
+			Validators: []validator.String{
+				stringvalidator.LengthBetween(1, 70),
+			},
+		},
+		"image": schema.StringAttribute{
+			Description: "The ID of the image the user data is meant for, " +
+				"which is validated to support cloud-init.",
+			Optional: true,
+		},
+		"rendered": schema.StringAttribute{
+			Description: "The base64-encoded user data, which can be used as the user_data of a Linode.",
+			Computed:    true,
+		},
+	},
+	Blocks: map[string]schema.Block{
+		"part": schema.ListNestedBlock{
+			Description: "A part of the MIME multi-part document.",
+			Validators: []validator.List{
+				listvalidator.IsRequired(),
+				listvalidator.SizeAtLeast(1),
+			},
+			NestedObject: schema.NestedBlockObject{
+				Attributes: map[string]schema.Attribute{
+					"content_type": schema.StringAttribute{
+						Description: "The MIME type of the part. Defaults to text/cloud-config.",
+						Optional:    true,
+						Validators: []validator.String{
+							stringvalidator.OneOf(supportedContentTypes...),
+						},
+					},
+					"content": schema.StringAttribute{
+						Description: "The content of the part.",
+						Required:    true,
+					},
+					"filename": schema.StringAttribute{
+						Description: "The filename of the part.",
+						Optional:    true,
+					},
+					"merge_type": schema.StringAttribute{
+						Description: "How cloud-init merges the part with the previous parts, " +
+							"e.g. list(append)+dict(recurse_array).",
+						Optional: true,
+					},
+				},
+			},
+		},
+	},
+}
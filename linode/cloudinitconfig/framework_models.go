@@ -0,0 +1,181 @@
+package cloudinitconfig
+
+import (
+	"bytes"
+	"compress/gzip"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"mime/multipart"
+	"net/textproto"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"gopkg.in/yaml.v3"
+)
+
+const (
+	contentTypeCloudConfig = "text/cloud-config"
+	defaultBoundary        = "MIMEBOUNDARY"
+
+	// userDataMaxSize is the maximum size of the base64-encoded user data accepted by the API
+	userDataMaxSize = 16384
+)
+
+var supportedContentTypes = []string{
+	contentTypeCloudConfig,
+	"text/x-shellscript",
+	"text/cloud-boothook",
+	"text/part-handler",
+	"text/x-include-url",
+	"text/jinja2",
+}
+
+type DataSourceModel struct {
+	ID       types.String `tfsdk:"id"`
+	Gzip     types.Bool   `tfsdk:"gzip"`
+	Boundary types.String `tfsdk:"boundary"`
+	Image    types.String `tfsdk:"image"`
+	Rendered types.String `tfsdk:"rendered"`
+	Parts    []PartModel  `tfsdk:"part"`
+}
+
+type PartModel struct {
+	ContentType types.String `tfsdk:"content_type"`
+	Content     types.String `tfsdk:"content"`
+	Filename    types.String `tfsdk:"filename"`
+	MergeType   types.String `tfsdk:"merge_type"`
+}
+
+// contentType returns the MIME type of the part, defaulting to a cloud-config.
+func (part PartModel) contentType() string {
+	if part.ContentType.IsNull() {
+		return contentTypeCloudConfig
+	}
+	return part.ContentType.ValueString()
+}
+
+// ValidateParts validates that every cloud-config part is valid YAML.
+func (data *DataSourceModel) ValidateParts(diags *diag.Diagnostics) {
+	for i, part := range data.Parts {
+		if part.contentType() != contentTypeCloudConfig {
+			continue
+		}
+
+		if err := validateCloudConfig(part.Content.ValueString()); err != nil {
+			diags.AddAttributeError(
+				path.Root("part").AtListIndex(i).AtName("content"),
+				"Invalid Cloud Config",
+				err.Error(),
+			)
+		}
+	}
+}
+
+// Render renders the parts into the base64-encoded MIME multi-part user data.
+func (data *DataSourceModel) Render(diags *diag.Diagnostics) {
+	boundary := defaultBoundary
+	if !data.Boundary.IsNull() {
+		boundary = data.Boundary.ValueString()
+	}
+
+	rendered, err := renderMultipart(data.Parts, boundary, data.Gzip.IsNull() || data.Gzip.ValueBool())
+	if err != nil {
+		diags.AddError("Failed to Render Cloud-Init Config", err.Error())
+		return
+	}
+
+	if len(rendered) > userDataMaxSize {
+		diags.AddError(
+			"User Data Too Large",
+			fmt.Sprintf(
+				"The rendered user data is %d bytes, which exceeds the limit of %d bytes. "+
+					"Consider enabling gzip or moving large files out of the user data.",
+				len(rendered), userDataMaxSize,
+			),
+		)
+		return
+	}
+
+	hash := sha256.Sum256([]byte(rendered))
+
+	data.ID = types.StringValue(hex.EncodeToString(hash[:]))
+	data.Rendered = types.StringValue(rendered)
+}
+
+func validateCloudConfig(content string) error {
+	var document map[string]any
+	if err := yaml.Unmarshal([]byte(content), &document); err != nil {
+		return fmt.Errorf("failed to parse cloud-config as YAML: %w", err)
+	}
+
+	if document == nil {
+		return fmt.Errorf("cloud-config must be a YAML mapping")
+	}
+
+	return nil
+}
+
+func renderMultipart(parts []PartModel, boundary string, compress bool) (string, error) {
+	var buffer bytes.Buffer
+
+	var writer io.Writer = &buffer
+	var gzipWriter *gzip.Writer
+
+	if compress {
+		gzipWriter = gzip.NewWriter(&buffer)
+		writer = gzipWriter
+	}
+
+	if _, err := fmt.Fprintf(
+		writer,
+		"Content-Type: multipart/mixed; boundary=\"%s\"\r\nMIME-Version: 1.0\r\n\r\n",
+		boundary,
+	); err != nil {
+		return "", err
+	}
+
+	mimeWriter := multipart.NewWriter(writer)
+	if err := mimeWriter.SetBoundary(boundary); err != nil {
+		return "", fmt.Errorf("invalid boundary: %w", err)
+	}
+
+	for _, part := range parts {
+		header := textproto.MIMEHeader{}
+		header.Set("Content-Type", part.contentType())
+		header.Set("MIME-Version", "1.0")
+		header.Set("Content-Transfer-Encoding", "7bit")
+
+		if filename := part.Filename.ValueString(); filename != "" {
+			header.Set("Content-Disposition", fmt.Sprintf("attachment; filename=\"%s\"", filename))
+		}
+
+		if mergeType := part.MergeType.ValueString(); mergeType != "" {
+			header.Set("X-Merge-Type", mergeType)
+		}
+
+		partWriter, err := mimeWriter.CreatePart(header)
+		if err != nil {
+			return "", err
+		}
+
+		if _, err := partWriter.Write([]byte(part.Content.ValueString())); err != nil {
+			return "", err
+		}
+	}
+
+	if err := mimeWriter.Close(); err != nil {
+		return "", err
+	}
+
+	if gzipWriter != nil {
+		if err := gzipWriter.Close(); err != nil {
+			return "", err
+		}
+	}
+
+	return base64.StdEncoding.EncodeToString(buffer.Bytes()), nil
+}
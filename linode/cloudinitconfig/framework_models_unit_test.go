@@ -0,0 +1,200 @@
+//go:build unit
+
+package cloudinitconfig
+
+import (
+	"bytes"
+	"compress/gzip"
+	"encoding/base64"
+	"io"
+	"mime"
+	"mime/multipart"
+	"net/mail"
+	"net/textproto"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type renderedPart struct {
+	Header   textproto.MIMEHeader
+	FileName string
+	Content  string
+}
+
+func parseRendered(t *testing.T, rendered string, compressed bool) []renderedPart {
+	decoded, err := base64.StdEncoding.DecodeString(rendered)
+	require.NoError(t, err)
+
+	var reader io.Reader = bytes.NewReader(decoded)
+	if compressed {
+		reader, err = gzip.NewReader(reader)
+		require.NoError(t, err)
+	}
+
+	message, err := mail.ReadMessage(reader)
+	require.NoError(t, err)
+	assert.Equal(t, "1.0", message.Header.Get("MIME-Version"))
+
+	mediaType, params, err := mime.ParseMediaType(message.Header.Get("Content-Type"))
+	require.NoError(t, err)
+	assert.Equal(t, "multipart/mixed", mediaType)
+
+	var parts []renderedPart
+
+	partReader := multipart.NewReader(message.Body, params["boundary"])
+	for {
+		part, err := partReader.NextPart()
+		if err == io.EOF {
+			break
+		}
+		require.NoError(t, err)
+
+		content, err := io.ReadAll(part)
+		require.NoError(t, err)
+
+		parts = append(parts, renderedPart{
+			Header:   part.Header,
+			FileName: part.FileName(),
+			Content:  string(content),
+		})
+	}
+
+	return parts
+}
+
+func TestRender(t *testing.T) {
+	data := DataSourceModel{
+		Gzip:     types.BoolNull(),
+		Boundary: types.StringNull(),
+		Parts: []PartModel{
+			{
+				ContentType: types.StringNull(),
+				Content:     types.StringValue("#cloud-config\npackage_update: true\n"),
+				Filename:    types.StringValue("cloud-config.yaml"),
+				MergeType:   types.StringValue("list(append)+dict(recurse_array)"),
+			},
+			{
+				ContentType: types.StringValue("text/x-shellscript"),
+				Content:     types.StringValue("#!/bin/bash\necho hello"),
+				Filename:    types.StringNull(),
+				MergeType:   types.StringNull(),
+			},
+		},
+	}
+
+	var diags diag.Diagnostics
+
+	data.ValidateParts(&diags)
+	data.Render(&diags)
+	require.False(t, diags.HasError(), diags.Errors())
+
+	assert.Len(t, data.ID.ValueString(), 64)
+
+	parts := parseRendered(t, data.Rendered.ValueString(), true)
+	require.Len(t, parts, 2)
+
+	assert.Equal(t, "text/cloud-config", parts[0].Header.Get("Content-Type"))
+	assert.Equal(t, "cloud-config.yaml", parts[0].FileName)
+	assert.Equal(t, "list(append)+dict(recurse_array)", parts[0].Header.Get("X-Merge-Type"))
+
+	assert.Equal(t, "text/x-shellscript", parts[1].Header.Get("Content-Type"))
+	assert.Empty(t, parts[1].Header.Get("Content-Disposition"))
+
+	assert.Equal(t, "#!/bin/bash\necho hello", parts[1].Content)
+
+	// Rendering is deterministic so the ID doesn't drift between plans
+	rendered := data.Rendered.ValueString()
+	data.Render(&diags)
+	assert.Equal(t, rendered, data.Rendered.ValueString())
+}
+
+func TestRender_uncompressed(t *testing.T) {
+	data := DataSourceModel{
+		Gzip:     types.BoolValue(false),
+		Boundary: types.StringValue("CUSTOMBOUNDARY"),
+		Parts: []PartModel{
+			{
+				ContentType: types.StringValue("text/x-shellscript"),
+				Content:     types.StringValue("#!/bin/bash\necho hello"),
+			},
+		},
+	}
+
+	var diags diag.Diagnostics
+
+	data.Render(&diags)
+	require.False(t, diags.HasError(), diags.Errors())
+
+	decoded, err := base64.StdEncoding.DecodeString(data.Rendered.ValueString())
+	require.NoError(t, err)
+	assert.Contains(t, string(decoded), "--CUSTOMBOUNDARY")
+
+	assert.Len(t, parseRendered(t, data.Rendered.ValueString(), false), 1)
+}
+
+func TestValidateParts(t *testing.T) {
+	data := DataSourceModel{
+		Parts: []PartModel{
+			{
+				ContentType: types.StringValue("text/x-shellscript"),
+				Content:     types.StringValue("not: [valid yaml"),
+			},
+			{
+				ContentType: types.StringNull(),
+				Content:     types.StringValue("packages: [curl"),
+			},
+			{
+				ContentType: types.StringValue("text/cloud-config"),
+				Content:     types.StringValue("just a string"),
+			},
+		},
+	}
+
+	var diags diag.Diagnostics
+
+	data.ValidateParts(&diags)
+	require.Len(t, diags.Errors(), 2)
+
+	assert.Equal(
+		t,
+		path.Root("part").AtListIndex(1).AtName("content"),
+		diags.Errors()[0].(diag.DiagnosticWithPath).Path(),
+	)
+	assert.Equal(
+		t,
+		path.Root("part").AtListIndex(2).AtName("content"),
+		diags.Errors()[1].(diag.DiagnosticWithPath).Path(),
+	)
+}
+
+func TestRender_sizeLimit(t *testing.T) {
+	data := DataSourceModel{
+		Gzip: types.BoolValue(false),
+		Parts: []PartModel{
+			{
+				ContentType: types.StringValue("text/x-shellscript"),
+				Content:     types.StringValue(strings.Repeat("a", userDataMaxSize)),
+			},
+		},
+	}
+
+	var diags diag.Diagnostics
+
+	data.Render(&diags)
+	require.True(t, diags.HasError())
+	assert.Equal(t, "User Data Too Large", diags.Errors()[0].Summary())
+	assert.True(t, data.Rendered.IsNull())
+
+	// The same content fits once compressed
+	data.Gzip = types.BoolValue(true)
+	diags = nil
+
+	data.Render(&diags)
+	assert.False(t, diags.HasError(), diags.Errors())
+}
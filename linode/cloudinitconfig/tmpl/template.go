@@ -0,0 +1,24 @@
+package tmpl
+
+import (
+	"testing"
+
+	"github.com/linode/terraform-provider-linode/v2/linode/acceptance"
+)
+
+type TemplateData struct {
+	Label    string
+	Region   string
+	Image    string
+	RootPass string
+}
+
+func DataBasic(t testing.TB, label, region, image, rootPass string) string {
+	return acceptance.ExecuteTemplate(t,
+		"cloudinit_config_data_basic", TemplateData{
+			Label:    label,
+			Region:   region,
+			Image:    image,
+			RootPass: rootPass,
+		})
+}
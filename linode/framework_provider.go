@@ -3,10 +3,16 @@ package linode
 import (
 	"context"
 
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
+	"github.com/hashicorp/terraform-plugin-framework/function"
 	"github.com/hashicorp/terraform-plugin-framework/provider"
 	"github.com/hashicorp/terraform-plugin-framework/provider/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/linode/terraform-provider-linode/v2/linode/account"
 	"github.com/linode/terraform-provider-linode/v2/linode/accountavailabilities"
 	"github.com/linode/terraform-provider-linode/v2/linode/accountavailability"
@@ -16,6 +22,7 @@ import (
 	"github.com/linode/terraform-provider-linode/v2/linode/backup"
 	"github.com/linode/terraform-provider-linode/v2/linode/childaccount"
 	"github.com/linode/terraform-provider-linode/v2/linode/childaccounts"
+	"github.com/linode/terraform-provider-linode/v2/linode/cloudinitconfig"
 	"github.com/linode/terraform-provider-linode/v2/linode/databasebackups"
 	"github.com/linode/terraform-provider-linode/v2/linode/databaseengines"
 	"github.com/linode/terraform-provider-linode/v2/linode/databasemysql"
@@ -30,14 +37,22 @@ import (
 	"github.com/linode/terraform-provider-linode/v2/linode/firewall"
 	"github.com/linode/terraform-provider-linode/v2/linode/firewalldevice"
 	"github.com/linode/terraform-provider-linode/v2/linode/firewalls"
+	"github.com/linode/terraform-provider-linode/v2/linode/functions"
 	"github.com/linode/terraform-provider-linode/v2/linode/helper"
 	"github.com/linode/terraform-provider-linode/v2/linode/image"
 	"github.com/linode/terraform-provider-linode/v2/linode/images"
+	"github.com/linode/terraform-provider-linode/v2/linode/instance"
+	"github.com/linode/terraform-provider-linode/v2/linode/instancebackuprestore"
+	"github.com/linode/terraform-provider-linode/v2/linode/instanceclone"
 	"github.com/linode/terraform-provider-linode/v2/linode/instancedisk"
+	"github.com/linode/terraform-provider-linode/v2/linode/instanceinterface"
 	"github.com/linode/terraform-provider-linode/v2/linode/instanceip"
+	"github.com/linode/terraform-provider-linode/v2/linode/instancemaintenance"
 	"github.com/linode/terraform-provider-linode/v2/linode/instancenetworking"
+	"github.com/linode/terraform-provider-linode/v2/linode/instancepower"
 	"github.com/linode/terraform-provider-linode/v2/linode/instancereservedipassignment"
 	"github.com/linode/terraform-provider-linode/v2/linode/instancesharedips"
+	"github.com/linode/terraform-provider-linode/v2/linode/instancesnapshot"
 	"github.com/linode/terraform-provider-linode/v2/linode/instancetype"
 	"github.com/linode/terraform-provider-linode/v2/linode/instancetypes"
 	"github.com/linode/terraform-provider-linode/v2/linode/ipv6range"
@@ -46,6 +61,7 @@ import (
 	"github.com/linode/terraform-provider-linode/v2/linode/kernels"
 	"github.com/linode/terraform-provider-linode/v2/linode/lke"
 	"github.com/linode/terraform-provider-linode/v2/linode/lkeclusters"
+	"github.com/linode/terraform-provider-linode/v2/linode/lkecontrolplaneacl"
 	"github.com/linode/terraform-provider-linode/v2/linode/lkenodepool"
 	"github.com/linode/terraform-provider-linode/v2/linode/lketypes"
 	"github.com/linode/terraform-provider-linode/v2/linode/lkeversions"
@@ -61,9 +77,12 @@ import (
 	"github.com/linode/terraform-provider-linode/v2/linode/networktransferprices"
 	"github.com/linode/terraform-provider-linode/v2/linode/obj"
 	"github.com/linode/terraform-provider-linode/v2/linode/objbucket"
+	"github.com/linode/terraform-provider-linode/v2/linode/objbucketpolicy"
 	"github.com/linode/terraform-provider-linode/v2/linode/objcluster"
 	"github.com/linode/terraform-provider-linode/v2/linode/objendpoints"
 	"github.com/linode/terraform-provider-linode/v2/linode/objkey"
+	"github.com/linode/terraform-provider-linode/v2/linode/objobjects"
+	"github.com/linode/terraform-provider-linode/v2/linode/objsync"
 	"github.com/linode/terraform-provider-linode/v2/linode/placementgroup"
 	"github.com/linode/terraform-provider-linode/v2/linode/placementgroupassignment"
 	"github.com/linode/terraform-provider-linode/v2/linode/placementgroups"
@@ -89,6 +108,11 @@ import (
 	"github.com/linode/terraform-provider-linode/v2/linode/vpcsubnets"
 )
 
+var (
+	_ provider.ProviderWithEphemeralResources = &FrameworkProvider{}
+	_ provider.ProviderWithFunctions          = &FrameworkProvider{}
+)
+
 type FrameworkProvider struct {
 	ProviderVersion string
 	Meta            *helper.FrameworkProviderMeta
@@ -211,6 +235,44 @@ func (p *FrameworkProvider) Schema(
 					"and versions will be force deleted.",
 			},
 		},
+		Blocks: map[string]schema.Block{
+			"retry": schema.ListNestedBlock{
+				Description: "Retry policies applied to Linode API requests, in addition to the built-in ones.",
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"status_codes": schema.ListAttribute{
+							ElementType: types.Int64Type,
+							Required:    true,
+							Description: "The HTTP status codes of the responses to retry.",
+							Validators: []validator.List{
+								listvalidator.SizeAtLeast(1),
+								listvalidator.ValueInt64sAre(int64validator.Between(100, 599)),
+							},
+						},
+						"path_pattern": schema.StringAttribute{
+							Optional: true,
+							Description: "A regular expression matched against the path of the request URL. " +
+								"Matches all requests if unset.",
+						},
+						"max_attempts": schema.Int64Attribute{
+							Optional:    true,
+							Description: "The maximum number of attempts for a matching request, including the first one.",
+							Validators: []validator.Int64{
+								int64validator.AtLeast(1),
+							},
+						},
+						"backoff_ms": schema.Int64Attribute{
+							Optional: true,
+							Description: "The delay in milliseconds between attempts. Uses the exponential backoff " +
+								"bounded by min_retry_delay_ms and max_retry_delay_ms if unset.",
+							Validators: []validator.Int64{
+								int64validator.AtLeast(0),
+							},
+						},
+					},
+				},
+			},
+		},
 	}
 }
 
@@ -220,10 +282,12 @@ func (p *FrameworkProvider) Resources(ctx context.Context) []func() resource.Res
 		firewall.NewResource,
 		firewalldevice.NewResource,
 		image.NewResource,
+		instance.NewResource,
 		instancedisk.NewResource,
 		instanceip.NewResource,
 		instancesharedips.NewResource,
 		ipv6range.NewResource,
+		lke.NewResource,
 		lkenodepool.NewResource,
 		nb.NewResource,
 		nbconfig.NewResource,
@@ -244,6 +308,15 @@ func (p *FrameworkProvider) Resources(ctx context.Context) []func() resource.Res
 		networkingipassignment.NewResource,
 		obj.NewResource,
 		databasemysqlv2.NewResource,
+		objbucketpolicy.NewResource,
+		objsync.NewResource,
+		instanceclone.NewResource,
+		instancesnapshot.NewResource,
+		instancebackuprestore.NewResource,
+		instancemaintenance.NewResource,
+		instancepower.NewResource,
+		instanceinterface.NewResource,
+		lkecontrolplaneacl.NewResource,
 	}
 }
 
@@ -316,5 +389,23 @@ func (p *FrameworkProvider) DataSources(ctx context.Context) []func() datasource
 		databasemysqlv2.NewDataSource,
 		databasepostgresqlv2.NewDataSource,
 		objendpoints.NewDataSource,
+		objobjects.NewDataSource,
+		cloudinitconfig.NewDataSource,
+	}
+}
+
+func (p *FrameworkProvider) EphemeralResources(ctx context.Context) []func() ephemeral.EphemeralResource {
+	return []func() ephemeral.EphemeralResource{
+		lke.NewEphemeralResource,
+		objkey.NewEphemeralResource,
+	}
+}
+
+func (p *FrameworkProvider) Functions(ctx context.Context) []func() function.Function {
+	return []func() function.Function{
+		functions.NewCIDRInVPCSubnetFunction,
+		functions.NewImportIDFunction,
+		functions.NewObjEndpointRegionFunction,
+		functions.NewParseKubeconfigFunction,
 	}
 }
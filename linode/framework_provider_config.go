@@ -57,6 +57,7 @@ func (fp *FrameworkProvider) Configure(
 
 	resp.ResourceData = &meta
 	resp.DataSourceData = &meta
+	resp.EphemeralResourceData = &meta
 
 	fp.Meta = &meta
 }
@@ -333,6 +334,11 @@ func (fp *FrameworkProvider) InitLinodeClient(
 
 	helper.ApplyAllRetryConditions(&client)
 
+	if err := helper.ApplyRetryConfigs(&client, lpm.ExpandRetryConfigs()); err != nil {
+		diags.AddError("Failed to apply retry policies", err.Error())
+		return nil
+	}
+
 	return &client
 }
 
@@ -0,0 +1,54 @@
+package linode
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/provider"
+	"github.com/hashicorp/terraform-plugin-framework/providerserver"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov5"
+)
+
+// legacyTypeSystemResources are the framework resources that were migrated from SDKv2
+// and still compute nested blocks that aren't configured, as their SDKv2 implementations did.
+var legacyTypeSystemResources = map[string]bool{
+	"linode_instance": true,
+}
+
+// NewFrameworkProviderServer returns a protocol version 5 server for the given framework provider.
+func NewFrameworkProviderServer(p provider.Provider) func() tfprotov5.ProviderServer {
+	return func() tfprotov5.ProviderServer {
+		return &legacyTypeSystemServer{
+			ProviderServer: providerserver.NewProtocol5(p)(),
+		}
+	}
+}
+
+// legacyTypeSystemServer flags the plans and new states of the legacyTypeSystemResources
+// the same way SDKv2 does, so that Terraform tolerates their computed nested blocks.
+type legacyTypeSystemServer struct {
+	tfprotov5.ProviderServer
+}
+
+func (s *legacyTypeSystemServer) PlanResourceChange(
+	ctx context.Context,
+	req *tfprotov5.PlanResourceChangeRequest,
+) (*tfprotov5.PlanResourceChangeResponse, error) {
+	resp, err := s.ProviderServer.PlanResourceChange(ctx, req)
+	if resp != nil && legacyTypeSystemResources[req.TypeName] {
+		resp.UnsafeToUseLegacyTypeSystem = true //nolint:staticcheck
+	}
+
+	return resp, err
+}
+
+func (s *legacyTypeSystemServer) ApplyResourceChange(
+	ctx context.Context,
+	req *tfprotov5.ApplyResourceChangeRequest,
+) (*tfprotov5.ApplyResourceChangeResponse, error) {
+	resp, err := s.ProviderServer.ApplyResourceChange(ctx, req)
+	if resp != nil && legacyTypeSystemResources[req.TypeName] {
+		resp.UnsafeToUseLegacyTypeSystem = true //nolint:staticcheck
+	}
+
+	return resp, err
+}
@@ -0,0 +1,70 @@
+package functions
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/linode/terraform-provider-linode/v2/linode/helper"
+)
+
+var _ function.Function = &CIDRInVPCSubnetFunction{}
+
+func NewCIDRInVPCSubnetFunction() function.Function {
+	return &CIDRInVPCSubnetFunction{}
+}
+
+// CIDRInVPCSubnetFunction checks whether an address or range lies within
+// a VPC subnet.
+type CIDRInVPCSubnetFunction struct{}
+
+func (f *CIDRInVPCSubnetFunction) Metadata(
+	ctx context.Context,
+	req function.MetadataRequest,
+	resp *function.MetadataResponse,
+) {
+	resp.Name = "cidr_in_vpc_subnet"
+}
+
+func (f *CIDRInVPCSubnetFunction) Definition(
+	ctx context.Context,
+	req function.DefinitionRequest,
+	resp *function.DefinitionResponse,
+) {
+	resp.Definition = function.Definition{
+		Summary: "Check whether an address or range is within a VPC subnet",
+		Description: "Returns true if the given IP address or CIDR range falls entirely within " +
+			"the given VPC subnet range, e.g. the `ipv4` attribute of a `linode_vpc_subnet`.",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:        "cidr",
+				Description: "The IP address or CIDR range to check.",
+			},
+			function.StringParameter{
+				Name:        "subnet",
+				Description: "The CIDR range of the VPC subnet.",
+			},
+		},
+		Return: function.BoolReturn{},
+	}
+}
+
+func (f *CIDRInVPCSubnetFunction) Run(
+	ctx context.Context,
+	req function.RunRequest,
+	resp *function.RunResponse,
+) {
+	var cidr, subnet string
+
+	resp.Error = req.Arguments.Get(ctx, &cidr, &subnet)
+	if resp.Error != nil {
+		return
+	}
+
+	result, err := helper.CIDRContainsCIDR(subnet, cidr)
+	if err != nil {
+		resp.Error = function.NewFuncError(err.Error())
+		return
+	}
+
+	resp.Error = resp.Result.Set(ctx, result)
+}
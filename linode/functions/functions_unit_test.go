@@ -0,0 +1,139 @@
+//go:build unit
+
+package functions
+
+import (
+	"context"
+	"encoding/base64"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const testKubeconfig = `apiVersion: v1
+kind: Config
+clusters:
+- name: lke12345
+  cluster:
+    certificate-authority-data: Zm9vYmFy
+    server: https://12345.us-east-1.linodelke.net:443
+users:
+- name: lke12345-admin
+  user:
+    token: abcdef123456
+contexts:
+- name: lke12345-ctx
+  context:
+    cluster: lke12345
+    user: lke12345-admin
+current-context: lke12345-ctx
+`
+
+func runFunction(
+	t *testing.T,
+	f function.Function,
+	result attr.Value,
+	args ...attr.Value,
+) function.RunResponse {
+	t.Helper()
+
+	resp := function.RunResponse{
+		Result: function.NewResultData(result),
+	}
+
+	f.Run(context.Background(), function.RunRequest{
+		Arguments: function.NewArgumentsData(args),
+	}, &resp)
+
+	return resp
+}
+
+func TestParseKubeconfigFunction(t *testing.T) {
+	encoded := base64.StdEncoding.EncodeToString([]byte(testKubeconfig))
+
+	resp := runFunction(
+		t, NewParseKubeconfigFunction(),
+		types.ObjectUnknown(kubeconfigObjectType.AttrTypes),
+		types.StringValue(encoded),
+	)
+	require.Nil(t, resp.Error)
+
+	result := resp.Result.Value().(types.Object).Attributes()
+	assert.Equal(t, types.StringValue("https://12345.us-east-1.linodelke.net:443"), result["host"])
+	assert.Equal(t, types.StringValue("foobar"), result["cluster_ca_certificate"])
+	assert.Equal(t, types.StringValue("abcdef123456"), result["token"])
+	assert.Equal(t, types.StringValue("lke12345-ctx"), result["context"])
+
+	resp = runFunction(
+		t, NewParseKubeconfigFunction(),
+		types.ObjectUnknown(kubeconfigObjectType.AttrTypes),
+		types.StringValue("not base64!"),
+	)
+	assert.NotNil(t, resp.Error)
+}
+
+func TestObjEndpointRegionFunction(t *testing.T) {
+	testCases := map[string]string{
+		"us-mia-1.linodeobjects.com":                   "us-mia",
+		"https://us-iad-10.linodeobjects.com":          "us-iad",
+		"my-bucket.gb-lon-1.linodeobjects.com":         "gb-lon",
+		"https://my-bucket.br-gru-1.linodeobjects.com": "br-gru",
+	}
+
+	for endpoint, expected := range testCases {
+		resp := runFunction(t, NewObjEndpointRegionFunction(), types.StringUnknown(), types.StringValue(endpoint))
+		require.Nil(t, resp.Error, endpoint)
+		assert.Equal(t, types.StringValue(expected), resp.Result.Value(), endpoint)
+	}
+
+	resp := runFunction(t, NewObjEndpointRegionFunction(), types.StringUnknown(), types.StringValue("example.com"))
+	assert.NotNil(t, resp.Error)
+}
+
+func TestImportIDFunction(t *testing.T) {
+	resp := runFunction(
+		t, NewImportIDFunction(),
+		types.ListUnknown(types.StringType),
+		types.StringValue("123, 456"),
+	)
+	require.Nil(t, resp.Error)
+
+	expected := types.ListValueMust(types.StringType, []attr.Value{
+		types.StringValue("123"),
+		types.StringValue("456"),
+	})
+	assert.True(t, expected.Equal(resp.Result.Value()))
+
+	resp = runFunction(
+		t, NewImportIDFunction(),
+		types.ListUnknown(types.StringType),
+		types.StringValue("123,"),
+	)
+	assert.NotNil(t, resp.Error)
+}
+
+func TestCIDRInVPCSubnetFunction(t *testing.T) {
+	resp := runFunction(
+		t, NewCIDRInVPCSubnetFunction(), types.BoolUnknown(),
+		types.StringValue("10.0.0.5"), types.StringValue("10.0.0.0/24"),
+	)
+	require.Nil(t, resp.Error)
+	assert.Equal(t, types.BoolValue(true), resp.Result.Value())
+
+	resp = runFunction(
+		t, NewCIDRInVPCSubnetFunction(), types.BoolUnknown(),
+		types.StringValue("10.0.0.0/16"), types.StringValue("10.0.0.0/24"),
+	)
+	require.Nil(t, resp.Error)
+	assert.Equal(t, types.BoolValue(false), resp.Result.Value())
+
+	resp = runFunction(
+		t, NewCIDRInVPCSubnetFunction(), types.BoolUnknown(),
+		types.StringValue("10.0.0.5"), types.StringValue("invalid"),
+	)
+	assert.NotNil(t, resp.Error)
+}
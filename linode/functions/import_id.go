@@ -0,0 +1,73 @@
+package functions
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/linode/terraform-provider-linode/v2/linode/helper"
+)
+
+var _ function.Function = &ImportIDFunction{}
+
+func NewImportIDFunction() function.Function {
+	return &ImportIDFunction{}
+}
+
+// ImportIDFunction splits a composite import ID into its parts.
+type ImportIDFunction struct{}
+
+func (f *ImportIDFunction) Metadata(
+	ctx context.Context,
+	req function.MetadataRequest,
+	resp *function.MetadataResponse,
+) {
+	resp.Name = "import_id"
+}
+
+func (f *ImportIDFunction) Definition(
+	ctx context.Context,
+	req function.DefinitionRequest,
+	resp *function.DefinitionResponse,
+) {
+	resp.Definition = function.Definition{
+		Summary: "Split a composite import ID",
+		Description: "Splits a comma-separated composite import ID, e.g. `cluster_id,pool_id`, " +
+			"into a list of its parts.",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:        "id",
+				Description: "The composite import ID to split.",
+			},
+		},
+		Return: function.ListReturn{
+			ElementType: types.StringType,
+		},
+	}
+}
+
+func (f *ImportIDFunction) Run(
+	ctx context.Context,
+	req function.RunRequest,
+	resp *function.RunResponse,
+) {
+	var id string
+
+	resp.Error = req.Arguments.Get(ctx, &id)
+	if resp.Error != nil {
+		return
+	}
+
+	parts := helper.SplitImportID(id)
+	for i, part := range parts {
+		if part == "" {
+			resp.Error = function.NewArgumentFuncError(
+				0, fmt.Sprintf("part %d of import ID %q is empty", i, id),
+			)
+			return
+		}
+	}
+
+	resp.Error = resp.Result.Set(ctx, parts)
+}
@@ -0,0 +1,65 @@
+package functions
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/linode/terraform-provider-linode/v2/linode/helper"
+)
+
+var _ function.Function = &ObjEndpointRegionFunction{}
+
+func NewObjEndpointRegionFunction() function.Function {
+	return &ObjEndpointRegionFunction{}
+}
+
+// ObjEndpointRegionFunction extracts the region from an Object Storage endpoint.
+type ObjEndpointRegionFunction struct{}
+
+func (f *ObjEndpointRegionFunction) Metadata(
+	ctx context.Context,
+	req function.MetadataRequest,
+	resp *function.MetadataResponse,
+) {
+	resp.Name = "obj_endpoint_region"
+}
+
+func (f *ObjEndpointRegionFunction) Definition(
+	ctx context.Context,
+	req function.DefinitionRequest,
+	resp *function.DefinitionResponse,
+) {
+	resp.Definition = function.Definition{
+		Summary: "Get the region of an Object Storage endpoint",
+		Description: "Returns the region of the given Object Storage endpoint or bucket hostname, " +
+			"e.g. `us-mia` for `us-mia-1.linodeobjects.com`.",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:        "endpoint",
+				Description: "The Object Storage endpoint or bucket hostname, optionally including a scheme.",
+			},
+		},
+		Return: function.StringReturn{},
+	}
+}
+
+func (f *ObjEndpointRegionFunction) Run(
+	ctx context.Context,
+	req function.RunRequest,
+	resp *function.RunResponse,
+) {
+	var endpoint string
+
+	resp.Error = req.Arguments.Get(ctx, &endpoint)
+	if resp.Error != nil {
+		return
+	}
+
+	region, err := helper.GetRegionFromS3Endpoint(endpoint)
+	if err != nil {
+		resp.Error = function.NewArgumentFuncError(0, err.Error())
+		return
+	}
+
+	resp.Error = resp.Result.Set(ctx, region)
+}
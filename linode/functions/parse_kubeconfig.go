@@ -0,0 +1,100 @@
+package functions
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/linode/terraform-provider-linode/v2/linode/helper"
+)
+
+var kubeconfigObjectType = types.ObjectType{
+	AttrTypes: map[string]attr.Type{
+		"context":                types.StringType,
+		"cluster":                types.StringType,
+		"user":                   types.StringType,
+		"host":                   types.StringType,
+		"cluster_ca_certificate": types.StringType,
+		"token":                  types.StringType,
+	},
+}
+
+var _ function.Function = &ParseKubeconfigFunction{}
+
+func NewParseKubeconfigFunction() function.Function {
+	return &ParseKubeconfigFunction{}
+}
+
+// ParseKubeconfigFunction decodes an LKE kubeconfig into the connection
+// details of its current context.
+type ParseKubeconfigFunction struct{}
+
+func (f *ParseKubeconfigFunction) Metadata(
+	ctx context.Context,
+	req function.MetadataRequest,
+	resp *function.MetadataResponse,
+) {
+	resp.Name = "parse_kubeconfig"
+}
+
+func (f *ParseKubeconfigFunction) Definition(
+	ctx context.Context,
+	req function.DefinitionRequest,
+	resp *function.DefinitionResponse,
+) {
+	resp.Definition = function.Definition{
+		Summary: "Parse an LKE kubeconfig",
+		Description: "Decodes a Base64-encoded kubeconfig, such as the `kubeconfig` attribute " +
+			"of a `linode_lke_cluster`, and returns the connection details of its current context.",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:        "kubeconfig",
+				Description: "The Base64-encoded kubeconfig to parse.",
+			},
+		},
+		Return: function.ObjectReturn{
+			AttributeTypes: kubeconfigObjectType.AttrTypes,
+		},
+	}
+}
+
+func (f *ParseKubeconfigFunction) Run(
+	ctx context.Context,
+	req function.RunRequest,
+	resp *function.RunResponse,
+) {
+	var encoded string
+
+	resp.Error = req.Arguments.Get(ctx, &encoded)
+	if resp.Error != nil {
+		return
+	}
+
+	raw, err := helper.DecodeKubeconfig(encoded)
+	if err != nil {
+		resp.Error = function.NewArgumentFuncError(0, err.Error())
+		return
+	}
+
+	kubeconfig, err := helper.ParseKubeconfig(raw)
+	if err != nil {
+		resp.Error = function.NewArgumentFuncError(0, err.Error())
+		return
+	}
+
+	result, d := types.ObjectValue(kubeconfigObjectType.AttrTypes, map[string]attr.Value{
+		"context":                types.StringValue(kubeconfig.Context),
+		"cluster":                types.StringValue(kubeconfig.Cluster),
+		"user":                   types.StringValue(kubeconfig.User),
+		"host":                   types.StringValue(kubeconfig.Host),
+		"cluster_ca_certificate": types.StringValue(kubeconfig.ClusterCACertificate),
+		"token":                  types.StringValue(kubeconfig.Token),
+	})
+	resp.Error = function.FuncErrorFromDiags(ctx, d)
+	if resp.Error != nil {
+		return
+	}
+
+	resp.Error = resp.Result.Set(ctx, result)
+}
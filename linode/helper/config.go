@@ -48,6 +48,8 @@ type Config struct {
 	LKEEventPollMilliseconds     int
 	LKENodeReadyPollMilliseconds int
 
+	Retry []RetryConfig
+
 	ObjAccessKey         string
 	ObjSecretKey         string
 	ObjUseTempKeys       bool
@@ -137,6 +139,10 @@ func (c *Config) Client(ctx context.Context) (*linodego.Client, error) {
 	client.SetUserAgent(userAgent)
 	ApplyAllRetryConditions(&client)
 
+	if err := ApplyRetryConfigs(&client, c.Retry); err != nil {
+		return nil, fmt.Errorf("failed to apply retry policies: %w", err)
+	}
+
 	// We always want to disable resty debugging in favor
 	// of Terraform transport debugging.
 	client.SetDebug(false)
@@ -0,0 +1,183 @@
+package customtypes
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"reflect"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/attr/xattr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+// Ensure the implementation satisfies the expected interfaces
+var (
+	_ basetypes.StringTypable                    = JSONStringType{}
+	_ basetypes.StringValuableWithSemanticEquals = JSONStringValue{}
+	_ xattr.TypeWithValidate                     = JSONStringType{}
+)
+
+// JSONStringType represents the type of an attribute that
+// contains a JSON document.
+type JSONStringType struct {
+	basetypes.StringType
+}
+
+func (t JSONStringType) Equal(o attr.Type) bool {
+	other, ok := o.(JSONStringType)
+
+	if !ok {
+		return false
+	}
+
+	return t.StringType.Equal(other.StringType)
+}
+
+func (t JSONStringType) String() string {
+	return "JSONStringType"
+}
+
+func (t JSONStringType) ValueFromString(
+	ctx context.Context,
+	in basetypes.StringValue,
+) (basetypes.StringValuable, diag.Diagnostics) {
+	value := JSONStringValue{
+		StringValue: in,
+	}
+
+	return value, nil
+}
+
+func (t JSONStringType) ValueFromTerraform(ctx context.Context, in tftypes.Value) (attr.Value, error) {
+	attrValue, err := t.StringType.ValueFromTerraform(ctx, in)
+	if err != nil {
+		return nil, err
+	}
+
+	stringValue, ok := attrValue.(basetypes.StringValue)
+
+	if !ok {
+		return nil, fmt.Errorf("unexpected value type of %T", attrValue)
+	}
+
+	stringValuable, diags := t.ValueFromString(ctx, stringValue)
+
+	if diags.HasError() {
+		return nil, fmt.Errorf("unexpected error converting StringValue to StringValuable: %v", diags)
+	}
+
+	return stringValuable, nil
+}
+
+func (t JSONStringType) ValueType(ctx context.Context) attr.Value {
+	return JSONStringValue{}
+}
+
+func (t JSONStringType) Validate(ctx context.Context, value tftypes.Value, valuePath path.Path) diag.Diagnostics {
+	if value.IsNull() || !value.IsKnown() {
+		return nil
+	}
+
+	var diags diag.Diagnostics
+	var valueString string
+
+	if err := value.As(&valueString); err != nil {
+		diags.AddAttributeError(
+			valuePath,
+			"Invalid Terraform Value",
+			"An unexpected error occurred while attempting to convert a Terraform value to a string. "+
+				"This generally is an issue with the provider schema implementation. "+
+				"Please contact the provider developers.\n\n"+
+				"Path: "+valuePath.String()+"\n"+
+				"Error: "+err.Error(),
+		)
+
+		return diags
+	}
+
+	if !json.Valid([]byte(valueString)) {
+		diags.AddAttributeError(
+			valuePath,
+			"Invalid JSON String Value",
+			"A string value was provided that is not valid JSON.\n"+
+				"Path: "+valuePath.String()+"\n"+
+				"Given Value: "+valueString+"\n",
+		)
+
+		return diags
+	}
+
+	return diags
+}
+
+var _ basetypes.StringValuable = JSONStringValue{}
+
+// JSONStringValue represents a string containing a JSON document.
+// This value implements semantic equality checks that ignore whitespace
+// and the order of object keys.
+type JSONStringValue struct {
+	basetypes.StringValue
+}
+
+func (v JSONStringValue) Equal(o attr.Value) bool {
+	other, ok := o.(JSONStringValue)
+
+	if !ok {
+		return false
+	}
+
+	return v.StringValue.Equal(other.StringValue)
+}
+
+func (v JSONStringValue) Type(ctx context.Context) attr.Type {
+	return JSONStringType{}
+}
+
+func (v JSONStringValue) StringSemanticEquals(
+	ctx context.Context,
+	newValuable basetypes.StringValuable,
+) (bool, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	newValue, ok := newValuable.(JSONStringValue)
+	if !ok {
+		diags.AddError(
+			"Semantic Equality Check Error",
+			"An unexpected value type was received while performing semantic equality checks. "+
+				"Please report this to the provider developers.\n\n"+
+				"Expected Value Type: "+fmt.Sprintf("%T", v)+"\n"+
+				"Got Value Type: "+fmt.Sprintf("%T", newValuable),
+		)
+
+		return false, diags
+	}
+
+	return JSONSemanticallyEqual(v.ValueString(), newValue.ValueString()), nil
+}
+
+// JSONSemanticallyEqual returns whether the two given JSON documents
+// are equal regardless of whitespace and object key order.
+func JSONSemanticallyEqual(a, b string) bool {
+	var aValue, bValue any
+
+	if err := json.Unmarshal([]byte(a), &aValue); err != nil {
+		return false
+	}
+
+	if err := json.Unmarshal([]byte(b), &bValue); err != nil {
+		return false
+	}
+
+	return reflect.DeepEqual(aValue, bValue)
+}
+
+func JSONValue(value string) JSONStringValue {
+	return JSONStringValue{
+		StringValue: types.StringValue(value),
+	}
+}
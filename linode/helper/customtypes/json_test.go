@@ -0,0 +1,56 @@
+//go:build unit
+
+package customtypes
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+func TestJSON_semanticEquals(t *testing.T) {
+	v1 := JSONValue(`{"Version": "2012-10-17", "Statement": [{"Effect": "Allow"}]}`)
+	v2 := JSONValue("{\n  \"Statement\": [\n    {\"Effect\":\"Allow\"}\n  ],\n  \"Version\":\"2012-10-17\"\n}")
+
+	equal, d := v1.StringSemanticEquals(context.Background(), v2)
+	if d.HasError() {
+		t.Fatal("Expected no errors; got some")
+	}
+
+	if !equal {
+		t.Fatal("Expected semantic equality")
+	}
+
+	v2 = JSONValue(`{"Version": "2012-10-17", "Statement": [{"Effect": "Deny"}]}`)
+
+	equal, d = v1.StringSemanticEquals(context.Background(), v2)
+	if d.HasError() {
+		t.Fatal("Expected no errors; got some")
+	}
+
+	if equal {
+		t.Fatal("Expected no semantic equality")
+	}
+}
+
+func TestJSON_validate(t *testing.T) {
+	d := JSONStringType{}.Validate(
+		context.Background(),
+		tftypes.NewValue(tftypes.String, `{"foo": "bar"}`),
+		path.Root("policy"),
+	)
+	if d.HasError() {
+		t.Fatal("Expected no errors; got some")
+	}
+
+	d = JSONStringType{}.Validate(
+		context.Background(),
+		tftypes.NewValue(tftypes.String, `{"foo": `),
+		path.Root("policy"),
+	)
+	if !d.HasError() {
+		t.Fatal("Expected an error; got none")
+	}
+}
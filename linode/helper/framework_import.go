@@ -31,6 +31,13 @@ func IDTypeConverterInt64(value string) (any, diag.Diagnostics) {
 	return result, d
 }
 
+// SplitImportID splits a composite import ID such as "123,456"
+// into its individual parts.
+func SplitImportID(id string) []string {
+	// Make sure we support spaces in the ID just in case :)
+	return strings.Split(strings.ReplaceAll(id, " ", ""), ",")
+}
+
 // ImportStatePassthroughInt64ID allows for the automatic importing of resources
 // through an int64 ID attribute. This is necessary as many Linode resources
 // use integers rather than strings as unique identifiers.
@@ -78,10 +85,7 @@ func ImportStateWithMultipleIDs(
 		strings.Join(idFieldNames, ", "), req.ID,
 	)
 
-	// Make sure we support spaces in the ID just in case :)
-	fullID := strings.ReplaceAll(req.ID, " ", "")
-
-	idParts := strings.Split(fullID, ",")
+	idParts := SplitImportID(req.ID)
 
 	if len(idParts) != len(idFields) {
 		resp.Diagnostics.AddError("Unexpected Import Identifier", unexpectedIDsErrorMsg)
@@ -28,6 +28,7 @@ func GetFrameworkProviderModelFromSDKv2ProviderConfig(config *Config) *Framework
 		ObjSecretKey:                 types.StringValue(config.ObjSecretKey),
 		ObjUseTempKeys:               types.BoolValue(config.ObjUseTempKeys),
 		ObjBucketForceDelete:         types.BoolValue(config.ObjBucketForceDelete),
+		Retry:                        flattenFrameworkRetryConfigs(config.Retry),
 	}
 }
 
@@ -60,6 +61,56 @@ type FrameworkProviderModel struct {
 	ObjSecretKey         types.String `tfsdk:"obj_secret_key"`
 	ObjUseTempKeys       types.Bool   `tfsdk:"obj_use_temp_keys"`
 	ObjBucketForceDelete types.Bool   `tfsdk:"obj_bucket_force_delete"`
+
+	Retry []FrameworkRetryModel `tfsdk:"retry"`
+}
+
+type FrameworkRetryModel struct {
+	StatusCodes         []types.Int64 `tfsdk:"status_codes"`
+	PathPattern         types.String  `tfsdk:"path_pattern"`
+	MaxAttempts         types.Int64   `tfsdk:"max_attempts"`
+	BackoffMilliseconds types.Int64   `tfsdk:"backoff_ms"`
+}
+
+// ExpandRetryConfigs converts the retry blocks of the provider into RetryConfigs.
+func (m *FrameworkProviderModel) ExpandRetryConfigs() []RetryConfig {
+	result := make([]RetryConfig, len(m.Retry))
+
+	for i, retry := range m.Retry {
+		statusCodes := make([]int, len(retry.StatusCodes))
+		for j, statusCode := range retry.StatusCodes {
+			statusCodes[j] = int(statusCode.ValueInt64())
+		}
+
+		result[i] = RetryConfig{
+			StatusCodes:         statusCodes,
+			PathPattern:         retry.PathPattern.ValueString(),
+			MaxAttempts:         int(retry.MaxAttempts.ValueInt64()),
+			BackoffMilliseconds: int(retry.BackoffMilliseconds.ValueInt64()),
+		}
+	}
+
+	return result
+}
+
+func flattenFrameworkRetryConfigs(configs []RetryConfig) []FrameworkRetryModel {
+	result := make([]FrameworkRetryModel, len(configs))
+
+	for i, config := range configs {
+		statusCodes := make([]types.Int64, len(config.StatusCodes))
+		for j, statusCode := range config.StatusCodes {
+			statusCodes[j] = types.Int64Value(int64(statusCode))
+		}
+
+		result[i] = FrameworkRetryModel{
+			StatusCodes:         statusCodes,
+			PathPattern:         types.StringValue(config.PathPattern),
+			MaxAttempts:         types.Int64Value(int64(config.MaxAttempts)),
+			BackoffMilliseconds: types.Int64Value(int64(config.BackoffMilliseconds)),
+		}
+	}
+
+	return result
 }
 
 type FrameworkProviderMeta struct {
@@ -0,0 +1,69 @@
+package helper
+
+import (
+	"context"
+	"fmt"
+	"net"
+
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+)
+
+// IPv4AddressValidator validates that a string is a plain IPv4 address.
+func IPv4AddressValidator() validator.String {
+	return ipValidator{
+		description: "value must be a valid IPv4 address",
+		validate: func(value string) error {
+			ip := net.ParseIP(value)
+			if ip == nil {
+				return fmt.Errorf("invalid ipv4 address: %s", value)
+			}
+
+			if ip.To4() == nil {
+				return fmt.Errorf("expected ipv4 address, got %s", value)
+			}
+
+			return nil
+		},
+	}
+}
+
+// IPRangeValidator validates that a string is an IPv4 or IPv6 range in CIDR notation.
+func IPRangeValidator() validator.String {
+	return ipValidator{
+		description: "value must be a valid IPv4 or IPv6 range in CIDR notation",
+		validate: func(value string) error {
+			if _, _, err := net.ParseCIDR(value); err != nil {
+				return fmt.Errorf("invalid CIDR range: %s", value)
+			}
+
+			return nil
+		},
+	}
+}
+
+type ipValidator struct {
+	description string
+	validate    func(string) error
+}
+
+func (v ipValidator) Description(ctx context.Context) string {
+	return v.description
+}
+
+func (v ipValidator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+func (v ipValidator) ValidateString(
+	ctx context.Context,
+	req validator.StringRequest,
+	resp *validator.StringResponse,
+) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		return
+	}
+
+	if err := v.validate(req.ConfigValue.ValueString()); err != nil {
+		resp.Diagnostics.AddAttributeError(req.Path, "Invalid IP Address", err.Error())
+	}
+}
@@ -134,16 +134,47 @@ func IsInstanceInBootedState(status linodego.InstanceStatus) bool {
 
 // GetCurrentBootedConfig gets the config a linode instance is current booted to
 func GetCurrentBootedConfig(ctx context.Context, client *linodego.Client, instID int) (int, error) {
-	inst, err := client.GetInstance(ctx, instID)
+	event, err := getLatestBootEvent(ctx, client, instID)
 	if err != nil {
 		return 0, err
 	}
 
 	// Valid exit condition where no config is booted
-	if !IsInstanceInBootedState(inst.Status) {
+	if event == nil {
+		return 0, nil
+	}
+
+	// Special case for instances booted into rescue mode
+	if event.SecondaryEntity == nil {
 		return 0, nil
 	}
 
+	return int(event.SecondaryEntity.ID.(float64)), nil
+}
+
+// IsInstanceInRescueMode returns whether the linode instance is currently booted into rescue mode.
+func IsInstanceInRescueMode(ctx context.Context, client *linodego.Client, instID int) (bool, error) {
+	event, err := getLatestBootEvent(ctx, client, instID)
+	if err != nil {
+		return false, err
+	}
+
+	// Rescue boots are the only boot events without a config secondary entity
+	return event != nil && event.SecondaryEntity == nil, nil
+}
+
+// getLatestBootEvent returns the most recent boot or reboot event of a booted instance,
+// or nil if the instance is not booted or no such event could be found.
+func getLatestBootEvent(ctx context.Context, client *linodego.Client, instID int) (*linodego.Event, error) {
+	inst, err := client.GetInstance(ctx, instID)
+	if err != nil {
+		return nil, err
+	}
+
+	if !IsInstanceInBootedState(inst.Status) {
+		return nil, nil
+	}
+
 	filter := map[string]any{
 		"entity.id":   instID,
 		"entity.type": linodego.EntityLinode,
@@ -158,27 +189,22 @@ func GetCurrentBootedConfig(ctx context.Context, client *linodego.Client, instID
 
 	filterBytes, err := json.Marshal(filter)
 	if err != nil {
-		return 0, err
+		return nil, err
 	}
 
 	events, err := client.ListEvents(ctx, &linodego.ListOptions{
 		Filter: string(filterBytes),
 	})
 	if err != nil {
-		return 0, err
+		return nil, err
 	}
 
 	if len(events) < 1 {
 		// This is a valid exit case
-		return 0, nil
-	}
-
-	// Special case for instances booted into rescue mode
-	if events[0].SecondaryEntity == nil {
-		return 0, nil
+		return nil, nil
 	}
 
-	return int(events[0].SecondaryEntity.ID.(float64)), nil
+	return &events[0], nil
 }
 
 func FrameworkCreateRandomRootPassword(diags *fwdiag.Diagnostics) string {
@@ -347,6 +373,111 @@ func BootInstanceSync(
 	return nil
 }
 
+// RescueInstanceSync boots the instance with the given ID into rescue mode using the given
+// device map and waits for the instance to be running before returning.
+func RescueInstanceSync(
+	ctx context.Context,
+	client *linodego.Client,
+	instanceID int,
+	devices linodego.InstanceConfigDeviceMap,
+	deadlineSeconds int,
+) error {
+	ctx = tflog.SetField(ctx, "instance_id", instanceID)
+
+	tflog.Info(ctx, "Booting instance into rescue mode")
+
+	instance, err := client.GetInstance(ctx, instanceID)
+	if err != nil {
+		return fmt.Errorf("failed to get instance: %s", err)
+	}
+
+	// Rescuing a running instance results in a reboot event rather than a boot event
+	action := linodego.ActionLinodeBoot
+	if IsInstanceInBootedState(instance.Status) {
+		action = linodego.ActionLinodeReboot
+	}
+
+	p, err := client.NewEventPoller(ctx, instanceID, linodego.EntityLinode, action)
+	if err != nil {
+		return fmt.Errorf("failed to initialize event poller: %s", err)
+	}
+
+	tflog.Debug(ctx, "client.RescueInstance(...)", map[string]any{
+		"devices": devices,
+	})
+
+	if err := client.RescueInstance(ctx, instanceID, linodego.InstanceRescueOptions{
+		Devices: devices,
+	}); err != nil {
+		return fmt.Errorf("failed to boot instance into rescue mode: %s", err)
+	}
+
+	tflog.Debug(ctx, "Waiting for instance rescue boot to finish")
+
+	if _, err := p.WaitForFinished(ctx, deadlineSeconds); err != nil {
+		return fmt.Errorf("failed to wait for instance rescue boot: %s", err)
+	}
+
+	if _, err := client.WaitForInstanceStatus(
+		ctx, instanceID, linodego.InstanceRunning, deadlineSeconds,
+	); err != nil {
+		return fmt.Errorf("failed to wait for instance running: %s", err)
+	}
+
+	tflog.Debug(ctx, "Instance has finished booting into rescue mode")
+
+	return nil
+}
+
+// MigrateInstanceSync migrates the instance with the given ID and waits for the migration to
+// complete before returning. Migrations without a target region initiate a pending host migration.
+func MigrateInstanceSync(
+	ctx context.Context,
+	client *linodego.Client,
+	instanceID int,
+	opts linodego.InstanceMigrateOptions,
+	deadlineSeconds int,
+) error {
+	ctx = SetLogFieldBulk(
+		ctx,
+		map[string]any{
+			"instance_id":    instanceID,
+			"target_region":  opts.Region,
+			"migration_type": opts.Type,
+		},
+	)
+
+	tflog.Info(ctx, "Migrating instance")
+
+	action := linodego.ActionLinodeMigrate
+	if opts.Region != "" {
+		action = linodego.ActionLinodeMigrateDatacenter
+	}
+
+	p, err := client.NewEventPoller(ctx, instanceID, linodego.EntityLinode, action)
+	if err != nil {
+		return fmt.Errorf("failed to initialize event poller: %s", err)
+	}
+
+	tflog.Debug(ctx, "client.MigrateInstance(...)", map[string]any{
+		"options": opts,
+	})
+
+	if err := client.MigrateInstance(ctx, instanceID, opts); err != nil {
+		return fmt.Errorf("failed to migrate instance: %w", err)
+	}
+
+	tflog.Debug(ctx, "Waiting for instance migration to finish")
+
+	if _, err := p.WaitForFinished(ctx, deadlineSeconds); err != nil {
+		return fmt.Errorf("failed to wait for instance migration: %w", err)
+	}
+
+	tflog.Debug(ctx, "Instance has finished migrating")
+
+	return nil
+}
+
 // ShutDownInstanceSync shuts down the instance with the given ID and waits for the operation to
 // complete before returning.
 func ShutDownInstanceSync(
@@ -0,0 +1,70 @@
+package helper
+
+import (
+	"encoding/base64"
+	"fmt"
+
+	"k8s.io/client-go/tools/clientcmd"
+)
+
+// Kubeconfig contains the connection details of the current context
+// of a parsed kubeconfig.
+type Kubeconfig struct {
+	Context              string
+	Cluster              string
+	User                 string
+	Host                 string
+	ClusterCACertificate string
+	Token                string
+}
+
+// DecodeKubeconfig decodes a Base64-encoded kubeconfig as returned by the
+// Linode API for LKE clusters.
+func DecodeKubeconfig(encoded string) ([]byte, error) {
+	result, err := base64.StdEncoding.DecodeString(encoded)
+	if err != nil {
+		return nil, fmt.Errorf("failed to decode kubeconfig: %w", err)
+	}
+
+	return result, nil
+}
+
+// ParseKubeconfig parses the given raw kubeconfig and resolves the
+// cluster and user referenced by its current context.
+func ParseKubeconfig(raw []byte) (*Kubeconfig, error) {
+	config, err := clientcmd.Load(raw)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse kubeconfig: %w", err)
+	}
+
+	contextName := config.CurrentContext
+	if contextName == "" && len(config.Contexts) == 1 {
+		for name := range config.Contexts {
+			contextName = name
+		}
+	}
+
+	kubeContext, ok := config.Contexts[contextName]
+	if !ok {
+		return nil, fmt.Errorf("context %q not found in kubeconfig", contextName)
+	}
+
+	cluster, ok := config.Clusters[kubeContext.Cluster]
+	if !ok {
+		return nil, fmt.Errorf("cluster %q not found in kubeconfig", kubeContext.Cluster)
+	}
+
+	result := Kubeconfig{
+		Context:              contextName,
+		Cluster:              kubeContext.Cluster,
+		User:                 kubeContext.AuthInfo,
+		Host:                 cluster.Server,
+		ClusterCACertificate: string(cluster.CertificateAuthorityData),
+	}
+
+	if user, ok := config.AuthInfos[kubeContext.AuthInfo]; ok {
+		result.Token = user.Token
+	}
+
+	return &result, nil
+}
@@ -0,0 +1,55 @@
+//go:build unit
+
+package helper_test
+
+import (
+	"encoding/base64"
+	"testing"
+
+	"github.com/linode/terraform-provider-linode/v2/linode/helper"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const testKubeconfig = `apiVersion: v1
+kind: Config
+clusters:
+- name: lke12345
+  cluster:
+    certificate-authority-data: ` + "Zm9vYmFy" + `
+    server: https://12345.us-east-1.linodelke.net:443
+users:
+- name: lke12345-admin
+  user:
+    token: abcdef123456
+contexts:
+- name: lke12345-ctx
+  context:
+    cluster: lke12345
+    namespace: default
+    user: lke12345-admin
+current-context: lke12345-ctx
+`
+
+func TestParseKubeconfig(t *testing.T) {
+	raw, err := helper.DecodeKubeconfig(base64.StdEncoding.EncodeToString([]byte(testKubeconfig)))
+	require.NoError(t, err)
+
+	result, err := helper.ParseKubeconfig(raw)
+	require.NoError(t, err)
+
+	assert.Equal(t, "lke12345-ctx", result.Context)
+	assert.Equal(t, "lke12345", result.Cluster)
+	assert.Equal(t, "lke12345-admin", result.User)
+	assert.Equal(t, "https://12345.us-east-1.linodelke.net:443", result.Host)
+	assert.Equal(t, "foobar", result.ClusterCACertificate)
+	assert.Equal(t, "abcdef123456", result.Token)
+}
+
+func TestParseKubeconfig_invalid(t *testing.T) {
+	_, err := helper.DecodeKubeconfig("not base64!")
+	assert.Error(t, err)
+
+	_, err = helper.ParseKubeconfig([]byte("current-context: missing\n"))
+	assert.Error(t, err)
+}
@@ -34,12 +34,12 @@ func NewAPILoggerTransport(transport http.RoundTripper) *APILoggerTransport {
 // of an API request. This allows us to configure the logger without
 // creating a new logger in each implementation.
 func (t *APILoggerTransport) RoundTrip(r *http.Request) (*http.Response, error) {
-	return t.transport.RoundTrip(r.WithContext(t.createAPILoggerSubsystem(r.Context())))
+	return t.transport.RoundTrip(r.WithContext(newAPILoggerContext(r.Context())))
 }
 
-// createAPILoggerSubsystem creates an API logger subsystem
+// newAPILoggerContext creates an API logger subsystem
 // for logging raw HTTP requests.
-func (t *APILoggerTransport) createAPILoggerSubsystem(ctx context.Context) context.Context {
+func newAPILoggerContext(ctx context.Context) context.Context {
 	targetLevel := APILogLevel
 
 	// Disable the logger if no logger is defined
@@ -1,7 +1,9 @@
 package helper
 
 import (
+	"fmt"
 	"net"
+	"strings"
 )
 
 func CompareIPv6Ranges(i, v string) (bool, error) {
@@ -17,3 +19,30 @@ func CompareIPv6Ranges(i, v string) (bool, error) {
 
 	return ipi.Equal(ipv) && ipneti.Mask.String() == ipnetv.Mask.String(), nil
 }
+
+// CIDRContainsCIDR returns whether the given IP address or CIDR range
+// falls entirely within the given outer CIDR range.
+func CIDRContainsCIDR(outer, inner string) (bool, error) {
+	_, outerNet, err := net.ParseCIDR(outer)
+	if err != nil {
+		return false, err
+	}
+
+	if !strings.Contains(inner, "/") {
+		ip := net.ParseIP(inner)
+		if ip == nil {
+			return false, fmt.Errorf("invalid IP address: %s", inner)
+		}
+		return outerNet.Contains(ip), nil
+	}
+
+	innerIP, innerNet, err := net.ParseCIDR(inner)
+	if err != nil {
+		return false, err
+	}
+
+	outerOnes, outerBits := outerNet.Mask.Size()
+	innerOnes, innerBits := innerNet.Mask.Size()
+
+	return outerBits == innerBits && innerOnes >= outerOnes && outerNet.Contains(innerIP), nil
+}
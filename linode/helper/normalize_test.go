@@ -43,3 +43,31 @@ func TestCompareIPv6Ranges(t *testing.T) {
 		t.Fatalf("ranges are reported as equal despite having different masks")
 	}
 }
+
+func TestCIDRContainsCIDR(t *testing.T) {
+	testCases := []struct {
+		outer, inner string
+		expected     bool
+	}{
+		{"10.0.0.0/24", "10.0.0.5", true},
+		{"10.0.0.0/24", "10.0.1.5", false},
+		{"10.0.0.0/16", "10.0.4.0/24", true},
+		{"10.0.0.0/24", "10.0.0.0/16", false},
+		{"10.0.0.0/24", "2001:db8::/64", false},
+	}
+
+	for _, tc := range testCases {
+		result, err := helper.CIDRContainsCIDR(tc.outer, tc.inner)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if result != tc.expected {
+			t.Fatalf("expected %s in %s to be %v, got %v", tc.inner, tc.outer, tc.expected, result)
+		}
+	}
+
+	if _, err := helper.CIDRContainsCIDR("10.0.0.0/24", "not-an-ip"); err == nil {
+		t.Fatalf("expected error for invalid address")
+	}
+}
@@ -27,6 +27,48 @@ func GetRegionOrCluster(d *schema.ResourceData) (regionOrCluster string) {
 	return
 }
 
+// GetRegionFromCluster returns the region of the given Object Storage
+// cluster, e.g. "us-mia" for "us-mia-1".
+func GetRegionFromCluster(cluster string) (string, error) {
+	s := strings.Split(cluster, "-")
+	if len(s) <= 2 {
+		return "", fmt.Errorf("failed to parse cluster %q", cluster)
+	}
+	return strings.Join(s[:2], "-"), nil
+}
+
+// GetClusterFromS3Endpoint returns the Object Storage cluster of the given
+// S3 endpoint. The endpoint may contain a scheme, a port and a bucket prefix,
+// e.g. "https://my-bucket.us-mia-1.linodeobjects.com:443".
+func GetClusterFromS3Endpoint(endpoint string) (string, error) {
+	host := endpoint
+	if _, after, found := strings.Cut(host, "://"); found {
+		host = after
+	}
+	host, _, _ = strings.Cut(host, "/")
+	host, _, _ = strings.Cut(host, ":")
+
+	labels := strings.Split(strings.ToLower(host), ".")
+	for i, label := range labels {
+		if label == "linodeobjects" && i > 0 {
+			return labels[i-1], nil
+		}
+	}
+
+	return "", fmt.Errorf("%q is not a Linode Object Storage endpoint", endpoint)
+}
+
+// GetRegionFromS3Endpoint returns the region of the given S3 endpoint,
+// e.g. "us-mia" for "us-mia-1.linodeobjects.com".
+func GetRegionFromS3Endpoint(endpoint string) (string, error) {
+	cluster, err := GetClusterFromS3Endpoint(endpoint)
+	if err != nil {
+		return "", err
+	}
+
+	return GetRegionFromCluster(cluster)
+}
+
 func FwS3Connection(ctx context.Context, endpoint, accessKey, secretKey string, diags *diag.Diagnostics) *s3.Client {
 	s3client, err := S3Connection(ctx, endpoint, accessKey, secretKey)
 	if err != nil {
@@ -155,6 +197,57 @@ func PurgeAllObjects(
 	return err
 }
 
+// ReleaseAllLegalHolds turns off the legal hold of every object version in
+// the given bucket so that they can be deleted. Buckets without Object Lock
+// can't hold any object and are left untouched.
+func ReleaseAllLegalHolds(ctx context.Context, bucket string, s3client *s3.Client) error {
+	_, err := s3client.GetObjectLockConfiguration(ctx, &s3.GetObjectLockConfigurationInput{
+		Bucket: aws.String(bucket),
+	})
+	if err != nil {
+		var apiErr smithy.APIError
+		if errors.As(err, &apiErr) && apiErr.ErrorCode() == "ObjectLockConfigurationNotFoundError" {
+			return nil
+		}
+
+		return fmt.Errorf("failed to get object lock configuration of bucket %s: %w", bucket, err)
+	}
+
+	tflog.Debug(ctx, fmt.Sprintf("Releasing legal holds of all object versions in bucket '%s'", bucket))
+
+	paginator := s3.NewListObjectVersionsPaginator(s3client, &s3.ListObjectVersionsInput{
+		Bucket: aws.String(bucket),
+	})
+
+	for paginator.HasMorePages() {
+		page, err := paginator.NextPage(ctx)
+		if err != nil {
+			return fmt.Errorf("failed to list object versions of bucket %s: %w", bucket, err)
+		}
+
+		for _, version := range page.Versions {
+			tflog.Trace(ctx, "client.PutObjectLegalHold(...)", map[string]any{
+				"key":        aws.ToString(version.Key),
+				"version_id": aws.ToString(version.VersionId),
+			})
+
+			if _, err := s3client.PutObjectLegalHold(ctx, &s3.PutObjectLegalHoldInput{
+				Bucket:    aws.String(bucket),
+				Key:       version.Key,
+				VersionId: version.VersionId,
+				LegalHold: &s3types.ObjectLockLegalHold{Status: s3types.ObjectLockLegalHoldStatusOff},
+			}); err != nil {
+				return fmt.Errorf(
+					"failed to release legal hold of object %s version %s: %w",
+					aws.ToString(version.Key), aws.ToString(version.VersionId), err,
+				)
+			}
+		}
+	}
+
+	return nil
+}
+
 // Send delete requests for every objects.
 // Versioned objects will get a deletion marker instead of being fully purged.
 func DeleteAllObjects(
@@ -263,3 +356,42 @@ func DeleteAllObjectVersionsAndDeleteMarkers(ctx context.Context, client *s3.Cli
 	}
 	return nil
 }
+
+// ListAllObjectsV2 pages through ListObjectsV2 and returns all objects and
+// common prefixes under the given prefix. An empty delimiter disables grouping.
+func ListAllObjectsV2(
+	ctx context.Context,
+	s3client *s3.Client,
+	bucket, prefix, delimiter string,
+) ([]s3types.Object, []string, error) {
+	input := &s3.ListObjectsV2Input{
+		Bucket: aws.String(bucket),
+	}
+	if prefix != "" {
+		input.Prefix = aws.String(prefix)
+	}
+	if delimiter != "" {
+		input.Delimiter = aws.String(delimiter)
+	}
+
+	var objects []s3types.Object
+	var commonPrefixes []string
+
+	paginator := s3.NewListObjectsV2Paginator(s3client, input)
+	for paginator.HasMorePages() {
+		tflog.Trace(ctx, fmt.Sprintf("Getting next page of the list of objects in bucket '%s'", bucket))
+
+		page, err := paginator.NextPage(ctx)
+		if err != nil {
+			return nil, nil, err
+		}
+
+		objects = append(objects, page.Contents...)
+
+		for _, commonPrefix := range page.CommonPrefixes {
+			commonPrefixes = append(commonPrefixes, aws.ToString(commonPrefix.Prefix))
+		}
+	}
+
+	return objects, commonPrefixes, nil
+}
@@ -0,0 +1,118 @@
+package helper
+
+import (
+	"context"
+	"fmt"
+	"slices"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/linode/linodego"
+)
+
+// RegionCapabilityRequirement is a region capability required by a planned value.
+type RegionCapabilityRequirement struct {
+	// Capability is the region capability, e.g. linodego.CapabilityDiskEncryption.
+	Capability string
+
+	// Path is the attribute the requirement originates from.
+	Path path.Path
+
+	// Reason describes the planned value, e.g. `disk_encryption = "enabled"`.
+	Reason string
+}
+
+// TypeRegionCapabilityRequirements returns the region capabilities required to deploy
+// Linodes of the given type, blaming the attribute at the given path.
+func TypeRegionCapabilityRequirements(
+	linodeType *linodego.LinodeType, typePath path.Path,
+) []RegionCapabilityRequirement {
+	var requirements []RegionCapabilityRequirement
+
+	if linodeType.Class == linodego.ClassGPU {
+		requirements = append(requirements, RegionCapabilityRequirement{
+			Capability: linodego.CapabilityGPU,
+			Path:       typePath,
+			Reason:     fmt.Sprintf("GPU type %s", linodeType.ID),
+		})
+	}
+
+	if linodeType.Class == "premium" {
+		requirements = append(requirements, RegionCapabilityRequirement{
+			Capability: linodego.CapabilityPremiumPlans,
+			Path:       typePath,
+			Reason:     fmt.Sprintf("premium type %s", linodeType.ID),
+		})
+	}
+
+	if linodeType.AcceleratedDevices > 0 {
+		requirements = append(requirements, RegionCapabilityRequirement{
+			Capability: linodego.CapabilityQuadraT1UVPU,
+			Path:       typePath,
+			Reason: fmt.Sprintf(
+				"type %s with %d accelerated devices", linodeType.ID, linodeType.AcceleratedDevices,
+			),
+		})
+	}
+
+	return requirements
+}
+
+// MissingRegionCapabilities returns the requirements that aren't satisfied
+// by the capabilities of the given region.
+func MissingRegionCapabilities(
+	region *linodego.Region, requirements []RegionCapabilityRequirement,
+) []RegionCapabilityRequirement {
+	var missing []RegionCapabilityRequirement
+
+	for _, requirement := range requirements {
+		if !slices.Contains(region.Capabilities, requirement.Capability) {
+			missing = append(missing, requirement)
+		}
+	}
+
+	return missing
+}
+
+// ValidateRegionCapabilities adds an attribute error for every requirement that isn't
+// supported by the given region, so unsupported combinations are rejected at plan time
+// rather than by the API. Regions are cached by the client, so this is cheap to call on
+// every plan.
+func ValidateRegionCapabilities(
+	ctx context.Context,
+	client *linodego.Client,
+	regionID string,
+	requirements []RegionCapabilityRequirement,
+	diags *diag.Diagnostics,
+) {
+	if regionID == "" || len(requirements) < 1 {
+		return
+	}
+
+	tflog.Trace(ctx, "client.GetRegion(...)", map[string]any{
+		"region": regionID,
+	})
+
+	region, err := client.GetRegion(ctx, regionID)
+	if err != nil {
+		// Unknown regions are reported by the API with a more specific error
+		if linodego.IsNotFound(err) {
+			return
+		}
+
+		diags.AddError(fmt.Sprintf("Failed to Get Region %s", regionID), err.Error())
+		return
+	}
+
+	for _, requirement := range MissingRegionCapabilities(region, requirements) {
+		diags.AddAttributeError(
+			requirement.Path,
+			"Unsupported Region Capability",
+			fmt.Sprintf(
+				"Region %s does not support the %q capability required by %s.",
+				regionID, requirement.Capability, requirement.Reason,
+			),
+		)
+	}
+}
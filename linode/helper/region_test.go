@@ -0,0 +1,101 @@
+//go:build unit
+
+package helper_test
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/linode/linodego"
+	"github.com/linode/terraform-provider-linode/v2/linode/helper"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestTypeRegionCapabilityRequirements(t *testing.T) {
+	typePath := path.Root("type")
+
+	requirements := helper.TypeRegionCapabilityRequirements(&linodego.LinodeType{
+		ID:    "g6-standard-2",
+		Class: linodego.ClassStandard,
+	}, typePath)
+	assert.Empty(t, requirements)
+
+	requirements = helper.TypeRegionCapabilityRequirements(&linodego.LinodeType{
+		ID:    "g1-gpu-rtx6000-1",
+		Class: linodego.ClassGPU,
+	}, typePath)
+	require.Len(t, requirements, 1)
+	assert.Equal(t, linodego.CapabilityGPU, requirements[0].Capability)
+	assert.Equal(t, typePath, requirements[0].Path)
+
+	requirements = helper.TypeRegionCapabilityRequirements(&linodego.LinodeType{
+		ID:                 "g1-accelerated-netint-vpu-t1u1-s",
+		Class:              "accelerated",
+		AcceleratedDevices: 1,
+	}, typePath)
+	require.Len(t, requirements, 1)
+	assert.Equal(t, linodego.CapabilityQuadraT1UVPU, requirements[0].Capability)
+}
+
+func TestMissingRegionCapabilities(t *testing.T) {
+	region := &linodego.Region{
+		ID:           "us-mia",
+		Capabilities: []string{linodego.CapabilityLinodes, linodego.CapabilityDiskEncryption},
+	}
+
+	missing := helper.MissingRegionCapabilities(region, []helper.RegionCapabilityRequirement{
+		{Capability: linodego.CapabilityDiskEncryption, Path: path.Root("disk_encryption")},
+		{Capability: linodego.CapabilityGPU, Path: path.Root("type")},
+	})
+
+	require.Len(t, missing, 1)
+	assert.Equal(t, linodego.CapabilityGPU, missing[0].Capability)
+}
+
+func TestValidateRegionCapabilities(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+
+		if !strings.HasSuffix(r.URL.Path, "/regions/us-mia") {
+			w.WriteHeader(http.StatusNotFound)
+			_, _ = w.Write([]byte(`{"errors": [{"reason": "Not found"}]}`))
+			return
+		}
+
+		_ = json.NewEncoder(w).Encode(linodego.Region{
+			ID:           "us-mia",
+			Capabilities: []string{linodego.CapabilityLinodes, linodego.CapabilityBlockStorage},
+		})
+	}))
+	defer server.Close()
+
+	client := linodego.NewClient(server.Client())
+	client.SetBaseURL(server.URL)
+
+	requirements := []helper.RegionCapabilityRequirement{
+		{
+			Capability: linodego.CapabilityBlockStorageEncryption,
+			Path:       path.Root("encryption"),
+			Reason:     `encryption = "enabled"`,
+		},
+	}
+
+	var diags diag.Diagnostics
+
+	helper.ValidateRegionCapabilities(context.Background(), &client, "us-mia", requirements, &diags)
+	require.Len(t, diags.Errors(), 1)
+	assert.Equal(t, path.Root("encryption"), diags.Errors()[0].(diag.DiagnosticWithPath).Path())
+	assert.Contains(t, diags.Errors()[0].Detail(), linodego.CapabilityBlockStorageEncryption)
+
+	// Unknown regions are left to the API to report
+	diags = nil
+	helper.ValidateRegionCapabilities(context.Background(), &client, "xx-unknown", requirements, &diags)
+	assert.False(t, diags.HasError())
+}
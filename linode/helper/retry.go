@@ -1,14 +1,44 @@
 package helper
 
 import (
+	"context"
+	"fmt"
 	"log"
+	"net/http"
 	"net/url"
 	"regexp"
+	"strconv"
+	"time"
 
 	"github.com/go-resty/resty/v2"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/linode/linodego"
 )
 
+const retryAfterHeaderName = "Retry-After"
+
+// retryLimitKey is the context key of the function that stops
+// any further retries of a request.
+type retryLimitKey struct{}
+
+// RetryConfig represents a user-defined retry policy
+// configured in the `retry` block of the provider.
+type RetryConfig struct {
+	StatusCodes         []int
+	PathPattern         string
+	MaxAttempts         int
+	BackoffMilliseconds int
+}
+
+// retryPolicy is the compiled form of a RetryConfig.
+type retryPolicy struct {
+	conditions  []func(response *resty.Response, err error) bool
+	statusCodes []int
+	pathPattern string
+	maxAttempts int
+	backoff     time.Duration
+}
+
 // Workaround for intermittent 5xx errors when retrieving a database from the API
 func Database502Retry() func(response *resty.Response, err error) bool {
 	databaseGetRegex, err := regexp.Compile("[A-Za-z0-9]+/databases/[a-z]+/instances/[0-9]+")
@@ -57,3 +87,202 @@ func ApplyAllRetryConditions(client *linodego.Client) {
 	client.AddRetryCondition(LinodeInstance500Retry())
 	client.AddRetryCondition(ImageUpload500Retry())
 }
+
+// ApplyRetryConfigs compiles the user-defined retry policies into
+// GenericRetryCondition entries and registers them on the given client.
+// The backoff of the policies replaces the default retry delay, while the
+// Retry-After header, e.g. sent with 429 responses, is always honored.
+//
+// The max_attempts of a policy also applies to the built-in retry conditions
+// of the client, so a matching request is never retried past the limit.
+func ApplyRetryConfigs(client *linodego.Client, configs []RetryConfig) error {
+	if len(configs) == 0 {
+		return nil
+	}
+
+	policies, err := compileRetryConfigs(configs)
+	if err != nil {
+		return err
+	}
+
+	for _, policy := range policies {
+		client.AddRetryCondition(policy.shouldRetry)
+	}
+
+	client.OnBeforeRequest(withRetryLimit)
+	client.OnAfterResponse(enforceMaxAttempts(policies))
+	client.SetRetryAfter(retryAfterFunc(policies))
+
+	return nil
+}
+
+// withRetryLimit gives the request a context that is canceled once the
+// request must not be retried anymore.
+// Retry conditions are evaluated in order and any of them can retry a request,
+// so canceling the context is the only way to stop the retries of a request
+// before the conditions are evaluated.
+func withRetryLimit(request *linodego.Request) error {
+	if _, ok := request.Context().Value(retryLimitKey{}).(context.CancelFunc); ok {
+		return nil
+	}
+
+	ctx, cancel := context.WithCancel(request.Context())
+	request.SetContext(context.WithValue(ctx, retryLimitKey{}, cancel))
+
+	return nil
+}
+
+// enforceMaxAttempts stops the retries of a response matching a policy
+// whose max_attempts has been reached.
+func enforceMaxAttempts(policies []*retryPolicy) func(response *linodego.Response) error {
+	return func(response *linodego.Response) error {
+		if response.Request == nil {
+			return nil
+		}
+
+		stopRetries, ok := response.Request.Context().Value(retryLimitKey{}).(context.CancelFunc)
+		if !ok {
+			return nil
+		}
+
+		for _, policy := range policies {
+			if !policy.attemptsExhausted(response) || !policy.matches(response, nil) {
+				continue
+			}
+
+			ctx := newAPILoggerContext(response.Request.Context())
+			tflog.SubsystemDebug(ctx, APILoggerSubsystem, "Maximum attempts of retry policy reached, not retrying", map[string]any{
+				"method":       response.Request.Method,
+				"url":          response.Request.URL,
+				"status_code":  response.StatusCode(),
+				"attempt":      response.Request.Attempt,
+				"max_attempts": policy.maxAttempts,
+				"status_codes": policy.statusCodes,
+				"path_pattern": policy.pathPattern,
+			})
+
+			stopRetries()
+
+			return nil
+		}
+
+		return nil
+	}
+}
+
+func compileRetryConfigs(configs []RetryConfig) ([]*retryPolicy, error) {
+	policies := make([]*retryPolicy, len(configs))
+
+	for i, config := range configs {
+		pathPattern, err := regexp.Compile(config.PathPattern)
+		if err != nil {
+			return nil, fmt.Errorf("failed to compile path_pattern of retry policy %d: %w", i, err)
+		}
+
+		policy := &retryPolicy{
+			statusCodes: config.StatusCodes,
+			pathPattern: config.PathPattern,
+			maxAttempts: config.MaxAttempts,
+			backoff:     time.Duration(config.BackoffMilliseconds) * time.Millisecond,
+		}
+
+		for _, statusCode := range config.StatusCodes {
+			policy.conditions = append(policy.conditions, GenericRetryCondition(statusCode, pathPattern))
+		}
+
+		policies[i] = policy
+	}
+
+	return policies, nil
+}
+
+// matches returns whether the given response is covered by this policy.
+func (p *retryPolicy) matches(response *resty.Response, err error) bool {
+	for _, condition := range p.conditions {
+		if condition(response, err) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// attemptsExhausted returns whether the given response was received on the
+// last attempt allowed by this policy.
+func (p *retryPolicy) attemptsExhausted(response *resty.Response) bool {
+	return p.maxAttempts > 0 && response.Request.Attempt >= p.maxAttempts
+}
+
+func (p *retryPolicy) shouldRetry(response *resty.Response, err error) bool {
+	if response == nil || response.Request == nil || p.attemptsExhausted(response) || !p.matches(response, err) {
+		return false
+	}
+
+	ctx := newAPILoggerContext(response.Request.Context())
+	ctx = SetLogFieldBulk(ctx, map[string]any{
+		"method":       response.Request.Method,
+		"url":          response.Request.URL,
+		"status_code":  response.StatusCode(),
+		"attempt":      response.Request.Attempt,
+		"max_attempts": p.maxAttempts,
+		"status_codes": p.statusCodes,
+		"path_pattern": p.pathPattern,
+	})
+
+	tflog.SubsystemDebug(ctx, APILoggerSubsystem, "Request matched retry policy, retrying")
+
+	return true
+}
+
+// retryAfterFunc returns the delay before retrying a request, preferring the
+// Retry-After header over the backoff of the matching policy.
+// A zero delay falls back to the default exponential backoff of the client.
+func retryAfterFunc(policies []*retryPolicy) linodego.RetryAfter {
+	return func(_ *resty.Client, response *resty.Response) (time.Duration, error) {
+		ctx := context.Background()
+		if response.Request != nil {
+			ctx = response.Request.Context()
+		}
+		ctx = newAPILoggerContext(ctx)
+
+		if delay, ok := parseRetryAfter(response); ok {
+			tflog.SubsystemDebug(ctx, APILoggerSubsystem, "Respecting Retry-After header", map[string]any{
+				"status_code": response.StatusCode(),
+				"delay":       delay.String(),
+			})
+			return delay, nil
+		}
+
+		for _, policy := range policies {
+			if policy.backoff > 0 && policy.matches(response, nil) {
+				tflog.SubsystemDebug(ctx, APILoggerSubsystem, "Using backoff of retry policy", map[string]any{
+					"status_code": response.StatusCode(),
+					"delay":       policy.backoff.String(),
+				})
+				return policy.backoff, nil
+			}
+		}
+
+		return 0, nil
+	}
+}
+
+// parseRetryAfter parses the Retry-After header of a response,
+// which is either a number of seconds or an HTTP date.
+func parseRetryAfter(response *resty.Response) (time.Duration, bool) {
+	value := response.Header().Get(retryAfterHeaderName)
+	if value == "" {
+		return 0, false
+	}
+
+	if seconds, err := strconv.Atoi(value); err == nil {
+		return time.Duration(seconds) * time.Second, seconds > 0
+	}
+
+	if date, err := http.ParseTime(value); err == nil {
+		delay := time.Until(date)
+		return delay, delay > 0
+	}
+
+	return 0, false
+}
@@ -0,0 +1,178 @@
+//go:build unit
+
+package helper_test
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/linode/linodego"
+	"github.com/linode/terraform-provider-linode/v2/linode/helper"
+)
+
+func newRetryTestClient(t *testing.T, handler http.HandlerFunc, configs []helper.RetryConfig) *linodego.Client {
+	server := httptest.NewServer(handler)
+	t.Cleanup(server.Close)
+
+	client := linodego.NewClient(server.Client())
+	client.SetBaseURL(server.URL)
+	client.SetRetryWaitTime(time.Millisecond)
+	client.SetRetryMaxWaitTime(10 * time.Millisecond)
+
+	if err := helper.ApplyRetryConfigs(&client, configs); err != nil {
+		t.Fatal(err)
+	}
+
+	return &client
+}
+
+func TestApplyRetryConfigs_retriesMatchingRequests(t *testing.T) {
+	var requests atomic.Int32
+
+	client := newRetryTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		if requests.Add(1) < 3 {
+			w.WriteHeader(http.StatusBadGateway)
+			return
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"id": 123}`))
+	}, []helper.RetryConfig{
+		{
+			StatusCodes:         []int{http.StatusBadGateway},
+			PathPattern:         "linode/instances/[0-9]+$",
+			MaxAttempts:         5,
+			BackoffMilliseconds: 1,
+		},
+	})
+
+	instance, err := client.GetInstance(context.Background(), 123)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if instance.ID != 123 {
+		t.Fatalf("expected instance 123, got %d", instance.ID)
+	}
+
+	if got := requests.Load(); got != 3 {
+		t.Fatalf("expected 3 requests, got %d", got)
+	}
+}
+
+func TestApplyRetryConfigs_maxAttempts(t *testing.T) {
+	var requests atomic.Int32
+
+	client := newRetryTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		requests.Add(1)
+		w.WriteHeader(http.StatusBadGateway)
+	}, []helper.RetryConfig{
+		{
+			StatusCodes: []int{http.StatusBadGateway},
+			MaxAttempts: 2,
+		},
+	})
+
+	if _, err := client.GetInstance(context.Background(), 123); err == nil {
+		t.Fatal("expected error")
+	}
+
+	if got := requests.Load(); got != 2 {
+		t.Fatalf("expected 2 requests, got %d", got)
+	}
+}
+
+func TestApplyRetryConfigs_maxAttemptsBuiltInCondition(t *testing.T) {
+	var requests atomic.Int32
+
+	// 503 responses are also retried by the built-in conditions of the client
+	client := newRetryTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		requests.Add(1)
+		w.WriteHeader(http.StatusServiceUnavailable)
+	}, []helper.RetryConfig{
+		{
+			StatusCodes: []int{http.StatusServiceUnavailable},
+			MaxAttempts: 2,
+		},
+	})
+
+	if _, err := client.GetInstance(context.Background(), 123); err == nil {
+		t.Fatal("expected error")
+	}
+
+	if got := requests.Load(); got != 2 {
+		t.Fatalf("expected 2 requests, got %d", got)
+	}
+}
+
+func TestApplyRetryConfigs_pathMismatch(t *testing.T) {
+	var requests atomic.Int32
+
+	client := newRetryTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		requests.Add(1)
+		w.WriteHeader(http.StatusBadGateway)
+	}, []helper.RetryConfig{
+		{
+			StatusCodes: []int{http.StatusBadGateway},
+			PathPattern: "volumes",
+			MaxAttempts: 3,
+		},
+	})
+
+	if _, err := client.GetInstance(context.Background(), 123); err == nil {
+		t.Fatal("expected error")
+	}
+
+	if got := requests.Load(); got != 1 {
+		t.Fatalf("expected 1 request, got %d", got)
+	}
+}
+
+func TestApplyRetryConfigs_retryAfter(t *testing.T) {
+	var requests atomic.Int32
+
+	client := newRetryTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		if requests.Add(1) < 2 {
+			w.Header().Set("Retry-After", "1")
+			w.WriteHeader(http.StatusTooManyRequests)
+			return
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"id": 123}`))
+	}, []helper.RetryConfig{
+		{
+			StatusCodes:         []int{http.StatusTooManyRequests},
+			BackoffMilliseconds: 1,
+		},
+	})
+	client.SetRetryMaxWaitTime(5 * time.Second)
+
+	start := time.Now()
+
+	if _, err := client.GetInstance(context.Background(), 123); err != nil {
+		t.Fatal(err)
+	}
+
+	if elapsed := time.Since(start); elapsed < time.Second {
+		t.Fatalf("expected the Retry-After header to be honored, retried after %s", elapsed)
+	}
+}
+
+func TestApplyRetryConfigs_invalidPathPattern(t *testing.T) {
+	client := linodego.NewClient(nil)
+
+	err := helper.ApplyRetryConfigs(&client, []helper.RetryConfig{
+		{
+			StatusCodes: []int{http.StatusBadGateway},
+			PathPattern: "[",
+		},
+	})
+	if err == nil {
+		t.Fatal("expected error")
+	}
+}
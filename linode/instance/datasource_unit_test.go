@@ -33,7 +33,7 @@ func areMapsEqual(a, b map[string]interface{}) bool {
 	return reflect.DeepEqual(a, b)
 }
 
-// Unit tests for functions in flatten.go
+// Unit tests for the flatten functions of the data source
 func TestFlattenInstanceAlerts(t *testing.T) {
 	instance := linodego.Instance{
 		ID:      123,
@@ -4,19 +4,25 @@ import (
 	"github.com/linode/linodego"
 )
 
-// expandInstanceConfigDeviceMap converts a terraform linode_instance config.*.devices map to a InstanceConfigDeviceMap
+// expandConfigDevices converts a linode_instance config.*.devices block to a InstanceConfigDeviceMap
 // for the Linode API.
-func expandInstanceConfigDeviceMap(
-	m map[string]interface{}, diskIDLabelMap map[string]int,
-) (deviceMap *linodego.InstanceConfigDeviceMap, err error) {
-	if len(m) == 0 {
-		return nil, nil
+func expandConfigDevices(
+	devices ConfigDevicesModel, diskIDLabelMap map[string]int,
+) (*linodego.InstanceConfigDeviceMap, error) {
+	deviceMap := &linodego.InstanceConfigDeviceMap{}
+	slots := map[string][]ConfigDeviceModel{
+		"sda": devices.SDA,
+		"sdb": devices.SDB,
+		"sdc": devices.SDC,
+		"sdd": devices.SDD,
+		"sde": devices.SDE,
+		"sdf": devices.SDF,
+		"sdg": devices.SDG,
+		"sdh": devices.SDH,
 	}
-	deviceMap = &linodego.InstanceConfigDeviceMap{}
-	for k, rdev := range m {
-		devSlots := rdev.([]interface{})
-		for _, rrdev := range devSlots {
-			dev := rrdev.(map[string]interface{})
+
+	for k, devSlots := range slots {
+		for _, dev := range devSlots {
 			tDevice := new(linodego.InstanceConfigDevice)
 			if err := assignConfigDevice(tDevice, dev, diskIDLabelMap); err != nil {
 				return nil, err
@@ -28,17 +34,17 @@ func expandInstanceConfigDeviceMap(
 	return deviceMap, nil
 }
 
-func expandInstanceConfigDevice(m map[string]interface{}) *linodego.InstanceConfigDevice {
-	var dev *linodego.InstanceConfigDevice
-	// be careful of `disk_label string` in m
-	if diskID, ok := m["disk_id"]; ok && diskID.(int) > 0 {
-		dev = &linodego.InstanceConfigDevice{
-			DiskID: diskID.(int),
+func expandInstanceConfigDevice(dev ConfigDeviceModel) *linodego.InstanceConfigDevice {
+	var result *linodego.InstanceConfigDevice
+	// be careful of `disk_label string` in dev
+	if diskID := dev.DiskID.ValueInt64(); diskID > 0 {
+		result = &linodego.InstanceConfigDevice{
+			DiskID: int(diskID),
 		}
-	} else if volumeID, ok := m["volume_id"]; ok && volumeID.(int) > 0 {
-		dev = &linodego.InstanceConfigDevice{
-			VolumeID: m["volume_id"].(int),
+	} else if volumeID := dev.VolumeID.ValueInt64(); volumeID > 0 {
+		result = &linodego.InstanceConfigDevice{
+			VolumeID: int(volumeID),
 		}
 	}
-	return dev
+	return result
 }
@@ -5,21 +5,24 @@ package instance
 import (
 	"testing"
 
+	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/linode/linodego"
 )
 
-func TestExpandInstanceConfigDeviceMap(t *testing.T) {
-	deviceMapInput := map[string]interface{}{
-		"sda": []interface{}{
-			map[string]interface{}{
-				"disk_id":   124458,
-				"volume_id": nil,
+func TestExpandConfigDevices(t *testing.T) {
+	devices := ConfigDevicesModel{
+		SDA: []ConfigDeviceModel{
+			{
+				DiskID:    types.Int64Value(124458),
+				DiskLabel: types.StringNull(),
+				VolumeID:  types.Int64Null(),
 			},
 		},
-		"sdb": []interface{}{
-			map[string]interface{}{
-				"disk_id":   124459,
-				"volume_id": nil,
+		SDB: []ConfigDeviceModel{
+			{
+				DiskID:    types.Int64Unknown(),
+				DiskLabel: types.StringValue("example_label_sdb"),
+				VolumeID:  types.Int64Null(),
 			},
 		},
 	}
@@ -29,13 +32,13 @@ func TestExpandInstanceConfigDeviceMap(t *testing.T) {
 		"example_label_sdb": 124459,
 	}
 
-	deviceMap, err := expandInstanceConfigDeviceMap(deviceMapInput, diskIDLabelMap)
+	deviceMap, err := expandConfigDevices(devices, diskIDLabelMap)
 	if err != nil {
 		t.Errorf("Unexpected error: %v", err)
 	}
 
 	if deviceMap == nil {
-		t.Error("Expected deviceMap to not be nil")
+		t.Fatal("Expected deviceMap to not be nil")
 	}
 
 	// Assert the DiskID for SDA and SDB
@@ -56,16 +59,33 @@ func TestExpandInstanceConfigDeviceMap(t *testing.T) {
 	}
 }
 
+func TestExpandConfigDevicesUnknownLabel(t *testing.T) {
+	devices := ConfigDevicesModel{
+		SDA: []ConfigDeviceModel{
+			{
+				DiskID:    types.Int64Unknown(),
+				DiskLabel: types.StringValue("missing"),
+				VolumeID:  types.Int64Null(),
+			},
+		},
+	}
+
+	if _, err := expandConfigDevices(devices, map[string]int{}); err == nil {
+		t.Error("Expected an error for an unknown disk label")
+	}
+}
+
 func TestExpandInstanceConfigDevice(t *testing.T) {
 	tests := []struct {
 		name string
-		m    map[string]interface{}
+		m    ConfigDeviceModel
 		want *linodego.InstanceConfigDevice
 	}{
 		{
 			name: "Valid DiskID",
-			m: map[string]interface{}{
-				"disk_id": 123,
+			m: ConfigDeviceModel{
+				DiskID:   types.Int64Value(123),
+				VolumeID: types.Int64Null(),
 			},
 			want: &linodego.InstanceConfigDevice{
 				DiskID: 123,
@@ -73,8 +93,9 @@ func TestExpandInstanceConfigDevice(t *testing.T) {
 		},
 		{
 			name: "Valid VolumeID",
-			m: map[string]interface{}{
-				"volume_id": 456,
+			m: ConfigDeviceModel{
+				DiskID:   types.Int64Null(),
+				VolumeID: types.Int64Value(456),
 			},
 			want: &linodego.InstanceConfigDevice{
 				VolumeID: 456,
@@ -82,15 +103,18 @@ func TestExpandInstanceConfigDevice(t *testing.T) {
 		},
 		{
 			name: "Invalid IDs",
-			m: map[string]interface{}{
-				"disk_id":   0,
-				"volume_id": -1,
+			m: ConfigDeviceModel{
+				DiskID:   types.Int64Value(0),
+				VolumeID: types.Int64Value(-1),
 			},
 			want: nil,
 		},
 		{
 			name: "No IDs",
-			m:    map[string]interface{}{},
+			m: ConfigDeviceModel{
+				DiskID:   types.Int64Null(),
+				VolumeID: types.Int64Null(),
+			},
 			want: nil,
 		},
 	}
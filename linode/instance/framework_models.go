@@ -0,0 +1,1123 @@
+package instance
+
+import (
+	"context"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/linode/linodego"
+	"github.com/linode/terraform-provider-linode/v2/linode/helper"
+)
+
+type ResourceModel struct {
+	ID                              types.String          `tfsdk:"id"`
+	Image                           types.String          `tfsdk:"image"`
+	BackupID                        types.Int64           `tfsdk:"backup_id"`
+	StackScriptID                   types.Int64           `tfsdk:"stackscript_id"`
+	StackScriptData                 types.Map             `tfsdk:"stackscript_data"`
+	Label                           types.String          `tfsdk:"label"`
+	Group                           types.String          `tfsdk:"group"`
+	Tags                            types.Set             `tfsdk:"tags"`
+	Capabilities                    types.Set             `tfsdk:"capabilities"`
+	BootConfigLabel                 types.String          `tfsdk:"boot_config_label"`
+	Region                          types.String          `tfsdk:"region"`
+	Type                            types.String          `tfsdk:"type"`
+	ResizeDisk                      types.Bool            `tfsdk:"resize_disk"`
+	MigrationType                   types.String          `tfsdk:"migration_type"`
+	Status                          types.String          `tfsdk:"status"`
+	IPAddress                       types.String          `tfsdk:"ip_address"`
+	IPv6                            types.String          `tfsdk:"ipv6"`
+	IPv4                            types.Set             `tfsdk:"ipv4"`
+	PrivateIP                       types.Bool            `tfsdk:"private_ip"`
+	PrivateIPAddress                types.String          `tfsdk:"private_ip_address"`
+	AuthorizedKeys                  types.List            `tfsdk:"authorized_keys"`
+	AuthorizedUsers                 types.List            `tfsdk:"authorized_users"`
+	RootPass                        types.String          `tfsdk:"root_pass"`
+	SwapSize                        types.Int64           `tfsdk:"swap_size"`
+	BackupsEnabled                  types.Bool            `tfsdk:"backups_enabled"`
+	WatchdogEnabled                 types.Bool            `tfsdk:"watchdog_enabled"`
+	HostUUID                        types.String          `tfsdk:"host_uuid"`
+	Booted                          types.Bool            `tfsdk:"booted"`
+	Rescue                          types.Bool            `tfsdk:"rescue"`
+	FirewallID                      types.Int64           `tfsdk:"firewall_id"`
+	SharedIPv4                      types.Set             `tfsdk:"shared_ipv4"`
+	PlacementGroupExternallyManaged types.Bool            `tfsdk:"placement_group_externally_managed"`
+	HasUserData                     types.Bool            `tfsdk:"has_user_data"`
+	DiskEncryption                  types.String          `tfsdk:"disk_encryption"`
+	LKEClusterID                    types.Int64           `tfsdk:"lke_cluster_id"`
+	Specs                           types.List            `tfsdk:"specs"`
+	Backups                         types.List            `tfsdk:"backups"`
+	RescueDevice                    []RescueDeviceModel   `tfsdk:"rescue_device"`
+	Metadata                        []MetadataModel       `tfsdk:"metadata"`
+	PlacementGroup                  []PlacementGroupModel `tfsdk:"placement_group"`
+	Alerts                          []AlertsModel         `tfsdk:"alerts"`
+	Interface                       []InterfaceModel      `tfsdk:"interface"`
+	Config                          []ConfigModel         `tfsdk:"config"`
+	Disk                            []DiskModel           `tfsdk:"disk"`
+	Timeouts                        timeouts.Value        `tfsdk:"timeouts"`
+}
+
+type RescueDeviceModel struct {
+	DeviceName types.String `tfsdk:"device_name"`
+	DiskLabel  types.String `tfsdk:"disk_label"`
+	DiskID     types.Int64  `tfsdk:"disk_id"`
+	VolumeID   types.Int64  `tfsdk:"volume_id"`
+}
+
+type MetadataModel struct {
+	UserData types.String `tfsdk:"user_data"`
+}
+
+type PlacementGroupModel struct {
+	ID                   types.Int64  `tfsdk:"id"`
+	CompliantOnly        types.Bool   `tfsdk:"compliant_only"`
+	Label                types.String `tfsdk:"label"`
+	PlacementGroupType   types.String `tfsdk:"placement_group_type"`
+	PlacementGroupPolicy types.String `tfsdk:"placement_group_policy"`
+}
+
+type AlertsModel struct {
+	CPU           types.Int64 `tfsdk:"cpu"`
+	NetworkIn     types.Int64 `tfsdk:"network_in"`
+	NetworkOut    types.Int64 `tfsdk:"network_out"`
+	TransferQuota types.Int64 `tfsdk:"transfer_quota"`
+	IO            types.Int64 `tfsdk:"io"`
+}
+
+type InterfaceModel struct {
+	Purpose     types.String         `tfsdk:"purpose"`
+	IPAMAddress types.String         `tfsdk:"ipam_address"`
+	Label       types.String         `tfsdk:"label"`
+	ID          types.Int64          `tfsdk:"id"`
+	SubnetID    types.Int64          `tfsdk:"subnet_id"`
+	VPCID       types.Int64          `tfsdk:"vpc_id"`
+	Primary     types.Bool           `tfsdk:"primary"`
+	Active      types.Bool           `tfsdk:"active"`
+	IPRanges    types.List           `tfsdk:"ip_ranges"`
+	IPv4        []InterfaceIPv4Model `tfsdk:"ipv4"`
+}
+
+type InterfaceIPv4Model struct {
+	VPC     types.String `tfsdk:"vpc"`
+	NAT1To1 types.String `tfsdk:"nat_1_1"`
+}
+
+type ConfigModel struct {
+	ID          types.Int64          `tfsdk:"id"`
+	Label       types.String         `tfsdk:"label"`
+	Kernel      types.String         `tfsdk:"kernel"`
+	RunLevel    types.String         `tfsdk:"run_level"`
+	VirtMode    types.String         `tfsdk:"virt_mode"`
+	RootDevice  types.String         `tfsdk:"root_device"`
+	Comments    types.String         `tfsdk:"comments"`
+	MemoryLimit types.Int64          `tfsdk:"memory_limit"`
+	Helpers     []ConfigHelpersModel `tfsdk:"helpers"`
+	Devices     []ConfigDevicesModel `tfsdk:"devices"`
+	Interface   []InterfaceModel     `tfsdk:"interface"`
+}
+
+type ConfigHelpersModel struct {
+	UpdateDBDisabled  types.Bool `tfsdk:"updatedb_disabled"`
+	Distro            types.Bool `tfsdk:"distro"`
+	ModulesDep        types.Bool `tfsdk:"modules_dep"`
+	Network           types.Bool `tfsdk:"network"`
+	DevTmpFsAutomount types.Bool `tfsdk:"devtmpfs_automount"`
+}
+
+type ConfigDevicesModel struct {
+	SDA []ConfigDeviceModel `tfsdk:"sda"`
+	SDB []ConfigDeviceModel `tfsdk:"sdb"`
+	SDC []ConfigDeviceModel `tfsdk:"sdc"`
+	SDD []ConfigDeviceModel `tfsdk:"sdd"`
+	SDE []ConfigDeviceModel `tfsdk:"sde"`
+	SDF []ConfigDeviceModel `tfsdk:"sdf"`
+	SDG []ConfigDeviceModel `tfsdk:"sdg"`
+	SDH []ConfigDeviceModel `tfsdk:"sdh"`
+}
+
+type ConfigDeviceModel struct {
+	DiskLabel types.String `tfsdk:"disk_label"`
+	DiskID    types.Int64  `tfsdk:"disk_id"`
+	VolumeID  types.Int64  `tfsdk:"volume_id"`
+}
+
+type DiskModel struct {
+	Label           types.String `tfsdk:"label"`
+	Size            types.Int64  `tfsdk:"size"`
+	ID              types.Int64  `tfsdk:"id"`
+	Filesystem      types.String `tfsdk:"filesystem"`
+	ReadOnly        types.Bool   `tfsdk:"read_only"`
+	Image           types.String `tfsdk:"image"`
+	AuthorizedKeys  types.List   `tfsdk:"authorized_keys"`
+	AuthorizedUsers types.List   `tfsdk:"authorized_users"`
+	StackScriptID   types.Int64  `tfsdk:"stackscript_id"`
+	StackScriptData types.Map    `tfsdk:"stackscript_data"`
+	RootPass        types.String `tfsdk:"root_pass"`
+}
+
+// FlattenInstance flattens the given instance and its related resources into the model.
+//
+// The disk, config and alerts blocks are computed from the instance when they
+// aren't tracked by the model yet, as they were by the SDKv2 implementation.
+func (data *ResourceModel) FlattenInstance(
+	ctx context.Context,
+	instance *linodego.Instance,
+	network *linodego.InstanceIPAddressResponse,
+	disks []linodego.InstanceDisk,
+	configs []linodego.InstanceConfig,
+	inRescue bool,
+	preserveKnown bool,
+	diags *diag.Diagnostics,
+) {
+	importing := data.Region.IsNull() && !preserveKnown
+	if importing {
+		data.setImportDefaults()
+	}
+
+	data.ID = helper.KeepOrUpdateString(data.ID, strconv.Itoa(instance.ID), preserveKnown)
+	data.Label = helper.KeepOrUpdateString(data.Label, instance.Label, preserveKnown)
+	data.Status = helper.KeepOrUpdateString(data.Status, string(instance.Status), preserveKnown)
+	data.Type = helper.KeepOrUpdateString(data.Type, instance.Type, preserveKnown)
+	data.Region = helper.KeepOrUpdateString(data.Region, instance.Region, preserveKnown)
+	data.WatchdogEnabled = helper.KeepOrUpdateBool(data.WatchdogEnabled, instance.WatchdogEnabled, preserveKnown)
+	data.Group = helper.KeepOrUpdateString(data.Group, instance.Group, preserveKnown)
+	data.Tags = helper.KeepOrUpdateStringSet(data.Tags, instance.Tags, preserveKnown, diags)
+	data.Capabilities = helper.KeepOrUpdateStringSet(data.Capabilities, instance.Capabilities, preserveKnown, diags)
+	data.Booted = helper.KeepOrUpdateBool(data.Booted, isInstanceBooted(instance), preserveKnown)
+	data.Rescue = helper.KeepOrUpdateBool(data.Rescue, inRescue, preserveKnown)
+	data.HostUUID = helper.KeepOrUpdateString(data.HostUUID, instance.HostUUID, preserveKnown)
+	data.HasUserData = helper.KeepOrUpdateBool(data.HasUserData, instance.HasUserData, preserveKnown)
+	data.LKEClusterID = helper.KeepOrUpdateInt64(data.LKEClusterID, int64(instance.LKEClusterID), preserveKnown)
+	data.DiskEncryption = helper.KeepOrUpdateString(
+		data.DiskEncryption, string(instance.DiskEncryption), preserveKnown,
+	)
+	data.BackupsEnabled = helper.KeepOrUpdateBool(
+		data.BackupsEnabled, instance.Backups != nil && instance.Backups.Enabled, preserveKnown,
+	)
+	data.IPv6 = helper.KeepOrUpdateString(data.IPv6, instance.IPv6, preserveKnown)
+
+	ips := make([]string, len(instance.IPv4))
+	for i, ip := range instance.IPv4 {
+		ips[i] = ip.String()
+	}
+	data.IPv4 = helper.KeepOrUpdateStringSet(data.IPv4, ips, preserveKnown, diags)
+
+	data.Specs = helper.KeepOrUpdateValue(data.Specs, flattenSpecs(instance, diags), preserveKnown)
+	data.Backups = helper.KeepOrUpdateValue(data.Backups, flattenBackups(instance, diags), preserveKnown)
+
+	if len(data.Alerts) > 0 {
+		data.Alerts[0].FlattenAlerts(instance.Alerts, preserveKnown)
+	} else {
+		var alerts AlertsModel
+		alerts.FlattenAlerts(instance.Alerts, false)
+		data.Alerts = []AlertsModel{alerts}
+	}
+
+	data.flattenPlacementGroup(instance.PlacementGroup, preserveKnown)
+	data.flattenNetwork(network, preserveKnown, diags)
+
+	swapSize := 0
+	for _, disk := range disks {
+		if disk.Filesystem == linodego.FilesystemSwap {
+			swapSize += disk.Size
+		}
+	}
+	data.SwapSize = helper.KeepOrUpdateInt64(data.SwapSize, int64(swapSize), preserveKnown)
+
+	data.Disk = flattenDisks(data.Disk, disks, preserveKnown && len(data.Disk) > 0)
+
+	diskLabels := make(map[int]string, len(disks))
+	for _, disk := range disks {
+		diskLabels[disk.ID] = disk.Label
+	}
+
+	data.Config = flattenConfigs(ctx, data.Config, configs, diskLabels, preserveKnown && len(data.Config) > 0, diags)
+
+	if len(configs) > 0 {
+		bootConfigLabel := configs[0].Label
+		if len(configs) == 1 {
+			data.BootConfigLabel = helper.KeepOrUpdateString(data.BootConfigLabel, bootConfigLabel, preserveKnown)
+		} else if data.BootConfigLabel.IsUnknown() || data.BootConfigLabel.IsNull() {
+			data.BootConfigLabel = types.StringValue(bootConfigLabel)
+		}
+
+		if len(data.Interface) > 0 {
+			data.Interface = flattenInterfaces(
+				ctx, data.Interface, configs[0].Interfaces, preserveKnown, false, diags,
+			)
+		}
+	} else if data.BootConfigLabel.IsUnknown() {
+		data.BootConfigLabel = types.StringNull()
+	}
+}
+
+// setImportDefaults sets the attributes that aren't returned by the API to their defaults.
+func (data *ResourceModel) setImportDefaults() {
+	data.ResizeDisk = types.BoolValue(false)
+	data.MigrationType = types.StringValue(string(linodego.ColdMigration))
+	data.PlacementGroupExternallyManaged = types.BoolValue(false)
+}
+
+func (data *ResourceModel) flattenNetwork(
+	network *linodego.InstanceIPAddressResponse, preserveKnown bool, diags *diag.Diagnostics,
+) {
+	var public, private, shared []*linodego.InstanceIP
+	if network != nil && network.IPv4 != nil {
+		public, private, shared = network.IPv4.Public, network.IPv4.Private, network.IPv4.Shared
+	}
+
+	data.IPAddress = flattenPreferredAddress(data.IPAddress, public, preserveKnown)
+	data.PrivateIPAddress = flattenPreferredAddress(data.PrivateIPAddress, private, preserveKnown)
+	data.PrivateIP = helper.KeepOrUpdateBool(data.PrivateIP, len(private) > 0, preserveKnown)
+	data.SharedIPv4 = helper.KeepOrUpdateStringSet(
+		data.SharedIPv4, instanceIPSliceToString(shared), preserveKnown, diags,
+	)
+}
+
+// flattenPreferredAddress keeps the current address as long as it is still assigned
+// to the instance so that the address does not flap between multiple addresses.
+func flattenPreferredAddress(
+	current types.String, addresses []*linodego.InstanceIP, preserveKnown bool,
+) types.String {
+	if len(addresses) == 0 {
+		if current.IsUnknown() {
+			return types.StringNull()
+		}
+		return current
+	}
+
+	if !current.IsUnknown() && !current.IsNull() && slices.ContainsFunc(
+		addresses, func(ip *linodego.InstanceIP) bool {
+			return ip.Address == current.ValueString()
+		},
+	) {
+		return current
+	}
+
+	return helper.KeepOrUpdateString(current, addresses[0].Address, preserveKnown)
+}
+
+func (data *ResourceModel) flattenPlacementGroup(
+	pg *linodego.InstancePlacementGroup, preserveKnown bool,
+) {
+	// The assignment of externally managed placement groups is only tracked as configured
+	externallyManaged := data.PlacementGroupExternallyManaged.ValueBool()
+	if externallyManaged && len(data.PlacementGroup) == 0 {
+		return
+	}
+
+	if pg == nil {
+		if externallyManaged || preserveKnown {
+			for i := range data.PlacementGroup {
+				data.PlacementGroup[i].fillUnknown()
+			}
+			return
+		}
+
+		data.PlacementGroup = nil
+		return
+	}
+
+	var result PlacementGroupModel
+	if len(data.PlacementGroup) > 0 {
+		result = data.PlacementGroup[0]
+	} else {
+		result.CompliantOnly = types.BoolNull()
+	}
+
+	// The placement group of an externally managed assignment is not refreshed
+	keep := preserveKnown || externallyManaged
+
+	result.ID = helper.KeepOrUpdateInt64(result.ID, int64(pg.ID), keep)
+	result.Label = helper.KeepOrUpdateString(result.Label, pg.Label, keep)
+	result.PlacementGroupType = helper.KeepOrUpdateString(
+		result.PlacementGroupType, string(pg.PlacementGroupType), keep,
+	)
+	result.PlacementGroupPolicy = helper.KeepOrUpdateString(
+		result.PlacementGroupPolicy, string(pg.PlacementGroupPolicy), keep,
+	)
+	result.fillUnknown()
+
+	data.PlacementGroup = []PlacementGroupModel{result}
+}
+
+func (pg *PlacementGroupModel) fillUnknown() {
+	if pg.Label.IsUnknown() {
+		pg.Label = types.StringNull()
+	}
+	if pg.PlacementGroupType.IsUnknown() {
+		pg.PlacementGroupType = types.StringNull()
+	}
+	if pg.PlacementGroupPolicy.IsUnknown() {
+		pg.PlacementGroupPolicy = types.StringNull()
+	}
+}
+
+func (alerts *AlertsModel) FlattenAlerts(instanceAlerts *linodego.InstanceAlert, preserveKnown bool) {
+	if instanceAlerts == nil {
+		instanceAlerts = &linodego.InstanceAlert{}
+	}
+
+	alerts.CPU = helper.KeepOrUpdateInt64(alerts.CPU, int64(instanceAlerts.CPU), preserveKnown)
+	alerts.NetworkIn = helper.KeepOrUpdateInt64(alerts.NetworkIn, int64(instanceAlerts.NetworkIn), preserveKnown)
+	alerts.NetworkOut = helper.KeepOrUpdateInt64(alerts.NetworkOut, int64(instanceAlerts.NetworkOut), preserveKnown)
+	alerts.TransferQuota = helper.KeepOrUpdateInt64(
+		alerts.TransferQuota, int64(instanceAlerts.TransferQuota), preserveKnown,
+	)
+	alerts.IO = helper.KeepOrUpdateInt64(alerts.IO, int64(instanceAlerts.IO), preserveKnown)
+}
+
+func flattenSpecs(instance *linodego.Instance, diags *diag.Diagnostics) types.List {
+	var specs linodego.InstanceSpec
+	if instance.Specs != nil {
+		specs = *instance.Specs
+	}
+
+	specsObject, d := types.ObjectValue(specsObjectType.AttrTypes, map[string]attr.Value{
+		"disk":                types.Int64Value(int64(specs.Disk)),
+		"memory":              types.Int64Value(int64(specs.Memory)),
+		"vcpus":               types.Int64Value(int64(specs.VCPUs)),
+		"transfer":            types.Int64Value(int64(specs.Transfer)),
+		"accelerated_devices": types.Int64Value(int64(specs.AcceleratedDevices)),
+		"gpus":                types.Int64Value(int64(specs.GPUs)),
+	})
+	diags.Append(d...)
+
+	result, d := types.ListValue(specsObjectType, []attr.Value{specsObject})
+	diags.Append(d...)
+
+	return result
+}
+
+func flattenBackups(instance *linodego.Instance, diags *diag.Diagnostics) types.List {
+	var backups linodego.InstanceBackup
+	if instance.Backups != nil {
+		backups = *instance.Backups
+	}
+
+	schedule, d := types.ObjectValue(backupsScheduleObjectType.AttrTypes, map[string]attr.Value{
+		"day":    types.StringValue(backups.Schedule.Day),
+		"window": types.StringValue(backups.Schedule.Window),
+	})
+	diags.Append(d...)
+
+	scheduleList, d := types.ListValue(backupsScheduleObjectType, []attr.Value{schedule})
+	diags.Append(d...)
+
+	backupsObject, d := types.ObjectValue(backupsObjectType.AttrTypes, map[string]attr.Value{
+		"available": types.BoolValue(backups.Available),
+		"enabled":   types.BoolValue(backups.Enabled),
+		"schedule":  scheduleList,
+	})
+	diags.Append(d...)
+
+	result, d := types.ListValue(backupsObjectType, []attr.Value{backupsObject})
+	diags.Append(d...)
+
+	return result
+}
+
+// flattenDisks flattens the disks of an instance, matching them to the existing disk blocks by label.
+// Disks that aren't tracked yet are only added when the disks are being refreshed.
+func flattenDisks(existing []DiskModel, disks []linodego.InstanceDisk, preserveKnown bool) []DiskModel {
+	diskMap := make(map[string]linodego.InstanceDisk, len(disks))
+	for _, disk := range disks {
+		diskMap[disk.Label] = disk
+	}
+
+	result := make([]DiskModel, 0, len(disks))
+	tracked := make(map[string]bool, len(existing))
+
+	for _, model := range existing {
+		disk, ok := diskMap[model.Label.ValueString()]
+		if !ok {
+			if preserveKnown {
+				result = append(result, model)
+			}
+			continue
+		}
+
+		model.FlattenDisk(disk, preserveKnown)
+		result = append(result, model)
+		tracked[disk.Label] = true
+	}
+
+	if preserveKnown {
+		return result
+	}
+
+	for _, disk := range disks {
+		if tracked[disk.Label] {
+			continue
+		}
+
+		model := DiskModel{
+			ReadOnly:        types.BoolValue(false),
+			Image:           types.StringNull(),
+			AuthorizedKeys:  types.ListNull(types.StringType),
+			AuthorizedUsers: types.ListNull(types.StringType),
+			StackScriptID:   types.Int64Null(),
+			StackScriptData: types.MapNull(types.StringType),
+			RootPass:        types.StringNull(),
+		}
+		model.FlattenDisk(disk, false)
+		result = append(result, model)
+	}
+
+	return result
+}
+
+// FlattenDisk flattens the computed attributes of a disk. The attributes only used
+// during the creation of the disk aren't returned by the API and are kept as is.
+func (data *DiskModel) FlattenDisk(disk linodego.InstanceDisk, preserveKnown bool) {
+	data.ID = helper.KeepOrUpdateInt64(data.ID, int64(disk.ID), preserveKnown)
+	data.Label = helper.KeepOrUpdateString(data.Label, disk.Label, preserveKnown)
+	data.Size = helper.KeepOrUpdateInt64(data.Size, int64(disk.Size), preserveKnown)
+	data.Filesystem = helper.KeepOrUpdateString(data.Filesystem, string(disk.Filesystem), preserveKnown)
+
+	if data.ReadOnly.IsUnknown() || data.ReadOnly.IsNull() {
+		data.ReadOnly = types.BoolValue(false)
+	}
+}
+
+// flattenConfigs flattens the configs of an instance, matching them to the existing config blocks by label.
+// Configs that aren't tracked yet are only added when the configs are being refreshed.
+func flattenConfigs(
+	ctx context.Context,
+	existing []ConfigModel,
+	configs []linodego.InstanceConfig,
+	diskLabels map[int]string,
+	preserveKnown bool,
+	diags *diag.Diagnostics,
+) []ConfigModel {
+	configMap := make(map[string]linodego.InstanceConfig, len(configs))
+	for _, config := range configs {
+		configMap[config.Label] = config
+	}
+
+	result := make([]ConfigModel, 0, len(configs))
+	tracked := make(map[string]bool, len(existing))
+
+	for _, model := range existing {
+		config, ok := configMap[model.Label.ValueString()]
+		if !ok {
+			if preserveKnown {
+				result = append(result, model)
+			}
+			continue
+		}
+
+		model.FlattenConfig(ctx, config, diskLabels, preserveKnown, false, diags)
+		result = append(result, model)
+		tracked[config.Label] = true
+	}
+
+	if preserveKnown {
+		return result
+	}
+
+	for _, config := range configs {
+		if tracked[config.Label] {
+			continue
+		}
+
+		var model ConfigModel
+		model.FlattenConfig(ctx, config, diskLabels, false, true, diags)
+		result = append(result, model)
+	}
+
+	return result
+}
+
+// FlattenConfig flattens the given instance config into the model. Nested blocks
+// are only refreshed if they are already tracked or if all blocks are requested.
+func (data *ConfigModel) FlattenConfig(
+	ctx context.Context,
+	config linodego.InstanceConfig,
+	diskLabels map[int]string,
+	preserveKnown bool,
+	allBlocks bool,
+	diags *diag.Diagnostics,
+) {
+	data.ID = helper.KeepOrUpdateInt64(data.ID, int64(config.ID), preserveKnown)
+	data.Label = helper.KeepOrUpdateString(data.Label, config.Label, preserveKnown)
+	data.Kernel = helper.KeepOrUpdateString(data.Kernel, config.Kernel, preserveKnown)
+	data.RunLevel = helper.KeepOrUpdateString(data.RunLevel, string(config.RunLevel), preserveKnown)
+	data.VirtMode = helper.KeepOrUpdateString(data.VirtMode, string(config.VirtMode), preserveKnown)
+	data.RootDevice = helper.KeepOrUpdateString(data.RootDevice, config.RootDevice, preserveKnown)
+	data.Comments = helper.KeepOrUpdateString(data.Comments, config.Comments, preserveKnown)
+	data.MemoryLimit = helper.KeepOrUpdateInt64(data.MemoryLimit, int64(config.MemoryLimit), preserveKnown)
+
+	if len(data.Helpers) > 0 || allBlocks {
+		var helpers ConfigHelpersModel
+		if len(data.Helpers) > 0 {
+			helpers = data.Helpers[0]
+		}
+
+		var configHelpers linodego.InstanceConfigHelpers
+		if config.Helpers != nil {
+			configHelpers = *config.Helpers
+		}
+
+		helpers.UpdateDBDisabled = helper.KeepOrUpdateBool(
+			helpers.UpdateDBDisabled, configHelpers.UpdateDBDisabled, preserveKnown,
+		)
+		helpers.Distro = helper.KeepOrUpdateBool(helpers.Distro, configHelpers.Distro, preserveKnown)
+		helpers.ModulesDep = helper.KeepOrUpdateBool(helpers.ModulesDep, configHelpers.ModulesDep, preserveKnown)
+		helpers.Network = helper.KeepOrUpdateBool(helpers.Network, configHelpers.Network, preserveKnown)
+		helpers.DevTmpFsAutomount = helper.KeepOrUpdateBool(
+			helpers.DevTmpFsAutomount, configHelpers.DevTmpFsAutomount, preserveKnown,
+		)
+
+		data.Helpers = []ConfigHelpersModel{helpers}
+	}
+
+	if len(data.Devices) > 0 || allBlocks {
+		var devices ConfigDevicesModel
+		if len(data.Devices) > 0 {
+			devices = data.Devices[0]
+		}
+
+		var deviceMap linodego.InstanceConfigDeviceMap
+		if config.Devices != nil {
+			deviceMap = *config.Devices
+		}
+
+		devices.FlattenDevices(deviceMap, diskLabels, preserveKnown, allBlocks)
+		data.Devices = []ConfigDevicesModel{devices}
+	}
+
+	// Interfaces of a config aren't computed, so they're always refreshed
+	if preserveKnown {
+		data.Interface = flattenInterfaces(ctx, data.Interface, config.Interfaces, true, false, diags)
+	} else {
+		data.Interface = flattenInterfaces(ctx, data.Interface, config.Interfaces, false, allBlocks, diags)
+	}
+}
+
+// FlattenDevices flattens the given device map into the tracked device slots.
+func (data *ConfigDevicesModel) FlattenDevices(
+	deviceMap linodego.InstanceConfigDeviceMap,
+	diskLabels map[int]string,
+	preserveKnown bool,
+	allSlots bool,
+) {
+	flattenSlot := func(existing []ConfigDeviceModel, device *linodego.InstanceConfigDevice) []ConfigDeviceModel {
+		empty := device == nil || emptyInstanceConfigDevice(*device)
+
+		if len(existing) == 0 {
+			if !allSlots || empty {
+				return existing
+			}
+			existing = []ConfigDeviceModel{{}}
+		} else if empty && !preserveKnown {
+			return nil
+		}
+
+		if empty {
+			device = &linodego.InstanceConfigDevice{}
+		}
+
+		result := existing[0]
+		result.FlattenDevice(*device, diskLabels, preserveKnown)
+
+		return []ConfigDeviceModel{result}
+	}
+
+	data.SDA = flattenSlot(data.SDA, deviceMap.SDA)
+	data.SDB = flattenSlot(data.SDB, deviceMap.SDB)
+	data.SDC = flattenSlot(data.SDC, deviceMap.SDC)
+	data.SDD = flattenSlot(data.SDD, deviceMap.SDD)
+	data.SDE = flattenSlot(data.SDE, deviceMap.SDE)
+	data.SDF = flattenSlot(data.SDF, deviceMap.SDF)
+	data.SDG = flattenSlot(data.SDG, deviceMap.SDG)
+	data.SDH = flattenSlot(data.SDH, deviceMap.SDH)
+}
+
+func (data *ConfigDeviceModel) FlattenDevice(
+	device linodego.InstanceConfigDevice, diskLabels map[int]string, preserveKnown bool,
+) {
+	diskID, diskLabel, volumeID := types.Int64Null(), types.StringNull(), types.Int64Null()
+
+	if device.DiskID > 0 {
+		diskID = types.Int64Value(int64(device.DiskID))
+
+		if label, ok := diskLabels[device.DiskID]; ok {
+			diskLabel = types.StringValue(label)
+		}
+	} else if device.VolumeID > 0 {
+		volumeID = types.Int64Value(int64(device.VolumeID))
+	}
+
+	data.DiskID = helper.KeepOrUpdateValue(data.DiskID, diskID, preserveKnown)
+	data.DiskLabel = helper.KeepOrUpdateValue(data.DiskLabel, diskLabel, preserveKnown)
+	data.VolumeID = helper.KeepOrUpdateValue(data.VolumeID, volumeID, preserveKnown)
+}
+
+// flattenInterfaces flattens the interfaces of a config into the existing interface blocks by position.
+func flattenInterfaces(
+	ctx context.Context,
+	existing []InterfaceModel,
+	interfaces []linodego.InstanceConfigInterface,
+	preserveKnown bool,
+	allBlocks bool,
+	diags *diag.Diagnostics,
+) []InterfaceModel {
+	if preserveKnown {
+		result := slices.Clone(existing)
+		for i := range result {
+			var iface linodego.InstanceConfigInterface
+			if i < len(interfaces) {
+				iface = interfaces[i]
+			}
+			result[i].FlattenInterface(ctx, iface, true, false, diags)
+		}
+		return result
+	}
+
+	if len(interfaces) == 0 {
+		return nil
+	}
+
+	result := make([]InterfaceModel, len(interfaces))
+	for i, iface := range interfaces {
+		if i < len(existing) {
+			result[i] = existing[i]
+		}
+		result[i].FlattenInterface(ctx, iface, false, allBlocks, diags)
+	}
+
+	return result
+}
+
+// FlattenInterface flattens the given config interface into the model. The IPv4 block
+// is only refreshed if it is already tracked or if all blocks are requested.
+func (data *InterfaceModel) FlattenInterface(
+	ctx context.Context,
+	iface linodego.InstanceConfigInterface,
+	preserveKnown bool,
+	allBlocks bool,
+	diags *diag.Diagnostics,
+) {
+	// The purpose is validated case-insensitively
+	if !strings.EqualFold(data.Purpose.ValueString(), string(iface.Purpose)) {
+		data.Purpose = helper.KeepOrUpdateString(data.Purpose, string(iface.Purpose), preserveKnown)
+	}
+
+	data.IPAMAddress = helper.KeepOrUpdateString(data.IPAMAddress, iface.IPAMAddress, preserveKnown)
+	data.Label = helper.KeepOrUpdateString(data.Label, iface.Label, preserveKnown)
+	data.ID = helper.KeepOrUpdateInt64(data.ID, int64(iface.ID), preserveKnown)
+	data.SubnetID = helper.KeepOrUpdateIntPointer(data.SubnetID, iface.SubnetID, preserveKnown)
+	data.VPCID = helper.KeepOrUpdateIntPointer(data.VPCID, iface.VPCID, preserveKnown)
+	data.Primary = helper.KeepOrUpdateBool(data.Primary, iface.Primary, preserveKnown)
+	data.Active = helper.KeepOrUpdateBool(data.Active, iface.Active, preserveKnown)
+
+	if len(iface.IPRanges) > 0 {
+		ipRanges, d := types.ListValueFrom(ctx, types.StringType, iface.IPRanges)
+		diags.Append(d...)
+		data.IPRanges = helper.KeepOrUpdateValue(data.IPRanges, ipRanges, preserveKnown)
+	} else if data.IPRanges.IsUnknown() || len(data.IPRanges.Elements()) > 0 {
+		// Keep an explicitly configured empty list of ranges
+		data.IPRanges = helper.KeepOrUpdateValue(data.IPRanges, types.ListNull(types.StringType), preserveKnown)
+	}
+
+	if len(data.IPv4) == 0 && !(allBlocks && iface.IPv4 != nil) {
+		return
+	}
+
+	var ipv4 InterfaceIPv4Model
+	if len(data.IPv4) > 0 {
+		ipv4 = data.IPv4[0]
+	}
+
+	vpc, nat := types.StringNull(), types.StringNull()
+	if iface.IPv4 != nil {
+		vpc = types.StringValue(iface.IPv4.VPC)
+		nat = types.StringPointerValue(iface.IPv4.NAT1To1)
+	}
+
+	ipv4.VPC = helper.KeepOrUpdateValue(ipv4.VPC, vpc, preserveKnown)
+
+	// A configured "any" address stays as is as long as any address is assigned
+	if ipv4.NAT1To1.ValueString() != "any" || nat.ValueString() == "" {
+		ipv4.NAT1To1 = helper.KeepOrUpdateValue(ipv4.NAT1To1, nat, preserveKnown)
+	}
+
+	data.IPv4 = []InterfaceIPv4Model{ipv4}
+}
+
+// ExpandInterface expands the interface block into the options of a config interface.
+func (data InterfaceModel) ExpandInterface(
+	ctx context.Context, diags *diag.Diagnostics,
+) linodego.InstanceConfigInterfaceCreateOptions {
+	purpose := linodego.ConfigInterfacePurpose(strings.ToLower(data.Purpose.ValueString()))
+	result := linodego.InstanceConfigInterfaceCreateOptions{
+		Purpose: purpose,
+		Primary: data.Primary.ValueBool(),
+	}
+
+	switch purpose {
+	case linodego.InterfacePurposeVLAN:
+		result.IPAMAddress = data.IPAMAddress.ValueString()
+		result.Label = data.Label.ValueString()
+	case linodego.InterfacePurposeVPC:
+		if subnetID := helper.FrameworkSafeInt64ToInt(data.SubnetID.ValueInt64(), diags); subnetID != 0 {
+			result.SubnetID = &subnetID
+		}
+
+		if len(data.IPv4) > 0 {
+			vpc, nat := data.IPv4[0].VPC.ValueString(), data.IPv4[0].NAT1To1.ValueString()
+			if vpc != "" || nat != "" {
+				result.IPv4 = &linodego.VPCIPv4{VPC: vpc}
+				if nat != "" {
+					result.IPv4.NAT1To1 = &nat
+				}
+			}
+		}
+
+		// Keep the ranges nil rather than an empty slice when there is no range
+		if len(data.IPRanges.Elements()) > 0 {
+			diags.Append(data.IPRanges.ElementsAs(ctx, &result.IPRanges, false)...)
+		}
+	}
+
+	return result
+}
+
+func expandInterfaces(
+	ctx context.Context, interfaces []InterfaceModel, diags *diag.Diagnostics,
+) []linodego.InstanceConfigInterfaceCreateOptions {
+	result := make([]linodego.InstanceConfigInterfaceCreateOptions, len(interfaces))
+	for i, iface := range interfaces {
+		result[i] = iface.ExpandInterface(ctx, diags)
+	}
+	return result
+}
+
+// resolveInterfaceIPv4 resolves the IPv4 configuration of the given VPC interfaces against
+// the current interfaces at the same positions, so that an unset IPv4 block or an "any"
+// NAT address keeps the current address of the interface.
+func resolveInterfaceIPv4(
+	interfaces []linodego.InstanceConfigInterfaceCreateOptions,
+	current []linodego.InstanceConfigInterface,
+) {
+	for i := range interfaces {
+		if i >= len(current) ||
+			interfaces[i].Purpose != linodego.InterfacePurposeVPC ||
+			current[i].Purpose != linodego.InterfacePurposeVPC ||
+			current[i].IPv4 == nil {
+			continue
+		}
+
+		if interfaces[i].IPv4 == nil {
+			interfaces[i].IPv4 = &linodego.VPCIPv4{
+				VPC:     current[i].IPv4.VPC,
+				NAT1To1: current[i].IPv4.NAT1To1,
+			}
+			continue
+		}
+
+		if interfaces[i].IPv4.VPC == "" {
+			interfaces[i].IPv4.VPC = current[i].IPv4.VPC
+		}
+
+		if nat := interfaces[i].IPv4.NAT1To1; nat != nil && *nat == "any" &&
+			current[i].IPv4.NAT1To1 != nil && *current[i].IPv4.NAT1To1 != "" {
+			interfaces[i].IPv4.NAT1To1 = current[i].IPv4.NAT1To1
+		}
+	}
+}
+
+// ExpandCreateOptions expands the disk block into the options to create the disk.
+func (data DiskModel) ExpandCreateOptions(
+	ctx context.Context, diags *diag.Diagnostics,
+) linodego.InstanceDiskCreateOptions {
+	result := linodego.InstanceDiskCreateOptions{
+		Label:      data.Label.ValueString(),
+		Filesystem: data.Filesystem.ValueString(),
+		Size:       helper.FrameworkSafeInt64ToInt(data.Size.ValueInt64(), diags),
+	}
+
+	if data.Image.IsNull() || data.Image.IsUnknown() {
+		return result
+	}
+
+	result.Image = data.Image.ValueString()
+	result.StackscriptID = helper.FrameworkSafeInt64ToInt(data.StackScriptID.ValueInt64(), diags)
+
+	if data.RootPass.ValueString() != "" {
+		result.RootPass = data.RootPass.ValueString()
+	} else {
+		result.RootPass = helper.FrameworkCreateRandomRootPassword(diags)
+	}
+
+	result.AuthorizedKeys = expandNonNullStrings(
+		data.AuthorizedKeys, "invalid input for disk authorized_keys: keys cannot be empty or null", diags,
+	)
+	result.AuthorizedUsers = expandNonNullStrings(
+		data.AuthorizedUsers, "invalid input for disk authorized_users: users cannot be empty or null", diags,
+	)
+
+	if !data.StackScriptData.IsNull() && !data.StackScriptData.IsUnknown() {
+		diags.Append(data.StackScriptData.ElementsAs(ctx, &result.StackscriptData, false)...)
+	}
+
+	return result
+}
+
+// expandNonNullStrings expands a list of strings, raising the given error for any null element.
+func expandNonNullStrings(list types.List, errorMessage string, diags *diag.Diagnostics) []string {
+	if list.IsNull() || list.IsUnknown() {
+		return nil
+	}
+
+	result := make([]string, 0, len(list.Elements()))
+	for _, elem := range list.Elements() {
+		value, ok := elem.(types.String)
+		if !ok || value.IsNull() || value.IsUnknown() {
+			diags.AddError("Invalid Input", errorMessage)
+			return nil
+		}
+		result = append(result, value.ValueString())
+	}
+
+	return result
+}
+
+// ExpandHelpers expands the helpers block of a config.
+func (data ConfigModel) ExpandHelpers() *linodego.InstanceConfigHelpers {
+	if len(data.Helpers) == 0 {
+		return nil
+	}
+
+	helpers := data.Helpers[0]
+
+	return &linodego.InstanceConfigHelpers{
+		UpdateDBDisabled:  helpers.UpdateDBDisabled.ValueBool(),
+		Distro:            helpers.Distro.ValueBool(),
+		ModulesDep:        helpers.ModulesDep.ValueBool(),
+		Network:           helpers.Network.ValueBool(),
+		DevTmpFsAutomount: helpers.DevTmpFsAutomount.ValueBool(),
+	}
+}
+
+// ExpandCreateOptions expands the config block into the options to create the config.
+func (data ConfigModel) ExpandCreateOptions(
+	ctx context.Context, diskIDLabelMap map[string]int, diags *diag.Diagnostics,
+) linodego.InstanceConfigCreateOptions {
+	result := linodego.InstanceConfigCreateOptions{
+		Label:       data.Label.ValueString(),
+		Comments:    data.Comments.ValueString(),
+		Kernel:      data.Kernel.ValueString(),
+		RunLevel:    data.RunLevel.ValueString(),
+		VirtMode:    data.VirtMode.ValueString(),
+		MemoryLimit: helper.FrameworkSafeInt64ToInt(data.MemoryLimit.ValueInt64(), diags),
+		Helpers:     data.ExpandHelpers(),
+		Interfaces:  expandInterfaces(ctx, data.Interface, diags),
+	}
+
+	if rootDevice := data.RootDevice.ValueString(); rootDevice != "" {
+		result.RootDevice = &rootDevice
+	}
+
+	if len(data.Devices) > 0 {
+		devices, err := expandConfigDevices(data.Devices[0], diskIDLabelMap)
+		if err != nil {
+			diags.AddError("Failed to Expand Config Devices", err.Error())
+			return result
+		}
+		result.Devices = *devices
+	}
+
+	return result
+}
+
+// ExpandMetadata expands the metadata block into the instance metadata options.
+func (data *ResourceModel) ExpandMetadata() *linodego.InstanceMetadataOptions {
+	if len(data.Metadata) == 0 {
+		return nil
+	}
+
+	return &linodego.InstanceMetadataOptions{
+		UserData: data.Metadata[0].UserData.ValueString(),
+	}
+}
+
+// ExpandPlacementGroup expands the placement group block into the options
+// to create an instance in the placement group.
+func (data *ResourceModel) ExpandPlacementGroup() *linodego.InstanceCreatePlacementGroupOptions {
+	if len(data.PlacementGroup) == 0 {
+		return nil
+	}
+
+	pg := data.PlacementGroup[0]
+
+	return &linodego.InstanceCreatePlacementGroupOptions{
+		ID:            int(pg.ID.ValueInt64()),
+		CompliantOnly: pg.CompliantOnly.ValueBoolPointer(),
+	}
+}
+
+// ExpandAlerts expands the alerts block into the alert options of an instance.
+// Alerts that aren't configured keep their current values.
+func (data *ResourceModel) ExpandAlerts(
+	current *linodego.InstanceAlert, diags *diag.Diagnostics,
+) *linodego.InstanceAlert {
+	if len(data.Alerts) == 0 {
+		return nil
+	}
+
+	if current == nil {
+		current = &linodego.InstanceAlert{}
+	}
+
+	alerts := data.Alerts[0]
+
+	expandAlert := func(value types.Int64, currentValue int) int {
+		if value.IsUnknown() || value.IsNull() {
+			return currentValue
+		}
+		return helper.FrameworkSafeInt64ToInt(value.ValueInt64(), diags)
+	}
+
+	return &linodego.InstanceAlert{
+		CPU:           expandAlert(alerts.CPU, current.CPU),
+		IO:            expandAlert(alerts.IO, current.IO),
+		NetworkIn:     expandAlert(alerts.NetworkIn, current.NetworkIn),
+		NetworkOut:    expandAlert(alerts.NetworkOut, current.NetworkOut),
+		TransferQuota: expandAlert(alerts.TransferQuota, current.TransferQuota),
+	}
+}
+
+// UpgradeFromV0 upgrades the state written by the SDKv2 implementation of this resource.
+//
+// The SDKv2 implementation stored zero values for unset attributes, which the current
+// schema stores as null. The computed disks, configs and alerts are carried forward.
+func (data *ResourceModel) UpgradeFromV0(ctx context.Context, v0 ResourceModel) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	*data = v0
+
+	data.Image = nullIfEmptyString(data.Image)
+	data.RootPass = upgradeRootPassFromV0(data.RootPass)
+	data.BackupID = nullIfZeroInt64(data.BackupID)
+	data.StackScriptID = nullIfZeroInt64(data.StackScriptID)
+	data.FirewallID = nullIfZeroInt64(data.FirewallID)
+	data.StackScriptData = nullIfEmptyMap(data.StackScriptData)
+	data.AuthorizedKeys = nullIfEmptyList(data.AuthorizedKeys)
+	data.AuthorizedUsers = nullIfEmptyList(data.AuthorizedUsers)
+
+	for i := range data.Disk {
+		disk := &data.Disk[i]
+		disk.Image = nullIfEmptyString(disk.Image)
+		disk.RootPass = upgradeRootPassFromV0(disk.RootPass)
+		disk.StackScriptID = nullIfZeroInt64(disk.StackScriptID)
+		disk.StackScriptData = nullIfEmptyMap(disk.StackScriptData)
+		disk.AuthorizedKeys = nullIfEmptyList(disk.AuthorizedKeys)
+		disk.AuthorizedUsers = nullIfEmptyList(disk.AuthorizedUsers)
+
+		if disk.ReadOnly.IsNull() {
+			disk.ReadOnly = types.BoolValue(false)
+		}
+	}
+
+	for i := range data.Config {
+		config := &data.Config[i]
+
+		for j := range config.Devices {
+			devices := &config.Devices[j]
+			for _, slot := range []*[]ConfigDeviceModel{
+				&devices.SDA, &devices.SDB, &devices.SDC, &devices.SDD,
+				&devices.SDE, &devices.SDF, &devices.SDG, &devices.SDH,
+			} {
+				for k := range *slot {
+					device := &(*slot)[k]
+					device.DiskLabel = nullIfEmptyString(device.DiskLabel)
+					device.DiskID = nullIfZeroInt64(device.DiskID)
+					device.VolumeID = nullIfZeroInt64(device.VolumeID)
+				}
+			}
+		}
+
+		upgradeInterfacesFromV0(config.Interface)
+	}
+
+	upgradeInterfacesFromV0(data.Interface)
+
+	for i := range data.RescueDevice {
+		device := &data.RescueDevice[i]
+		device.DiskLabel = nullIfEmptyString(device.DiskLabel)
+		device.DiskID = nullIfZeroInt64(device.DiskID)
+		device.VolumeID = nullIfZeroInt64(device.VolumeID)
+	}
+
+	for i := range data.Metadata {
+		data.Metadata[i].UserData = nullIfEmptyString(data.Metadata[i].UserData)
+	}
+
+	for i := range data.PlacementGroup {
+		if !data.PlacementGroup[i].CompliantOnly.ValueBool() {
+			data.PlacementGroup[i].CompliantOnly = types.BoolNull()
+		}
+	}
+
+	return diags
+}
+
+// upgradeRootPassFromV0 normalizes a root password stored by the SDKv2 implementation,
+// which stored passwords hashed. Unset passwords are stored as null, while the hashes of
+// configured passwords are kept for the legacyRootPass plan modifier, as the passwords
+// can't be recovered from them.
+func upgradeRootPassFromV0(rootPass types.String) types.String {
+	if rootPass.ValueString() == "" || rootPass.ValueString() == hashString("") {
+		return types.StringNull()
+	}
+
+	return rootPass
+}
+
+func upgradeInterfacesFromV0(interfaces []InterfaceModel) {
+	for i := range interfaces {
+		iface := &interfaces[i]
+		iface.SubnetID = nullIfZeroInt64(iface.SubnetID)
+		iface.VPCID = nullIfZeroInt64(iface.VPCID)
+		iface.IPRanges = nullIfEmptyList(iface.IPRanges)
+
+		for j := range iface.IPv4 {
+			iface.IPv4[j].NAT1To1 = nullIfEmptyString(iface.IPv4[j].NAT1To1)
+		}
+	}
+}
+
+func nullIfEmptyString(value types.String) types.String {
+	if !value.IsUnknown() && value.ValueString() == "" {
+		return types.StringNull()
+	}
+	return value
+}
+
+func nullIfZeroInt64(value types.Int64) types.Int64 {
+	if !value.IsUnknown() && value.ValueInt64() == 0 {
+		return types.Int64Null()
+	}
+	return value
+}
+
+func nullIfEmptyList(value types.List) types.List {
+	if !value.IsUnknown() && len(value.Elements()) == 0 {
+		return types.ListNull(types.StringType)
+	}
+	return value
+}
+
+func nullIfEmptyMap(value types.Map) types.Map {
+	if !value.IsUnknown() && len(value.Elements()) == 0 {
+		return types.MapNull(types.StringType)
+	}
+	return value
+}
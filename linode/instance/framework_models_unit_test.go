@@ -0,0 +1,555 @@
+//go:build unit
+
+package instance
+
+import (
+	"context"
+	"net"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/linode/linodego"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func newTestInstance() *linodego.Instance {
+	return &linodego.Instance{
+		ID:              123,
+		Label:           "test-instance",
+		Status:          linodego.InstanceRunning,
+		Type:            "g6-standard-1",
+		Region:          "us-east",
+		Image:           "linode/debian12",
+		Group:           "test-group",
+		Tags:            []string{"tag1", "tag2"},
+		WatchdogEnabled: true,
+		HostUUID:        "abc-123",
+		IPv4:            []*net.IP{},
+		IPv6:            "1234::5678/128",
+		Alerts: &linodego.InstanceAlert{
+			CPU:           90,
+			IO:            10000,
+			NetworkIn:     10,
+			NetworkOut:    10,
+			TransferQuota: 80,
+		},
+		Backups: &linodego.InstanceBackup{
+			Available: true,
+			Enabled:   true,
+		},
+		Specs: &linodego.InstanceSpec{
+			Disk:     81920,
+			Memory:   2048,
+			VCPUs:    1,
+			Transfer: 2000,
+		},
+	}
+}
+
+func newTestInstanceNetwork() *linodego.InstanceIPAddressResponse {
+	return &linodego.InstanceIPAddressResponse{
+		IPv4: &linodego.InstanceIPv4Response{
+			Public: []*linodego.InstanceIP{
+				{Address: "192.0.2.1"},
+				{Address: "192.0.2.2"},
+			},
+			Private: []*linodego.InstanceIP{
+				{Address: "192.168.128.1"},
+			},
+		},
+	}
+}
+
+func TestFlattenInstanceImport(t *testing.T) {
+	ctx := context.Background()
+	var diags diag.Diagnostics
+
+	disks := []linodego.InstanceDisk{
+		{ID: 1, Label: "boot", Size: 81408, Filesystem: linodego.FilesystemExt4},
+		{ID: 2, Label: "swap", Size: 512, Filesystem: linodego.FilesystemSwap},
+	}
+	configs := []linodego.InstanceConfig{
+		{
+			ID:    10,
+			Label: "boot-config",
+			Devices: &linodego.InstanceConfigDeviceMap{
+				SDA: &linodego.InstanceConfigDevice{DiskID: 1},
+				SDB: &linodego.InstanceConfigDevice{DiskID: 2},
+			},
+		},
+	}
+
+	var data ResourceModel
+	data.FlattenInstance(
+		ctx, newTestInstance(), newTestInstanceNetwork(), disks, configs, false, false, &diags,
+	)
+	require.False(t, diags.HasError(), diags.Errors())
+
+	assert.Equal(t, "123", data.ID.ValueString())
+	assert.Equal(t, "test-instance", data.Label.ValueString())
+	assert.Equal(t, "us-east", data.Region.ValueString())
+	assert.Equal(t, "running", data.Status.ValueString())
+	assert.True(t, data.Booted.ValueBool())
+	assert.True(t, data.BackupsEnabled.ValueBool())
+	assert.Equal(t, "192.0.2.1", data.IPAddress.ValueString())
+	assert.Equal(t, "192.168.128.1", data.PrivateIPAddress.ValueString())
+	assert.True(t, data.PrivateIP.ValueBool())
+	assert.Equal(t, int64(512), data.SwapSize.ValueInt64())
+	assert.Equal(t, "boot-config", data.BootConfigLabel.ValueString())
+
+	// Attributes that aren't returned by the API are set to their defaults
+	assert.False(t, data.ResizeDisk.ValueBool())
+	assert.Equal(t, "cold", data.MigrationType.ValueString())
+	assert.False(t, data.PlacementGroupExternallyManaged.ValueBool())
+
+	// Disks, configs and alerts are computed from the instance
+	require.Len(t, data.Disk, 2)
+	assert.Equal(t, "boot", data.Disk[0].Label.ValueString())
+	assert.Equal(t, int64(512), data.Disk[1].Size.ValueInt64())
+
+	require.Len(t, data.Config, 1)
+	assert.Equal(t, int64(10), data.Config[0].ID.ValueInt64())
+	assert.Equal(t, "boot", data.Config[0].Devices[0].SDA[0].DiskLabel.ValueString())
+
+	require.Len(t, data.Alerts, 1)
+	assert.Equal(t, int64(90), data.Alerts[0].CPU.ValueInt64())
+	assert.Equal(t, int64(10000), data.Alerts[0].IO.ValueInt64())
+}
+
+func TestFlattenInstanceImportExplicitDisks(t *testing.T) {
+	ctx := context.Background()
+	var diags diag.Diagnostics
+
+	instance := newTestInstance()
+	instance.Image = ""
+
+	disks := []linodego.InstanceDisk{
+		{ID: 1, Label: "boot", Size: 81408, Filesystem: linodego.FilesystemExt4},
+		{ID: 2, Label: "swap", Size: 512, Filesystem: linodego.FilesystemSwap},
+	}
+	configs := []linodego.InstanceConfig{
+		{
+			ID:     10,
+			Label:  "boot-config",
+			Kernel: "linode/latest-64bit",
+			Devices: &linodego.InstanceConfigDeviceMap{
+				SDA: &linodego.InstanceConfigDevice{DiskID: 1},
+				SDB: &linodego.InstanceConfigDevice{DiskID: 2},
+			},
+		},
+	}
+
+	var data ResourceModel
+	data.FlattenInstance(ctx, instance, newTestInstanceNetwork(), disks, configs, false, false, &diags)
+	require.False(t, diags.HasError(), diags.Errors())
+
+	require.Len(t, data.Disk, 2)
+	assert.Equal(t, "boot", data.Disk[0].Label.ValueString())
+	assert.Equal(t, int64(1), data.Disk[0].ID.ValueInt64())
+	assert.Equal(t, "ext4", data.Disk[0].Filesystem.ValueString())
+	assert.True(t, data.Disk[0].Image.IsNull())
+	assert.Equal(t, "swap", data.Disk[1].Label.ValueString())
+
+	require.Len(t, data.Config, 1)
+	config := data.Config[0]
+	assert.Equal(t, int64(10), config.ID.ValueInt64())
+	assert.Equal(t, "linode/latest-64bit", config.Kernel.ValueString())
+
+	require.Len(t, config.Devices, 1)
+	require.Len(t, config.Devices[0].SDA, 1)
+	assert.Equal(t, "boot", config.Devices[0].SDA[0].DiskLabel.ValueString())
+	assert.Equal(t, int64(1), config.Devices[0].SDA[0].DiskID.ValueInt64())
+	require.Len(t, config.Devices[0].SDB, 1)
+	assert.Equal(t, "swap", config.Devices[0].SDB[0].DiskLabel.ValueString())
+	assert.Empty(t, config.Devices[0].SDC)
+}
+
+func TestFlattenInstancePreserveKnown(t *testing.T) {
+	ctx := context.Background()
+	var diags diag.Diagnostics
+
+	data := ResourceModel{
+		Label:     types.StringValue("configured-label"),
+		Region:    types.StringValue("us-east"),
+		IPAddress: types.StringValue("192.0.2.2"),
+		Status:    types.StringUnknown(),
+		Alerts: []AlertsModel{
+			{
+				CPU:           types.Int64Value(50),
+				IO:            types.Int64Unknown(),
+				NetworkIn:     types.Int64Unknown(),
+				NetworkOut:    types.Int64Unknown(),
+				TransferQuota: types.Int64Unknown(),
+			},
+		},
+	}
+
+	data.FlattenInstance(ctx, newTestInstance(), newTestInstanceNetwork(), nil, nil, false, true, &diags)
+	require.False(t, diags.HasError(), diags.Errors())
+
+	assert.Equal(t, "configured-label", data.Label.ValueString())
+	assert.Equal(t, "running", data.Status.ValueString())
+
+	// The current address is kept as long as it's assigned to the instance
+	assert.Equal(t, "192.0.2.2", data.IPAddress.ValueString())
+
+	assert.Equal(t, int64(50), data.Alerts[0].CPU.ValueInt64())
+	assert.Equal(t, int64(10000), data.Alerts[0].IO.ValueInt64())
+
+	assert.True(t, data.BootConfigLabel.IsNull())
+}
+
+func TestFlattenDisks(t *testing.T) {
+	existing := []DiskModel{
+		{Label: types.StringValue("swap"), ID: types.Int64Unknown(), Filesystem: types.StringValue("swap")},
+		{Label: types.StringValue("boot"), ID: types.Int64Unknown(), Filesystem: types.StringUnknown()},
+	}
+
+	disks := []linodego.InstanceDisk{
+		{ID: 1, Label: "boot", Size: 1024, Filesystem: linodego.FilesystemExt4},
+		{ID: 2, Label: "swap", Size: 512, Filesystem: linodego.FilesystemSwap},
+		{ID: 3, Label: "untracked", Size: 256, Filesystem: linodego.FilesystemRaw},
+	}
+
+	result := flattenDisks(existing, disks, true)
+
+	// Disks are matched by label and keep their configured order
+	require.Len(t, result, 2)
+	assert.Equal(t, int64(2), result[0].ID.ValueInt64())
+	assert.Equal(t, int64(1), result[1].ID.ValueInt64())
+	assert.Equal(t, "ext4", result[1].Filesystem.ValueString())
+
+	// Untracked disks are only added on refresh
+	result = flattenDisks(result, disks, false)
+	require.Len(t, result, 3)
+	assert.Equal(t, "untracked", result[2].Label.ValueString())
+	assert.False(t, result[2].ReadOnly.ValueBool())
+}
+
+func TestFlattenInterfaces(t *testing.T) {
+	ctx := context.Background()
+	var diags diag.Diagnostics
+
+	subnetID, vpcID := 5, 6
+	nat := "203.0.113.1"
+
+	existing := []InterfaceModel{
+		{
+			Purpose: types.StringValue("VPC"),
+			IPv4: []InterfaceIPv4Model{
+				{VPC: types.StringUnknown(), NAT1To1: types.StringValue("any")},
+			},
+		},
+	}
+
+	interfaces := []linodego.InstanceConfigInterface{
+		{
+			ID:       1,
+			Purpose:  linodego.InterfacePurposeVPC,
+			Primary:  true,
+			Active:   true,
+			SubnetID: &subnetID,
+			VPCID:    &vpcID,
+			IPv4: &linodego.VPCIPv4{
+				VPC:     "10.0.0.2",
+				NAT1To1: &nat,
+			},
+		},
+	}
+
+	result := flattenInterfaces(ctx, existing, interfaces, false, false, &diags)
+	require.False(t, diags.HasError(), diags.Errors())
+	require.Len(t, result, 1)
+
+	// The purpose is compared case-insensitively
+	assert.Equal(t, "VPC", result[0].Purpose.ValueString())
+	assert.Equal(t, int64(5), result[0].SubnetID.ValueInt64())
+	assert.Equal(t, int64(6), result[0].VPCID.ValueInt64())
+	assert.True(t, result[0].IPRanges.IsNull())
+	require.Len(t, result[0].IPv4, 1)
+	assert.Equal(t, "10.0.0.2", result[0].IPv4[0].VPC.ValueString())
+
+	// A configured "any" address is kept while an address is assigned
+	assert.Equal(t, "any", result[0].IPv4[0].NAT1To1.ValueString())
+}
+
+func TestExpandInterface(t *testing.T) {
+	ctx := context.Background()
+	var diags diag.Diagnostics
+
+	vlan := InterfaceModel{
+		Purpose:     types.StringValue("vlan"),
+		Label:       types.StringValue("my-vlan"),
+		IPAMAddress: types.StringValue("10.0.0.1/24"),
+		IPRanges:    types.ListNull(types.StringType),
+	}
+
+	assert.Equal(t, linodego.InstanceConfigInterfaceCreateOptions{
+		Purpose:     linodego.InterfacePurposeVLAN,
+		Label:       "my-vlan",
+		IPAMAddress: "10.0.0.1/24",
+	}, vlan.ExpandInterface(ctx, &diags))
+
+	vpc := InterfaceModel{
+		Purpose:  types.StringValue("VPC"),
+		Primary:  types.BoolValue(true),
+		SubnetID: types.Int64Value(5),
+		IPRanges: types.ListValueMust(types.StringType, nil),
+		IPv4: []InterfaceIPv4Model{
+			{VPC: types.StringNull(), NAT1To1: types.StringValue("any")},
+		},
+	}
+
+	expanded := vpc.ExpandInterface(ctx, &diags)
+	require.False(t, diags.HasError(), diags.Errors())
+
+	assert.Equal(t, linodego.InterfacePurposeVPC, expanded.Purpose)
+	assert.True(t, expanded.Primary)
+	assert.Equal(t, 5, *expanded.SubnetID)
+	assert.Nil(t, expanded.IPRanges)
+	require.NotNil(t, expanded.IPv4)
+	assert.Equal(t, "", expanded.IPv4.VPC)
+	assert.Equal(t, "any", *expanded.IPv4.NAT1To1)
+}
+
+func TestResolveInterfaceIPv4(t *testing.T) {
+	nat := "203.0.113.1"
+	anyNAT := "any"
+
+	current := []linodego.InstanceConfigInterface{
+		{Purpose: linodego.InterfacePurposePublic},
+		{
+			Purpose: linodego.InterfacePurposeVPC,
+			IPv4:    &linodego.VPCIPv4{VPC: "10.0.0.2", NAT1To1: &nat},
+		},
+	}
+
+	interfaces := []linodego.InstanceConfigInterfaceCreateOptions{
+		{Purpose: linodego.InterfacePurposePublic},
+		{
+			Purpose: linodego.InterfacePurposeVPC,
+			IPv4:    &linodego.VPCIPv4{NAT1To1: &anyNAT},
+		},
+	}
+
+	resolveInterfaceIPv4(interfaces, current)
+
+	assert.Nil(t, interfaces[0].IPv4)
+	assert.Equal(t, "10.0.0.2", interfaces[1].IPv4.VPC)
+	assert.Equal(t, nat, *interfaces[1].IPv4.NAT1To1)
+
+	interfaces[1].IPv4 = nil
+	resolveInterfaceIPv4(interfaces, current)
+	assert.Equal(t, current[1].IPv4, interfaces[1].IPv4)
+}
+
+func TestExpandDiskCreateOptions(t *testing.T) {
+	ctx := context.Background()
+	var diags diag.Diagnostics
+
+	disk := DiskModel{
+		Label:           types.StringValue("boot"),
+		Size:            types.Int64Value(1024),
+		Filesystem:      types.StringValue("ext4"),
+		Image:           types.StringValue("linode/debian12"),
+		RootPass:        types.StringValue("b4d_p4s5"),
+		AuthorizedKeys:  types.ListValueMust(types.StringType, nil),
+		AuthorizedUsers: types.ListNull(types.StringType),
+		StackScriptID:   types.Int64Null(),
+		StackScriptData: types.MapNull(types.StringType),
+	}
+
+	opts := disk.ExpandCreateOptions(ctx, &diags)
+	require.False(t, diags.HasError(), diags.Errors())
+
+	assert.Equal(t, "boot", opts.Label)
+	assert.Equal(t, 1024, opts.Size)
+	assert.Equal(t, "ext4", opts.Filesystem)
+	assert.Equal(t, "linode/debian12", opts.Image)
+	assert.Equal(t, "b4d_p4s5", opts.RootPass)
+	assert.Empty(t, opts.AuthorizedKeys)
+
+	disk.AuthorizedKeys = types.ListValueMust(types.StringType, []attr.Value{types.StringNull()})
+	disk.ExpandCreateOptions(ctx, &diags)
+	assert.True(t, diags.HasError())
+}
+
+func TestExpandAlerts(t *testing.T) {
+	var diags diag.Diagnostics
+
+	data := ResourceModel{
+		Alerts: []AlertsModel{
+			{
+				CPU:           types.Int64Value(50),
+				IO:            types.Int64Unknown(),
+				NetworkIn:     types.Int64Null(),
+				NetworkOut:    types.Int64Value(20),
+				TransferQuota: types.Int64Unknown(),
+			},
+		},
+	}
+
+	current := &linodego.InstanceAlert{
+		CPU:           90,
+		IO:            10000,
+		NetworkIn:     10,
+		NetworkOut:    10,
+		TransferQuota: 80,
+	}
+
+	assert.Equal(t, &linodego.InstanceAlert{
+		CPU:           50,
+		IO:            10000,
+		NetworkIn:     10,
+		NetworkOut:    20,
+		TransferQuota: 80,
+	}, data.ExpandAlerts(current, &diags))
+	assert.False(t, diags.HasError())
+
+	data.Alerts = nil
+	assert.Nil(t, data.ExpandAlerts(current, &diags))
+}
+
+func TestUpgradeFromV0(t *testing.T) {
+	ctx := context.Background()
+
+	v0 := ResourceModel{
+		ID:              types.StringValue("123"),
+		Image:           types.StringValue("linode/debian12"),
+		RootPass:        types.StringValue(""),
+		BackupID:        types.Int64Value(0),
+		StackScriptID:   types.Int64Value(0),
+		FirewallID:      types.Int64Value(0),
+		StackScriptData: types.MapValueMust(types.StringType, nil),
+		AuthorizedKeys:  types.ListValueMust(types.StringType, nil),
+		AuthorizedUsers: types.ListValueMust(types.StringType, nil),
+		Alerts:          []AlertsModel{{CPU: types.Int64Value(90)}},
+		Disk:            []DiskModel{{Label: types.StringValue("boot")}},
+		Config:          []ConfigModel{{Label: types.StringValue("config")}},
+		Interface: []InterfaceModel{
+			{
+				Purpose:  types.StringValue("public"),
+				SubnetID: types.Int64Value(0),
+				VPCID:    types.Int64Value(0),
+				IPRanges: types.ListValueMust(types.StringType, nil),
+				IPv4: []InterfaceIPv4Model{
+					{VPC: types.StringValue(""), NAT1To1: types.StringValue("")},
+				},
+			},
+		},
+		PlacementGroup: []PlacementGroupModel{
+			{ID: types.Int64Value(1), CompliantOnly: types.BoolValue(false)},
+		},
+	}
+
+	var v1 ResourceModel
+	diags := v1.UpgradeFromV0(ctx, v0)
+	require.False(t, diags.HasError(), diags.Errors())
+
+	assert.Equal(t, "123", v1.ID.ValueString())
+	assert.Equal(t, "linode/debian12", v1.Image.ValueString())
+	assert.True(t, v1.RootPass.IsNull())
+	assert.True(t, v1.BackupID.IsNull())
+	assert.True(t, v1.StackScriptID.IsNull())
+	assert.True(t, v1.FirewallID.IsNull())
+	assert.True(t, v1.StackScriptData.IsNull())
+	assert.True(t, v1.AuthorizedKeys.IsNull())
+	assert.True(t, v1.AuthorizedUsers.IsNull())
+
+	// Computed blocks are carried forward
+	require.Len(t, v1.Alerts, 1)
+	assert.Equal(t, int64(90), v1.Alerts[0].CPU.ValueInt64())
+	require.Len(t, v1.Disk, 1)
+	assert.Equal(t, "boot", v1.Disk[0].Label.ValueString())
+	require.Len(t, v1.Config, 1)
+	assert.Equal(t, "config", v1.Config[0].Label.ValueString())
+
+	require.Len(t, v1.Interface, 1)
+	assert.True(t, v1.Interface[0].SubnetID.IsNull())
+	assert.True(t, v1.Interface[0].VPCID.IsNull())
+	assert.True(t, v1.Interface[0].IPRanges.IsNull())
+	assert.Equal(t, "", v1.Interface[0].IPv4[0].VPC.ValueString())
+	assert.True(t, v1.Interface[0].IPv4[0].NAT1To1.IsNull())
+
+	assert.True(t, v1.PlacementGroup[0].CompliantOnly.IsNull())
+}
+
+func TestUpgradeFromV0ExplicitDisks(t *testing.T) {
+	ctx := context.Background()
+
+	defaultHelpers := ConfigHelpersModel{
+		UpdateDBDisabled:  types.BoolValue(true),
+		Distro:            types.BoolValue(true),
+		ModulesDep:        types.BoolValue(true),
+		Network:           types.BoolValue(true),
+		DevTmpFsAutomount: types.BoolValue(false),
+	}
+
+	v0 := ResourceModel{
+		Image: types.StringValue(""),
+		Disk: []DiskModel{
+			{
+				Label:           types.StringValue("boot"),
+				Image:           types.StringValue(""),
+				RootPass:        types.StringValue(""),
+				StackScriptID:   types.Int64Value(0),
+				StackScriptData: types.MapValueMust(types.StringType, nil),
+				AuthorizedKeys:  types.ListValueMust(types.StringType, nil),
+				AuthorizedUsers: types.ListValueMust(types.StringType, nil),
+				ReadOnly:        types.BoolNull(),
+			},
+		},
+		Config: []ConfigModel{
+			{
+				Label:   types.StringValue("config"),
+				Helpers: []ConfigHelpersModel{defaultHelpers},
+				Devices: []ConfigDevicesModel{
+					{
+						SDA: []ConfigDeviceModel{
+							{
+								DiskLabel: types.StringValue("boot"),
+								DiskID:    types.Int64Value(1),
+								VolumeID:  types.Int64Value(0),
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+
+	var v1 ResourceModel
+	diags := v1.UpgradeFromV0(ctx, v0)
+	require.False(t, diags.HasError(), diags.Errors())
+
+	assert.True(t, v1.Image.IsNull())
+
+	require.Len(t, v1.Disk, 1)
+	disk := v1.Disk[0]
+	assert.True(t, disk.Image.IsNull())
+	assert.True(t, disk.RootPass.IsNull())
+	assert.True(t, disk.StackScriptID.IsNull())
+	assert.True(t, disk.StackScriptData.IsNull())
+	assert.True(t, disk.AuthorizedKeys.IsNull())
+	assert.False(t, disk.ReadOnly.ValueBool())
+
+	require.Len(t, v1.Config, 1)
+	assert.Equal(t, []ConfigHelpersModel{defaultHelpers}, v1.Config[0].Helpers)
+
+	device := v1.Config[0].Devices[0].SDA[0]
+	assert.Equal(t, "boot", device.DiskLabel.ValueString())
+	assert.Equal(t, int64(1), device.DiskID.ValueInt64())
+	assert.True(t, device.VolumeID.IsNull())
+}
+
+func TestUpgradeRootPassFromV0(t *testing.T) {
+	assert.True(t, upgradeRootPassFromV0(types.StringValue("")).IsNull())
+	assert.True(t, upgradeRootPassFromV0(types.StringValue(hashString(""))).IsNull())
+	assert.True(t, upgradeRootPassFromV0(types.StringNull()).IsNull())
+
+	hashed := types.StringValue(hashString("t0p-s3cret"))
+	assert.Equal(t, hashed, upgradeRootPassFromV0(hashed))
+}
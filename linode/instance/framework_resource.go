@@ -0,0 +1,1701 @@
+package instance
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"strconv"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/linode/linodego"
+	"github.com/linode/terraform-provider-linode/v2/linode/helper"
+)
+
+// Instance creation with reserved IPv4 is for internal use only : please refer to KB page for more information.
+
+const (
+	LinodeInstanceCreateTimeout = 15 * time.Minute
+	LinodeInstanceUpdateTimeout = time.Hour
+	LinodeInstanceDeleteTimeout = 10 * time.Minute
+)
+
+func NewResource() resource.Resource {
+	return &Resource{
+		BaseResource: helper.NewBaseResource(
+			helper.BaseResourceConfig{
+				Name:   "linode_instance",
+				IDType: types.StringType,
+				Schema: &frameworkResourceSchema,
+				TimeoutOpts: &timeouts.Opts{
+					Create: true,
+					Update: true,
+					Delete: true,
+				},
+			},
+		),
+	}
+}
+
+type Resource struct {
+	helper.BaseResource
+}
+
+func (r *Resource) UpgradeState(context.Context) map[int64]resource.StateUpgrader {
+	return map[int64]resource.StateUpgrader{
+		0: {
+			PriorSchema:   &frameworkResourceSchemaV0,
+			StateUpgrader: upgradeInstanceStateV0toV1,
+		},
+	}
+}
+
+func upgradeInstanceStateV0toV1(
+	ctx context.Context, req resource.UpgradeStateRequest, resp *resource.UpgradeStateResponse,
+) {
+	var stateV0 ResourceModel
+	var stateV1 ResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &stateV0)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(stateV1.UpgradeFromV0(ctx, stateV0)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &stateV1)...)
+}
+
+func (r *Resource) ValidateConfig(
+	ctx context.Context,
+	req resource.ValidateConfigRequest,
+	resp *resource.ValidateConfigResponse,
+) {
+	var disks, configs, interfaces types.List
+	var rescueDevices types.Set
+	var image types.String
+	var booted types.Bool
+
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("disk"), &disks)...)
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("config"), &configs)...)
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("interface"), &interfaces)...)
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("rescue_device"), &rescueDevices)...)
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("image"), &image)...)
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("booted"), &booted)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	disksDefined := listBlockDefined(disks)
+	configsDefined := listBlockDefined(configs)
+
+	if disksDefined || configsDefined {
+		for _, name := range []string{
+			"image", "root_pass", "authorized_keys", "authorized_users", "swap_size",
+			"backup_id", "stackscript_id", "stackscript_data", "interface",
+		} {
+			if !configAttributeDefined(ctx, req, name, &resp.Diagnostics) {
+				continue
+			}
+
+			resp.Diagnostics.AddAttributeError(
+				path.Root(name),
+				"Invalid Attribute Combination",
+				fmt.Sprintf("%q cannot be specified when \"disk\" or \"config\" is specified", name),
+			)
+		}
+	}
+
+	if listBlockDefined(interfaces) && image.IsNull() {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("interface"),
+			"Invalid Attribute Combination",
+			"\"image\" must be specified when \"interface\" is specified",
+		)
+	}
+
+	if !rescueDevices.IsNull() && len(rescueDevices.Elements()) > 0 &&
+		configAttributeNull(ctx, req, "rescue", &resp.Diagnostics) {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("rescue_device"),
+			"Invalid Attribute Combination",
+			"\"rescue\" must be specified when \"rescue_device\" is specified",
+		)
+	}
+
+	if booted.ValueBool() && image.IsNull() && !(disksDefined && configsDefined) {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("booted"),
+			"Invalid Attribute Combination",
+			"booted requires an image or disk/config be defined",
+		)
+	}
+}
+
+func listBlockDefined(list types.List) bool {
+	return list.IsUnknown() || (!list.IsNull() && len(list.Elements()) > 0)
+}
+
+func configAttributeNull(
+	ctx context.Context, req resource.ValidateConfigRequest, name string, diags *diag.Diagnostics,
+) bool {
+	return !configAttributeDefined(ctx, req, name, diags)
+}
+
+func configAttributeDefined(
+	ctx context.Context, req resource.ValidateConfigRequest, name string, diags *diag.Diagnostics,
+) bool {
+	var value attr.Value
+	diags.Append(req.Config.GetAttribute(ctx, path.Root(name), &value)...)
+
+	return valueDefined(value)
+}
+
+// valueDefined returns whether the given configuration value is set,
+// treating empty collections the same as unset ones.
+func valueDefined(value attr.Value) bool {
+	if value == nil || value.IsNull() {
+		return false
+	}
+
+	switch v := value.(type) {
+	case types.List:
+		return v.IsUnknown() || len(v.Elements()) > 0
+	case types.Set:
+		return v.IsUnknown() || len(v.Elements()) > 0
+	case types.Map:
+		return v.IsUnknown() || len(v.Elements()) > 0
+	}
+
+	return true
+}
+
+func (r *Resource) ModifyPlan(
+	ctx context.Context,
+	req resource.ModifyPlanRequest,
+	resp *resource.ModifyPlanResponse,
+) {
+	// Nothing to plan when the resource is being destroyed
+	// or the provider hasn't been configured yet
+	if req.Plan.Raw.IsNull() || r.Meta == nil {
+		return
+	}
+
+	var state *ResourceModel
+	if !req.State.Raw.IsNull() {
+		state = new(ResourceModel)
+		resp.Diagnostics.Append(req.State.Get(ctx, state)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+
+		r.planComputedBlocks(ctx, req, resp)
+		r.alignNestedComputedValues(ctx, req, resp, state)
+		r.markComputedValuesUnknown(ctx, req, resp, state)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+
+	r.validateDiskLayout(ctx, req, resp, state)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	r.validateRegionCapabilities(ctx, req, resp, state)
+}
+
+// computedBlocks are the blocks that are computed from the instance when they aren't configured.
+var computedBlocks = []string{"alerts", "config", "disk"}
+
+// planComputedBlocks plans the blocks that aren't configured as they are in state.
+func (r *Resource) planComputedBlocks(
+	ctx context.Context,
+	req resource.ModifyPlanRequest,
+	resp *resource.ModifyPlanResponse,
+) {
+	for _, name := range computedBlocks {
+		var configValue, stateValue types.List
+		resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root(name), &configValue)...)
+		resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root(name), &stateValue)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+
+		if listBlockDefined(configValue) {
+			continue
+		}
+
+		resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root(name), stateValue)...)
+	}
+}
+
+// clearComputedBlocks clears the blocks that aren't configured,
+// so that they are computed from the instance rather than applied.
+func clearComputedBlocks(
+	ctx context.Context, config tfsdk.Config, data *ResourceModel, diags *diag.Diagnostics,
+) {
+	var alerts, configs, disks types.List
+	diags.Append(config.GetAttribute(ctx, path.Root("alerts"), &alerts)...)
+	diags.Append(config.GetAttribute(ctx, path.Root("config"), &configs)...)
+	diags.Append(config.GetAttribute(ctx, path.Root("disk"), &disks)...)
+	if diags.HasError() {
+		return
+	}
+
+	if !listBlockDefined(alerts) {
+		data.Alerts = nil
+	}
+	if !listBlockDefined(configs) {
+		data.Config = nil
+	}
+	if !listBlockDefined(disks) {
+		data.Disk = nil
+	}
+}
+
+// alignNestedComputedValues plans the computed attributes of the disk and config blocks
+// from the element with the same label in state, as elements are identified by their label
+// rather than their position.
+func (r *Resource) alignNestedComputedValues(
+	ctx context.Context,
+	req resource.ModifyPlanRequest,
+	resp *resource.ModifyPlanResponse,
+	state *ResourceModel,
+) {
+	var planDisks, configDisks, planConfigs types.List
+	resp.Diagnostics.Append(req.Plan.GetAttribute(ctx, path.Root("disk"), &planDisks)...)
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("disk"), &configDisks)...)
+	resp.Diagnostics.Append(req.Plan.GetAttribute(ctx, path.Root("config"), &planConfigs)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !planDisks.IsUnknown() && !configDisks.IsUnknown() && len(planDisks.Elements()) > 0 {
+		var disks, configured []DiskModel
+		resp.Diagnostics.Append(planDisks.ElementsAs(ctx, &disks, false)...)
+		resp.Diagnostics.Append(configDisks.ElementsAs(ctx, &configured, false)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+
+		stateDisks := make(map[string]DiskModel, len(state.Disk))
+		for _, disk := range state.Disk {
+			stateDisks[disk.Label.ValueString()] = disk
+		}
+
+		for i, disk := range disks {
+			id, filesystem := types.Int64Unknown(), types.StringUnknown()
+			if stateDisk, ok := stateDisks[disk.Label.ValueString()]; ok && !disk.Label.IsUnknown() {
+				id, filesystem = stateDisk.ID, stateDisk.Filesystem
+			}
+
+			diskPath := path.Root("disk").AtListIndex(i)
+
+			if !disk.ID.Equal(id) {
+				resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, diskPath.AtName("id"), id)...)
+			}
+
+			if i < len(configured) && configured[i].Filesystem.IsNull() && !disk.Filesystem.Equal(filesystem) {
+				resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, diskPath.AtName("filesystem"), filesystem)...)
+			}
+		}
+	}
+
+	if !planConfigs.IsUnknown() && len(planConfigs.Elements()) > 0 {
+		var configs []ConfigModel
+		resp.Diagnostics.Append(planConfigs.ElementsAs(ctx, &configs, false)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+
+		stateConfigs := make(map[string]ConfigModel, len(state.Config))
+		for _, config := range state.Config {
+			stateConfigs[config.Label.ValueString()] = config
+		}
+
+		for i, config := range configs {
+			id := types.Int64Unknown()
+			if stateConfig, ok := stateConfigs[config.Label.ValueString()]; ok && !config.Label.IsUnknown() {
+				id = stateConfig.ID
+			}
+
+			if !config.ID.Equal(id) {
+				resp.Diagnostics.Append(
+					resp.Plan.SetAttribute(ctx, path.Root("config").AtListIndex(i).AtName("id"), id)...,
+				)
+			}
+		}
+	}
+}
+
+// markComputedValuesUnknown marks the computed attributes that are affected by the planned changes as unknown.
+func (r *Resource) markComputedValuesUnknown(
+	ctx context.Context,
+	req resource.ModifyPlanRequest,
+	resp *resource.ModifyPlanResponse,
+	state *ResourceModel,
+) {
+	var region, typ types.String
+	var privateIP, backupsEnabled types.Bool
+	var disks, configs types.List
+
+	resp.Diagnostics.Append(req.Plan.GetAttribute(ctx, path.Root("region"), &region)...)
+	resp.Diagnostics.Append(req.Plan.GetAttribute(ctx, path.Root("type"), &typ)...)
+	resp.Diagnostics.Append(req.Plan.GetAttribute(ctx, path.Root("private_ip"), &privateIP)...)
+	resp.Diagnostics.Append(req.Plan.GetAttribute(ctx, path.Root("backups_enabled"), &backupsEnabled)...)
+	resp.Diagnostics.Append(resp.Plan.GetAttribute(ctx, path.Root("disk"), &disks)...)
+	resp.Diagnostics.Append(resp.Plan.GetAttribute(ctx, path.Root("config"), &configs)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var unknown []string
+
+	if !region.Equal(state.Region) {
+		unknown = append(unknown, "ip_address", "ipv4", "ipv6", "private_ip_address", "host_uuid")
+	}
+
+	if !typ.Equal(state.Type) {
+		unknown = append(unknown, "specs", "capabilities", "host_uuid", "backups")
+	}
+
+	if !privateIP.Equal(state.PrivateIP) {
+		unknown = append(unknown, "private_ip_address", "ipv4")
+	}
+
+	if !backupsEnabled.Equal(state.BackupsEnabled) {
+		unknown = append(unknown, "backups")
+	}
+
+	if len(disks.Elements()) > 0 || disks.IsUnknown() {
+		var stateDisks types.List
+		resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root("disk"), &stateDisks)...)
+		if !disks.Equal(stateDisks) {
+			unknown = append(unknown, "swap_size")
+		}
+	}
+
+	if len(configs.Elements()) > 0 || configs.IsUnknown() {
+		var stateConfigs types.List
+		resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root("config"), &stateConfigs)...)
+		if !configs.Equal(stateConfigs) {
+			unknown = append(unknown, "boot_config_label")
+		}
+	}
+
+	for _, name := range unknown {
+		var configValue attr.Value
+		resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root(name), &configValue)...)
+
+		// Configured values are always planned as configured
+		if configValue != nil && !configValue.IsNull() {
+			continue
+		}
+
+		var planValue attr.Value
+		resp.Diagnostics.Append(req.Plan.GetAttribute(ctx, path.Root(name), &planValue)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+
+		resp.Diagnostics.Append(
+			resp.Plan.SetAttribute(ctx, path.Root(name), unknownValue(ctx, planValue))...,
+		)
+	}
+}
+
+func unknownValue(ctx context.Context, value attr.Value) attr.Value {
+	switch v := value.(type) {
+	case types.String:
+		return types.StringUnknown()
+	case types.Int64:
+		return types.Int64Unknown()
+	case types.Set:
+		return types.SetUnknown(v.ElementType(ctx))
+	case types.List:
+		return types.ListUnknown(v.ElementType(ctx))
+	default:
+		return value
+	}
+}
+
+// validateRegionCapabilities validates at plan time that the planned region supports
+// the disk encryption and the type of the instance.
+func (r *Resource) validateRegionCapabilities(
+	ctx context.Context,
+	req resource.ModifyPlanRequest,
+	resp *resource.ModifyPlanResponse,
+	state *ResourceModel,
+) {
+	var region, typ, diskEncryption types.String
+
+	resp.Diagnostics.Append(req.Plan.GetAttribute(ctx, path.Root("region"), &region)...)
+	resp.Diagnostics.Append(req.Plan.GetAttribute(ctx, path.Root("type"), &typ)...)
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("disk_encryption"), &diskEncryption)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if region.IsUnknown() || region.ValueString() == "" {
+		return
+	}
+
+	regionChanged := state == nil || !region.Equal(state.Region)
+	typeChanged := state == nil || !typ.Equal(state.Type)
+
+	var requirements []helper.RegionCapabilityRequirement
+
+	if diskEncryption.ValueString() == string(linodego.InstanceDiskEncryptionEnabled) &&
+		(regionChanged || !diskEncryption.Equal(state.DiskEncryption)) {
+		requirements = append(requirements, helper.RegionCapabilityRequirement{
+			Capability: linodego.CapabilityDiskEncryption,
+			Path:       path.Root("disk_encryption"),
+			Reason:     `disk_encryption = "enabled"`,
+		})
+	}
+
+	if !typ.IsUnknown() && typ.ValueString() != "" && (regionChanged || typeChanged) {
+		linodeType, err := r.Meta.Client.GetType(ctx, typ.ValueString())
+		if err != nil {
+			resp.Diagnostics.AddError(
+				fmt.Sprintf("Failed to Get Linode Type %s", typ.ValueString()), err.Error(),
+			)
+			return
+		}
+
+		requirements = append(
+			requirements, helper.TypeRegionCapabilityRequirements(linodeType, path.Root("type"))...,
+		)
+	}
+
+	helper.ValidateRegionCapabilities(
+		ctx, r.Meta.Client, region.ValueString(), requirements, &resp.Diagnostics,
+	)
+}
+
+// validateDiskLayout validates at plan time that the planned disk layout
+// fits the target linode type, so that oversized layouts are rejected before
+// any resize operation is started.
+func (r *Resource) validateDiskLayout(
+	ctx context.Context,
+	req resource.ModifyPlanRequest,
+	resp *resource.ModifyPlanResponse,
+	state *ResourceModel,
+) {
+	var typ types.String
+	var swapSize, configSwapSize types.Int64
+	var resizeDisk types.Bool
+	var planDisks, configDisks types.List
+
+	resp.Diagnostics.Append(req.Plan.GetAttribute(ctx, path.Root("type"), &typ)...)
+	resp.Diagnostics.Append(req.Plan.GetAttribute(ctx, path.Root("swap_size"), &swapSize)...)
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("swap_size"), &configSwapSize)...)
+	resp.Diagnostics.Append(req.Plan.GetAttribute(ctx, path.Root("resize_disk"), &resizeDisk)...)
+	resp.Diagnostics.Append(req.Plan.GetAttribute(ctx, path.Root("disk"), &planDisks)...)
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("disk"), &configDisks)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if typ.IsUnknown() || typ.ValueString() == "" {
+		return
+	}
+
+	client := r.Meta.Client
+
+	linodeType, err := client.GetType(ctx, typ.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			fmt.Sprintf("Failed to Get Linode Type %s", typ.ValueString()), err.Error(),
+		)
+		return
+	}
+
+	explicitDisks := listBlockDefined(configDisks)
+
+	var disks []DiskModel
+	if !planDisks.IsUnknown() {
+		resp.Diagnostics.Append(planDisks.ElementsAs(ctx, &disks, false)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+
+	newDiskSize, newDiskSizeKnown := sumDiskModelSizes(disks)
+
+	if explicitDisks && !planDisks.IsUnknown() && newDiskSizeKnown {
+		if err := assertDiskSizeFitsInstanceType(newDiskSize, linodeType); err != nil {
+			resp.Diagnostics.AddAttributeError(path.Root("disk"), "Insufficient Disk Capacity", err.Error())
+			return
+		}
+	}
+
+	swapSizeKnown := !swapSize.IsUnknown() && !configSwapSize.IsNull()
+
+	// The swap disk of a new instance is carved out of the disk capacity of its type
+	if state == nil {
+		if swapSizeKnown && swapSize.ValueInt64() >= int64(linodeType.Disk) {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("swap_size"),
+				"Insufficient Disk Capacity",
+				fmt.Sprintf(
+					"swap_size %d leaves no space for the boot disk of linode type %s with a disk capacity of %d",
+					swapSize.ValueInt64(), linodeType.Label, linodeType.Disk,
+				),
+			)
+		}
+		return
+	}
+
+	oldDiskSize, _ := sumDiskModelSizes(state.Disk)
+
+	typeChanged := !typ.Equal(state.Type)
+	swapSizeChanged := swapSizeKnown && !swapSize.Equal(state.SwapSize)
+	diskSizeChanged := explicitDisks && newDiskSize != oldDiskSize
+
+	if !typeChanged && !swapSizeChanged && !diskSizeChanged {
+		return
+	}
+
+	instanceID := helper.FrameworkSafeStringToInt(state.ID.ValueString(), &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	instanceDisks, err := client.ListInstanceDisks(ctx, instanceID, nil)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			fmt.Sprintf("Failed to List Disks of Linode Instance %d", instanceID), err.Error(),
+		)
+		return
+	}
+
+	if !explicitDisks && typeChanged {
+		if err := validateImplicitDiskLayoutForType(
+			instanceDisks, linodeType, resizeDisk.ValueBool(),
+		); err != nil {
+			resp.Diagnostics.AddAttributeError(path.Root("type"), "Invalid Disk Layout", err.Error())
+			return
+		}
+	}
+
+	if !explicitDisks && swapSizeChanged {
+		if err := validateSwapSizeChange(
+			instanceDisks, int(state.SwapSize.ValueInt64()), int(swapSize.ValueInt64()),
+		); err != nil {
+			resp.Diagnostics.AddAttributeError(path.Root("swap_size"), "Invalid Swap Size", err.Error())
+			return
+		}
+	}
+
+	instance, err := client.GetInstance(ctx, instanceID)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			fmt.Sprintf("Failed to Get Linode Instance %d", instanceID), err.Error(),
+		)
+		return
+	}
+
+	// Resizing the instance or its disks requires the instance to be shut down
+	if !helper.IsInstanceInBootedState(instance.Status) {
+		return
+	}
+
+	// Attach the warning to the attribute whose change requires the shutdown
+	warningPath := path.Root("disk")
+	if typeChanged {
+		warningPath = path.Root("type")
+	} else if swapSizeChanged {
+		warningPath = path.Root("swap_size")
+	}
+
+	if r.Meta.Config.SkipImplicitReboots.ValueBool() {
+		resp.Diagnostics.AddAttributeWarning(
+			warningPath,
+			"Linode Instance Shutdown Required",
+			fmt.Sprintf(
+				"The planned disk or type changes require Linode instance %d to be shut down, "+
+					"which is not done implicitly while 'skip_implicit_reboots' is enabled.",
+				instanceID,
+			),
+		)
+		return
+	}
+
+	resp.Diagnostics.AddAttributeWarning(
+		warningPath,
+		"Linode Instance Will Be Shut Down",
+		fmt.Sprintf(
+			"The planned disk or type changes will shut down Linode instance %d during the apply "+
+				"and boot it back up once they are complete.",
+			instanceID,
+		),
+	)
+}
+
+// sumDiskModelSizes returns the amount of disk space used by the given disks,
+// and whether the size of every disk is known.
+func sumDiskModelSizes(disks []DiskModel) (int, bool) {
+	size := 0
+	for _, disk := range disks {
+		if disk.Size.IsUnknown() {
+			return size, false
+		}
+		size += int(disk.Size.ValueInt64())
+	}
+	return size, true
+}
+
+func (r *Resource) Create(
+	ctx context.Context,
+	req resource.CreateRequest,
+	resp *resource.CreateResponse,
+) {
+	tflog.Debug(ctx, "Create "+r.Config.Name)
+
+	var plan ResourceModel
+	var configBooted types.Bool
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("booted"), &configBooted)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	createTimeout, diags := plan.Timeouts.Create(ctx, LinodeInstanceCreateTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, createTimeout)
+	defer cancel()
+
+	client := r.Meta.Client
+
+	createOpts := linodego.InstanceCreateOptions{
+		Region:         plan.Region.ValueString(),
+		Type:           plan.Type.ValueString(),
+		Label:          plan.Label.ValueString(),
+		Group:          plan.Group.ValueString(),
+		BackupsEnabled: plan.BackupsEnabled.ValueBool(),
+		PrivateIP:      plan.PrivateIP.ValueBool(),
+		DiskEncryption: linodego.InstanceDiskEncryption(plan.DiskEncryption.ValueString()),
+		FirewallID:     helper.FrameworkSafeInt64ToInt(plan.FirewallID.ValueInt64(), &resp.Diagnostics),
+		Metadata:       plan.ExpandMetadata(),
+		PlacementGroup: plan.ExpandPlacementGroup(),
+	}
+
+	if !plan.IPv4.IsUnknown() && !plan.IPv4.IsNull() {
+		resp.Diagnostics.Append(plan.IPv4.ElementsAs(ctx, &createOpts.IPv4, false)...)
+	}
+
+	resp.Diagnostics.Append(plan.Tags.ElementsAs(ctx, &createOpts.Tags, false)...)
+
+	if len(plan.Interface) > 0 {
+		createOpts.Interfaces = expandInterfaces(ctx, plan.Interface, &resp.Diagnostics)
+	}
+
+	disksOk := len(plan.Disk) > 0
+	configsOk := len(plan.Config) > 0
+	bootedNull := configBooted.IsNull()
+	booted := configBooted.ValueBool()
+
+	// If we don't have disks and we don't have configs, use the single API call approach
+	if !disksOk && !configsOk {
+		createOpts.AuthorizedKeys = expandNonNullStrings(
+			plan.AuthorizedKeys, "invalid input for authorized_keys: keys cannot be empty or null", &resp.Diagnostics,
+		)
+		createOpts.AuthorizedUsers = expandNonNullStrings(
+			plan.AuthorizedUsers, "invalid input for authorized_users: users cannot be empty or null", &resp.Diagnostics,
+		)
+
+		createOpts.RootPass = plan.RootPass.ValueString()
+		if createOpts.RootPass == "" {
+			createOpts.RootPass = helper.FrameworkCreateRandomRootPassword(&resp.Diagnostics)
+		}
+
+		createOpts.Image = plan.Image.ValueString()
+
+		createOpts.Booted = linodego.Pointer(true)
+		if !bootedNull {
+			createOpts.Booted = &booted
+		}
+
+		createOpts.BackupID = helper.FrameworkSafeInt64ToInt(plan.BackupID.ValueInt64(), &resp.Diagnostics)
+		if swapSize := helper.FrameworkSafeInt64ToInt(
+			plan.SwapSize.ValueInt64(), &resp.Diagnostics,
+		); swapSize > 0 {
+			createOpts.SwapSize = &swapSize
+		}
+
+		createOpts.StackScriptID = helper.FrameworkSafeInt64ToInt(plan.StackScriptID.ValueInt64(), &resp.Diagnostics)
+
+		if !plan.StackScriptData.IsNull() && !plan.StackScriptData.IsUnknown() {
+			resp.Diagnostics.Append(plan.StackScriptData.ElementsAs(ctx, &createOpts.StackScriptData, false)...)
+		}
+	} else {
+		createOpts.Booted = linodego.Pointer(false) // necessary to prepare disks and configs
+	}
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	createPoller, err := client.NewEventPollerWithoutEntity(linodego.EntityLinode, linodego.ActionLinodeCreate)
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to Initialize Event Poller", err.Error())
+		return
+	}
+
+	tflog.Debug(ctx, "client.CreateInstance(...)", map[string]any{
+		"options": createOpts,
+	})
+
+	instance, err := client.CreateInstance(ctx, createOpts)
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to Create Linode Instance", err.Error())
+		return
+	}
+
+	ctx = tflog.SetField(ctx, "linode_id", instance.ID)
+
+	// Track the instance right away so a failed provisioning taints the resource
+	resp.Diagnostics.Append(
+		resp.State.SetAttribute(ctx, path.Root("id"), types.StringValue(strconv.Itoa(instance.ID)))...,
+	)
+
+	createPoller.EntityID = instance.ID
+
+	updateOpts := linodego.InstanceUpdateOptions{}
+	doUpdate := false
+
+	if !plan.WatchdogEnabled.ValueBool() {
+		doUpdate = true
+		updateOpts.WatchdogEnabled = linodego.Pointer(false)
+	}
+
+	if len(plan.Alerts) > 0 {
+		doUpdate = true
+		updateOpts.Alerts = plan.ExpandAlerts(instance.Alerts, &resp.Diagnostics)
+	}
+
+	if doUpdate {
+		tflog.Debug(ctx, "client.UpdateInstance(...)", map[string]any{
+			"options": updateOpts,
+		})
+
+		instance, err = client.UpdateInstance(ctx, instance.ID, updateOpts)
+		if err != nil {
+			resp.Diagnostics.AddError("Failed to Update Linode Instance", err.Error())
+			return
+		}
+	}
+
+	// Look up tables for any disks and configs we create
+	// - so configs and initrd can reference disks by label
+	// - so configs can be referenced as a boot_config_label param
+	var diskIDLabelMap map[string]int
+	bootConfig := 0
+
+	if disksOk {
+		tflog.Debug(ctx, "Waiting for instance creation to complete before provisioning disks")
+
+		if _, err = createPoller.WaitForFinished(ctx, getDeadlineSeconds(ctx)); err != nil {
+			resp.Diagnostics.AddError("Failed to Wait for Linode Instance to Finish Creating", err.Error())
+			return
+		}
+
+		tflog.Debug(ctx, "Instance is ready, provisioning disks")
+
+		diskIDLabelMap = make(map[string]int, len(plan.Disk))
+
+		for _, disk := range plan.Disk {
+			diskOpts := disk.ExpandCreateOptions(ctx, &resp.Diagnostics)
+			if resp.Diagnostics.HasError() {
+				return
+			}
+
+			instanceDisk, err := createInstanceDisk(ctx, *client, *instance, diskOpts)
+			if err != nil {
+				resp.Diagnostics.AddError("Failed to Create Linode Instance Disk", err.Error())
+				return
+			}
+			diskIDLabelMap[instanceDisk.Label] = instanceDisk.ID
+		}
+	}
+
+	if configsOk {
+		configOpts := make([]linodego.InstanceConfigCreateOptions, len(plan.Config))
+		for i, config := range plan.Config {
+			configOpts[i] = config.ExpandCreateOptions(ctx, diskIDLabelMap, &resp.Diagnostics)
+		}
+		if resp.Diagnostics.HasError() {
+			return
+		}
+
+		configIDMap, err := createInstanceConfigs(ctx, *client, instance.ID, configOpts, makeVolumeDetacher(*client))
+		if err != nil {
+			resp.Diagnostics.AddError("Failed to Create Linode Instance Config", err.Error())
+			return
+		}
+
+		if bootConfigLabel := plan.BootConfigLabel.ValueString(); bootConfigLabel != "" {
+			bootConfig = findConfigIDByLabel(configIDMap, bootConfigLabel)
+			if bootConfig == 0 {
+				resp.Diagnostics.AddAttributeError(
+					path.Root("boot_config_label"),
+					"Config Not Found",
+					fmt.Sprintf("Config label '%s' not found", bootConfigLabel),
+				)
+				return
+			}
+		}
+	}
+
+	if !plan.SharedIPv4.IsUnknown() && len(plan.SharedIPv4.Elements()) > 0 {
+		shareOpts := linodego.IPAddressesShareOptions{
+			LinodeID: instance.ID,
+		}
+		resp.Diagnostics.Append(plan.SharedIPv4.ElementsAs(ctx, &shareOpts.IPs, false)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+
+		tflog.Debug(ctx, "client.ShareIPAddresses(...)", map[string]any{
+			"options": shareOpts,
+		})
+
+		if err := client.ShareIPAddresses(ctx, shareOpts); err != nil {
+			resp.Diagnostics.AddError("Failed to Share IPv4 Addresses with Linode Instance", err.Error())
+			return
+		}
+	}
+
+	targetStatus := linodego.InstanceRunning
+
+	if createOpts.Booted == nil || !*createOpts.Booted {
+		if disksOk && configsOk && (bootedNull || booted) {
+			p, err := client.NewEventPoller(ctx, instance.ID, linodego.EntityLinode, linodego.ActionLinodeBoot)
+			if err != nil {
+				resp.Diagnostics.AddError("Failed to Initialize Event Poller", err.Error())
+				return
+			}
+
+			tflog.Debug(ctx, "client.BootInstance(...)", map[string]any{
+				"config_id": bootConfig,
+			})
+
+			if err = client.BootInstance(ctx, instance.ID, bootConfig); err != nil {
+				resp.Diagnostics.AddError(
+					fmt.Sprintf("Failed to Boot Linode Instance %d", instance.ID), err.Error(),
+				)
+				return
+			}
+
+			event, err := p.WaitForFinished(ctx, getDeadlineSeconds(ctx))
+			if err != nil {
+				resp.Diagnostics.AddError(
+					fmt.Sprintf("Failed to Wait for Linode Instance %d to Boot", instance.ID), err.Error(),
+				)
+				return
+			}
+
+			tflog.Debug(ctx, "Instance finished booting", map[string]any{
+				"event_id": event.ID,
+			})
+		} else {
+			targetStatus = linodego.InstanceOffline
+		}
+	}
+
+	// If the instance has implicit disks and config with no specified image it will not boot.
+	if !(disksOk && configsOk) && len(createOpts.Image) < 1 {
+		targetStatus = linodego.InstanceOffline
+	}
+
+	if !r.Meta.Config.SkipInstanceReadyPoll.ValueBool() {
+		tflog.Debug(ctx, "Waiting for instance to reach target status", map[string]any{
+			"target_status": targetStatus,
+		})
+
+		if _, err = client.WaitForInstanceStatus(
+			ctx, instance.ID, targetStatus, getDeadlineSeconds(ctx),
+		); err != nil {
+			resp.Diagnostics.AddError(
+				fmt.Sprintf(
+					"Timed-out Waiting for Linode Instance %d to Reach Status %s", instance.ID, targetStatus,
+				),
+				err.Error(),
+			)
+			return
+		}
+	}
+
+	if plan.Rescue.ValueBool() {
+		devices, err := expandRescueDevices(ctx, *client, plan.RescueDevice, instance.ID)
+		if err != nil {
+			resp.Diagnostics.AddError("Failed to Expand Rescue Devices", err.Error())
+			return
+		}
+
+		if err := helper.RescueInstanceSync(
+			ctx, client, instance.ID, devices, getDeadlineSeconds(ctx),
+		); err != nil {
+			resp.Diagnostics.AddError(
+				fmt.Sprintf("Failed to Boot Linode Instance %d into Rescue Mode", instance.ID), err.Error(),
+			)
+			return
+		}
+	}
+
+	r.refresh(ctx, &plan, instance.ID, true, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func findConfigIDByLabel(configs map[int]linodego.InstanceConfig, label string) int {
+	for id, config := range configs {
+		if config.Label == label {
+			return id
+		}
+	}
+	return 0
+}
+
+func (r *Resource) Read(
+	ctx context.Context,
+	req resource.ReadRequest,
+	resp *resource.ReadResponse,
+) {
+	tflog.Debug(ctx, "Read "+r.Config.Name)
+
+	var state ResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if helper.FrameworkAttemptRemoveResourceForEmptyID(ctx, state.ID, resp) {
+		return
+	}
+
+	ctx = populateLogAttributes(ctx, state)
+
+	id := helper.FrameworkSafeStringToInt(state.ID.ValueString(), &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	client := r.Meta.Client
+
+	if _, err := client.GetInstance(ctx, id); err != nil {
+		if linodego.IsNotFound(err) {
+			resp.Diagnostics.AddWarning(
+				"Linode Instance Not Found",
+				fmt.Sprintf(
+					"Removing Linode Instance %d from state because it no longer exists", id,
+				),
+			)
+			resp.State.RemoveResource(ctx)
+			return
+		}
+
+		resp.Diagnostics.AddError(
+			fmt.Sprintf("Failed to Get Linode Instance %d", id), err.Error(),
+		)
+		return
+	}
+
+	r.refresh(ctx, &state, id, false, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+// refresh fetches the instance and its related resources and flattens them into the given model.
+func (r *Resource) refresh(
+	ctx context.Context,
+	data *ResourceModel,
+	id int,
+	preserveKnown bool,
+	diags *diag.Diagnostics,
+) {
+	client := r.Meta.Client
+
+	instance, err := client.GetInstance(ctx, id)
+	if err != nil {
+		diags.AddError(fmt.Sprintf("Failed to Get Linode Instance %d", id), err.Error())
+		return
+	}
+
+	instanceNetwork, err := client.GetInstanceIPAddresses(ctx, id)
+	if err != nil {
+		diags.AddError(fmt.Sprintf("Failed to Get Networking of Linode Instance %d", id), err.Error())
+		return
+	}
+
+	instanceDisks, err := client.ListInstanceDisks(ctx, id, nil)
+	if err != nil {
+		diags.AddError(fmt.Sprintf("Failed to List Disks of Linode Instance %d", id), err.Error())
+		return
+	}
+
+	instanceConfigs, err := client.ListInstanceConfigs(ctx, id, nil)
+	if err != nil {
+		diags.AddError(fmt.Sprintf("Failed to List Configs of Linode Instance %d", id), err.Error())
+		return
+	}
+
+	// Rescue mode is only tracked once it has been requested through this resource
+	inRescue := false
+	if data.Rescue.ValueBool() {
+		inRescue, err = helper.IsInstanceInRescueMode(ctx, client, id)
+		if err != nil {
+			diags.AddError("Failed to Check Linode Instance Rescue Status", err.Error())
+			return
+		}
+	}
+
+	data.FlattenInstance(
+		ctx, instance, instanceNetwork, instanceDisks, instanceConfigs, inRescue, preserveKnown, diags,
+	)
+}
+
+// adjustSwapSizeIfNeeded handles changes to the swap_size attribute if needed. If there is a change, this means
+// resizing the underlying main/swap disks on the instance to match the declared swap size allocation.
+//
+// returns bool describing whether the linode needs to be restarted.
+func adjustSwapSizeIfNeeded(
+	ctx context.Context, client *linodego.Client, instance *linodego.Instance, oldSwap, newSwap int,
+) (bool, error) {
+	if oldSwap == newSwap {
+		return false, nil
+	}
+
+	// If the swap_size attribute is set, there are two default disks attached to the instance (the main disk of type ext4
+	// and a swap disk), as custom disk configuration via "disk" nested attributes conflicts with the swap_size.
+	bootDisk, swapDisk, err := getInstanceDefaultDisks(ctx, instance.ID, client)
+	if err != nil {
+		return false, err
+	}
+
+	diff := newSwap - oldSwap
+	newBootDiskSize := bootDisk.Size - diff
+
+	toResize := []struct {
+		size int
+		disk *linodego.InstanceDisk
+	}{
+		{
+			size: newBootDiskSize,
+			disk: bootDisk,
+		},
+		{
+			size: newSwap,
+			disk: swapDisk,
+		},
+	}
+
+	if bootDisk.Size < newBootDiskSize {
+		// swap disk needs to be downsized first to upsize main disk
+		toResize[0], toResize[1] = toResize[1], toResize[0]
+	}
+
+	for _, resizeOp := range toResize {
+		if err := changeInstanceDiskSize(ctx, client, *instance, *resizeOp.disk, resizeOp.size); err != nil {
+			return true, err
+		}
+	}
+	return true, nil
+}
+
+func findDiskByFS(disks []linodego.InstanceDisk, fs linodego.DiskFilesystem) *linodego.InstanceDisk {
+	for _, disk := range disks {
+		if disk.Filesystem == fs {
+			return &disk
+		}
+	}
+	return nil
+}
+
+func (r *Resource) Update(
+	ctx context.Context,
+	req resource.UpdateRequest,
+	resp *resource.UpdateResponse,
+) {
+	tflog.Debug(ctx, "Update "+r.Config.Name)
+
+	var plan, state ResourceModel
+	var configBooted, configRescue types.Bool
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("booted"), &configBooted)...)
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("rescue"), &configRescue)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	clearComputedBlocks(ctx, req.Config, &plan, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx = populateLogAttributes(ctx, state)
+
+	updateTimeout, diags := plan.Timeouts.Update(ctx, LinodeInstanceUpdateTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, updateTimeout)
+	defer cancel()
+
+	client := r.Meta.Client
+	skipImplicitReboots := r.Meta.Config.SkipImplicitReboots.ValueBool()
+
+	id := helper.FrameworkSafeStringToInt(state.ID.ValueString(), &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	instance, err := client.GetInstance(ctx, id)
+	if err != nil {
+		resp.Diagnostics.AddError(fmt.Sprintf("Failed to Get Linode Instance %d", id), err.Error())
+		return
+	}
+
+	updateOpts := linodego.InstanceUpdateOptions{}
+	simpleUpdate := false
+
+	if !plan.Label.IsUnknown() && !plan.Label.Equal(state.Label) {
+		updateOpts.Label = plan.Label.ValueString()
+		simpleUpdate = true
+	}
+	if !plan.Group.Equal(state.Group) {
+		updateOpts.Group = plan.Group.ValueStringPointer()
+		simpleUpdate = true
+	}
+	if !plan.Tags.Equal(state.Tags) {
+		var tags []string
+		resp.Diagnostics.Append(plan.Tags.ElementsAs(ctx, &tags, false)...)
+		updateOpts.Tags = &tags
+		simpleUpdate = true
+	}
+	if !plan.WatchdogEnabled.Equal(state.WatchdogEnabled) {
+		updateOpts.WatchdogEnabled = plan.WatchdogEnabled.ValueBoolPointer()
+		simpleUpdate = true
+	}
+	if len(plan.Alerts) > 0 && !reflect.DeepEqual(plan.Alerts, state.Alerts) {
+		updateOpts.Alerts = plan.ExpandAlerts(instance.Alerts, &resp.Diagnostics)
+		simpleUpdate = true
+	}
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// The assignment of an externally managed placement group is left as is
+	if !plan.PlacementGroupExternallyManaged.ValueBool() {
+		oldPGID, newPGID, compliantOnly := 0, 0, (*bool)(nil)
+		if len(state.PlacementGroup) > 0 {
+			oldPGID = int(state.PlacementGroup[0].ID.ValueInt64())
+		}
+		if len(plan.PlacementGroup) > 0 {
+			newPGID = int(plan.PlacementGroup[0].ID.ValueInt64())
+			compliantOnly = plan.PlacementGroup[0].CompliantOnly.ValueBoolPointer()
+		}
+
+		if oldPGID != newPGID {
+			tflog.Debug(ctx, "Attempting Placement Group reassignment", map[string]any{
+				"old_pg_id": oldPGID,
+				"new_pg_id": newPGID,
+			})
+
+			if err := reassignPlacementGroup(
+				ctx, *client, instance.ID, oldPGID, newPGID, compliantOnly,
+			); err != nil {
+				resp.Diagnostics.AddError("Failed to Update Linode Placement Group", err.Error())
+				return
+			}
+		}
+	}
+
+	// apply staged simple updates early
+	if simpleUpdate {
+		tflog.Debug(ctx, "client.UpdateInstance(...)", map[string]any{
+			"options": updateOpts,
+		})
+
+		if instance, err = client.UpdateInstance(ctx, id, updateOpts); err != nil {
+			resp.Diagnostics.AddError(fmt.Sprintf("Failed to Update Linode Instance %d", id), err.Error())
+			return
+		}
+	}
+
+	if !plan.BackupsEnabled.IsUnknown() && !plan.BackupsEnabled.Equal(state.BackupsEnabled) {
+		backupsEnabled := plan.BackupsEnabled.ValueBool()
+
+		tflog.Info(ctx, "Updating backups enrollment", map[string]any{
+			"backups_enabled": backupsEnabled,
+		})
+
+		if backupsEnabled {
+			tflog.Debug(ctx, "client.EnableInstanceBackups(...)")
+			err = client.EnableInstanceBackups(ctx, instance.ID)
+		} else {
+			tflog.Debug(ctx, "client.CancelInstanceBackups(...)")
+			err = client.CancelInstanceBackups(ctx, instance.ID)
+		}
+
+		if err != nil {
+			resp.Diagnostics.AddError("Failed to Update Backups Enrollment", err.Error())
+			return
+		}
+	}
+
+	rebootInstance := false
+
+	if !plan.PrivateIP.Equal(state.PrivateIP) {
+		if !plan.PrivateIP.ValueBool() {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("private_ip"),
+				"Failed to Remove Private IP Address",
+				fmt.Sprintf(
+					"Removing a Private IP address of Instance %d must be handled through a support ticket",
+					instance.ID,
+				),
+			)
+			return
+		}
+
+		tflog.Info(ctx, "client.AddInstanceIPAddress(...)", map[string]any{
+			"public": false,
+		})
+
+		if _, err := client.AddInstanceIPAddress(ctx, instance.ID, false); err != nil {
+			resp.Diagnostics.AddError(
+				fmt.Sprintf("Failed to Activate Private Networking on Instance %d", instance.ID), err.Error(),
+			)
+			return
+		}
+		rebootInstance = true
+	}
+
+	migrationType := linodego.InstanceMigrationType(plan.MigrationType.ValueString())
+
+	// If the region has changed,
+	// we should migrate the Linode.
+	if !plan.Region.Equal(state.Region) {
+		instance, err = applyInstanceMigration(ctx, client, instance, plan.Region.ValueString(), migrationType)
+		if err != nil {
+			resp.Diagnostics.AddError("Failed to Migrate Linode Instance", err.Error())
+			return
+		}
+	}
+
+	oldSpec, newSpec, err := getInstanceTypeChange(ctx, client, state.Type.ValueString(), plan.Type.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to Get Resize Info for Linode Instance", err.Error())
+		return
+	}
+	upsized := newSpec.Disk > oldSpec.Disk
+
+	// Explicit disks are only managed when they are defined
+	var diskOpts []linodego.InstanceDiskCreateOptions
+	if plan.Image.IsNull() && len(plan.Disk) > 0 {
+		diskOpts = make([]linodego.InstanceDiskCreateOptions, len(plan.Disk))
+		for i, disk := range plan.Disk {
+			diskOpts[i] = disk.ExpandCreateOptions(ctx, &resp.Diagnostics)
+		}
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+
+	resizeDisk := plan.ResizeDisk.ValueBool()
+
+	if upsized {
+		// The linode was upsized; apply before disk changes to allocate more disk
+		if instance, err = applyInstanceTypeChange(
+			ctx, client, instance, newSpec, resizeDisk, migrationType, diskOpts,
+		); err != nil {
+			resp.Diagnostics.AddError("Failed to Change Linode Instance Type", err.Error())
+			return
+		}
+		rebootInstance = true
+	}
+
+	if len(diskOpts) > 0 {
+		if didChange, err := applyInstanceDiskSpec(
+			ctx, client, instance, newSpec, state.Disk, diskOpts,
+		); err == nil && didChange {
+			rebootInstance = true
+		} else if err != nil && newSpec.Disk < oldSpec.Disk && reflect.DeepEqual(plan.Disk, state.Disk) {
+			// Linode was downsized but the pre-existing disk config does not fit new instance spec
+			resp.Diagnostics.AddError(
+				"Failed to Apply Linode Instance Disk Spec", err.Error()+"."+downsizeFailedMessage,
+			)
+			return
+		} else if err != nil {
+			resp.Diagnostics.AddError("Failed to Apply Linode Instance Disk Spec", err.Error())
+			return
+		}
+	}
+
+	if oldSpec.ID != newSpec.ID && !upsized {
+		// linode was downsized or changed to a type with the same disk allocation
+		if instance, err = applyInstanceTypeChange(
+			ctx, client, instance, newSpec, resizeDisk, migrationType, diskOpts,
+		); err != nil {
+			resp.Diagnostics.AddError("Failed to Change Linode Instance Type", err.Error())
+			return
+		}
+	}
+
+	if !plan.SwapSize.IsUnknown() && !state.SwapSize.IsNull() {
+		if didChange, err := adjustSwapSizeIfNeeded(
+			ctx, client, instance, int(state.SwapSize.ValueInt64()), int(plan.SwapSize.ValueInt64()),
+		); err != nil {
+			resp.Diagnostics.AddError("Failed to Adjust Swap Size", err.Error())
+			return
+		} else if didChange {
+			rebootInstance = true
+		}
+	}
+
+	diskLabels := make([]string, len(plan.Disk))
+	for i, disk := range plan.Disk {
+		diskLabels[i] = disk.Label.ValueString()
+	}
+
+	diskIDLabelMap, err := getInstanceDiskLabelIDMap(ctx, *client, diskLabels, instance.ID)
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to Get Disk Label to ID Mappings", err.Error())
+		return
+	}
+
+	bootConfig := 0
+	bootConfigLabel := plan.BootConfigLabel.ValueString()
+
+	var updatedConfigMap map[string]int
+
+	if len(plan.Config) > 0 {
+		configOpts := make([]linodego.InstanceConfigCreateOptions, len(plan.Config))
+		for i, config := range plan.Config {
+			configOpts[i] = config.ExpandCreateOptions(ctx, diskIDLabelMap, &resp.Diagnostics)
+		}
+		if resp.Diagnostics.HasError() {
+			return
+		}
+
+		didChangeConfig, configMap, updatedConfigs, err := updateInstanceConfigs(
+			ctx, *client, *instance, state.Config, configOpts, bootConfigLabel,
+		)
+		if err != nil {
+			resp.Diagnostics.AddError("Failed to Update Linode Instance Configs", err.Error())
+			return
+		}
+		rebootInstance = rebootInstance || didChangeConfig
+		updatedConfigMap = configMap
+
+		if bootConfigLabel != "" {
+			foundConfig, found := updatedConfigMap[bootConfigLabel]
+			if !found {
+				resp.Diagnostics.AddAttributeError(
+					path.Root("boot_config_label"),
+					"Config Not Found",
+					fmt.Sprintf("Config label '%s' not found", bootConfigLabel),
+				)
+				return
+			}
+			bootConfig = foundConfig
+		} else if len(updatedConfigs) > 0 {
+			bootConfig = updatedConfigs[0].ID
+		}
+	} else {
+		// Configs that aren't defined explicitly are resolved from the instance
+		configs, err := client.ListInstanceConfigs(ctx, instance.ID, nil)
+		if err != nil {
+			resp.Diagnostics.AddError("Failed to List Linode Instance Configs", err.Error())
+			return
+		}
+
+		for _, config := range configs {
+			if config.Label == bootConfigLabel || bootConfig == 0 {
+				bootConfig = config.ID
+			}
+		}
+	}
+
+	bootedNull := configBooted.IsNull()
+	booted := configBooted.ValueBool()
+
+	if len(plan.Interface) > 0 || len(state.Interface) > 0 {
+		interfaceReboot, d := r.updateInterfaces(ctx, &plan, id, bootConfig, bootedNull)
+		resp.Diagnostics.Append(d...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+
+		if interfaceReboot != nil {
+			rebootInstance = *interfaceReboot
+		}
+	}
+
+	if !plan.SharedIPv4.IsUnknown() && !plan.SharedIPv4.Equal(state.SharedIPv4) {
+		shareOpts := linodego.IPAddressesShareOptions{
+			LinodeID: instance.ID,
+			IPs:      make([]string, 0),
+		}
+		resp.Diagnostics.Append(plan.SharedIPv4.ElementsAs(ctx, &shareOpts.IPs, false)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+
+		tflog.Debug(ctx, "client.ShareIPAddresses(...)", map[string]any{
+			"options": shareOpts,
+		})
+
+		if err := client.ShareIPAddresses(ctx, shareOpts); err != nil {
+			resp.Diagnostics.AddError("Failed to Share IPv4 Addresses with Linode Instance", err.Error())
+			return
+		}
+	}
+
+	// Don't reboot if the Linode should be powered off
+	if !bootedNull && !booted {
+		rebootInstance = false
+	}
+
+	// Only reboot the instance if implicit reboots are not skipped
+	if skipImplicitReboots {
+		rebootInstance = false
+	}
+
+	if rebootInstance && len(diskIDLabelMap) > 0 && len(updatedConfigMap) > 0 && bootConfig > 0 {
+		ctx := tflog.SetField(ctx, "config_id", bootConfig)
+
+		tflog.Info(ctx, "Implicitly rebooting instance")
+
+		p, err := client.NewEventPoller(ctx, id, linodego.EntityLinode, linodego.ActionLinodeReboot)
+		if err != nil {
+			resp.Diagnostics.AddError("Failed to Initialize Event Poller", err.Error())
+			return
+		}
+
+		tflog.Debug(ctx, "client.RebootInstance(...)")
+
+		if err = client.RebootInstance(ctx, instance.ID, bootConfig); err != nil {
+			resp.Diagnostics.AddError(fmt.Sprintf("Failed to Reboot Linode Instance %d", instance.ID), err.Error())
+			return
+		}
+
+		tflog.Debug(ctx, "Waiting for instance reboot to complete")
+
+		if _, err = p.WaitForFinished(ctx, getDeadlineSeconds(ctx)); err != nil {
+			resp.Diagnostics.AddError(
+				fmt.Sprintf("Failed to Wait for Linode Instance %d to Finish Rebooting", instance.ID), err.Error(),
+			)
+			return
+		}
+
+		tflog.Debug(ctx, "Instance has finished rebooting")
+
+		if _, err = client.WaitForInstanceStatus(
+			ctx, instance.ID, linodego.InstanceRunning, getDeadlineSeconds(ctx),
+		); err != nil {
+			resp.Diagnostics.AddError(
+				fmt.Sprintf("Timed-out Waiting for Linode Instance %d to Boot", instance.ID), err.Error(),
+			)
+			return
+		}
+	}
+
+	rescueHandled, err := handleRescueUpdate(
+		ctx, client, configRescue, configBooted, plan.RescueDevice,
+		!reflect.DeepEqual(plan.RescueDevice, state.RescueDevice), instance.ID, bootConfig,
+	)
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to Handle Rescue Update", err.Error())
+		return
+	}
+
+	if !rescueHandled {
+		if err := handleBootedUpdate(ctx, client, configBooted, instance.ID, bootConfig); err != nil {
+			resp.Diagnostics.AddError("Failed to Handle Booted Update", err.Error())
+			return
+		}
+	}
+
+	r.refresh(ctx, &plan, id, true, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+// updateInterfaces applies the interface blocks to the boot config of the instance.
+// It returns whether the instance should be rebooted, or nil if the interfaces are unchanged.
+func (r *Resource) updateInterfaces(
+	ctx context.Context,
+	plan *ResourceModel,
+	instanceID, bootConfig int,
+	bootedNull bool,
+) (*bool, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	client := r.Meta.Client
+
+	expandedInterfaces := expandInterfaces(ctx, plan.Interface, &diags)
+	if diags.HasError() {
+		return nil, diags
+	}
+
+	config, err := client.GetInstanceConfig(ctx, instanceID, bootConfig)
+	if err != nil {
+		diags.AddError(fmt.Sprintf("Failed to Get Config %d", bootConfig), err.Error())
+		return nil, diags
+	}
+
+	resolveInterfaceIPv4(expandedInterfaces, config.Interfaces)
+
+	currentInterfaces := config.GetUpdateOptions().Interfaces
+	if reflect.DeepEqual(expandedInterfaces, currentInterfaces) ||
+		(len(expandedInterfaces) == 0 && len(currentInterfaces) == 0) {
+		return nil, diags
+	}
+
+	powerOffRequired := VPCInterfaceIncluded(config.Interfaces, expandedInterfaces)
+
+	tflog.Debug(ctx, "Updating instance config for interface changes", map[string]any{
+		"config_id": bootConfig,
+	})
+
+	instance, err := client.GetInstance(ctx, instanceID)
+	if err != nil {
+		diags.AddError(fmt.Sprintf("Failed to Get Linode Instance %d", instanceID), err.Error())
+		return nil, diags
+	}
+
+	// we should power on Linode after updating of the interfaces if
+	// it's currently on and booted attribute is unset by the user.
+	// Otherwise, it will stay off (if it's already off) or be handled by
+	// `handleBootedUpdate` (if booted is set to an explicit value)
+	shouldPowerOn := bootedNull && powerOffRequired && instance.Status == linodego.InstanceRunning
+
+	if powerOffRequired {
+		if err := ShutdownInstanceForVPCInterfaceUpdate(
+			ctx, client, r.Meta.Config.SkipImplicitReboots.ValueBool(), instanceID, getDeadlineSeconds(ctx),
+		); err != nil {
+			diags.AddError("Failed to Shut Down Linode Instance", err.Error())
+			return nil, diags
+		}
+	}
+
+	configUpdateOpts := linodego.InstanceConfigUpdateOptions{
+		Interfaces: expandedInterfaces,
+	}
+
+	tflog.Debug(ctx, "client.UpdateInstanceConfig(...)", map[string]any{
+		"options": configUpdateOpts,
+	})
+
+	if _, err := client.UpdateInstanceConfig(ctx, instanceID, bootConfig, configUpdateOpts); err != nil {
+		diags.AddError("Failed to Set Boot Config Interfaces", err.Error())
+		return nil, diags
+	}
+
+	if shouldPowerOn {
+		tflog.Debug(ctx, "Booting instance after VPC interface change applied")
+
+		if err := helper.BootInstanceSync(
+			ctx, client, instanceID, bootConfig, getDeadlineSeconds(ctx),
+		); err != nil {
+			diags.AddError("Failed to Boot Linode Instance after VPC Interface Change", err.Error())
+			return nil, diags
+		}
+	}
+
+	// reboot won't be needed if we power off the Linode during update
+	return linodego.Pointer(!powerOffRequired), diags
+}
+
+func (r *Resource) Delete(
+	ctx context.Context,
+	req resource.DeleteRequest,
+	resp *resource.DeleteResponse,
+) {
+	tflog.Debug(ctx, "Delete "+r.Config.Name)
+
+	var state ResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx = populateLogAttributes(ctx, state)
+
+	deleteTimeout, diags := state.Timeouts.Delete(ctx, LinodeInstanceDeleteTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, deleteTimeout)
+	defer cancel()
+
+	client := r.Meta.Client
+
+	id := helper.FrameworkSafeStringToInt(state.ID.ValueString(), &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	p, err := client.NewEventPoller(ctx, id, linodego.EntityLinode, linodego.ActionLinodeDelete)
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to Initialize Event Poller", err.Error())
+		return
+	}
+
+	tflog.Debug(ctx, "client.DeleteInstance(...)")
+
+	if err := client.DeleteInstance(ctx, id); err != nil {
+		if !linodego.IsNotFound(err) {
+			resp.Diagnostics.AddError(fmt.Sprintf("Failed to Delete Linode Instance %d", id), err.Error())
+		}
+		return
+	}
+
+	if !r.Meta.Config.SkipInstanceDeletePoll.ValueBool() {
+		// Wait for full deletion to assure volumes are detached
+		if _, err = p.WaitForFinished(ctx, getDeadlineSeconds(ctx)); err != nil {
+			resp.Diagnostics.AddError(
+				fmt.Sprintf("Failed to Wait for Linode Instance %d to be Deleted", id), err.Error(),
+			)
+		}
+	}
+}
+
+func populateLogAttributes(ctx context.Context, data ResourceModel) context.Context {
+	return helper.SetLogFieldBulk(ctx, map[string]any{
+		"linode_id": data.ID.ValueString(),
+	})
+}
@@ -0,0 +1,1404 @@
+package instance
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework-validators/boolvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/mapvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64default"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/listplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/mapplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/setplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/linode/terraform-provider-linode/v2/linode/helper"
+	linodeplanmodifiers "github.com/linode/terraform-provider-linode/v2/linode/helper/planmodifiers"
+)
+
+const replaceExistingElementDescription = "Replacement is only required when an existing element is changed."
+
+const (
+	onlyAllowedForVPCMsg  = "This attribute is only allowed for VPC interfaces."
+	onlyAllowedForVLANMsg = "This attribute is only allowed for VLAN interfaces."
+	requiredForVPCMsg     = "This attribute is required for VPC interfaces."
+	requiredForVLANMsg    = "This attribute is required for VLAN interfaces."
+)
+
+var deviceSlots = []string{"sda", "sdb", "sdc", "sdd", "sde", "sdf", "sdg", "sdh"}
+
+var specsObjectType = types.ObjectType{
+	AttrTypes: map[string]attr.Type{
+		"disk":                types.Int64Type,
+		"memory":              types.Int64Type,
+		"vcpus":               types.Int64Type,
+		"transfer":            types.Int64Type,
+		"accelerated_devices": types.Int64Type,
+		"gpus":                types.Int64Type,
+	},
+}
+
+var backupsScheduleObjectType = types.ObjectType{
+	AttrTypes: map[string]attr.Type{
+		"day":    types.StringType,
+		"window": types.StringType,
+	},
+}
+
+var backupsObjectType = types.ObjectType{
+	AttrTypes: map[string]attr.Type{
+		"available": types.BoolType,
+		"enabled":   types.BoolType,
+		"schedule":  types.ListType{ElemType: backupsScheduleObjectType},
+	},
+}
+
+// requiresReplaceIfExistingString requires the replacement of the instance only when
+// an attribute of an existing nested element changes, as new elements are created in place.
+func requiresReplaceIfExistingString() planmodifier.String {
+	return stringplanmodifier.RequiresReplaceIf(
+		func(
+			ctx context.Context,
+			req planmodifier.StringRequest,
+			resp *stringplanmodifier.RequiresReplaceIfFuncResponse,
+		) {
+			resp.RequiresReplace = !req.StateValue.IsNull() && !req.PlanValue.IsUnknown()
+		},
+		replaceExistingElementDescription,
+		replaceExistingElementDescription,
+	)
+}
+
+// requiresReplaceIfExistingBool requires the replacement of the instance only when
+// an attribute of an existing nested element changes, as new elements are created in place.
+func requiresReplaceIfExistingBool() planmodifier.Bool {
+	return boolplanmodifier.RequiresReplaceIf(
+		func(
+			ctx context.Context,
+			req planmodifier.BoolRequest,
+			resp *boolplanmodifier.RequiresReplaceIfFuncResponse,
+		) {
+			resp.RequiresReplace = !req.StateValue.IsNull() && !req.PlanValue.IsUnknown()
+		},
+		replaceExistingElementDescription,
+		replaceExistingElementDescription,
+	)
+}
+
+// legacyRootPass keeps the root password hashes stored by the SDKv2 implementation of this
+// resource in the plan while they match the configured password, so that upgraded instances
+// don't show a root_pass change on the first plan.
+func legacyRootPass() planmodifier.String {
+	return &legacyRootPassPlanModifier{}
+}
+
+type legacyRootPassPlanModifier struct{}
+
+func (m *legacyRootPassPlanModifier) Description(ctx context.Context) string {
+	return "Keeps hashed root passwords stored by earlier versions of this resource while they match the configuration."
+}
+
+func (m *legacyRootPassPlanModifier) MarkdownDescription(ctx context.Context) string {
+	return m.Description(ctx)
+}
+
+func (m *legacyRootPassPlanModifier) PlanModifyString(
+	ctx context.Context,
+	req planmodifier.StringRequest,
+	resp *planmodifier.StringResponse,
+) {
+	if req.StateValue.IsNull() || req.PlanValue.IsNull() || req.PlanValue.IsUnknown() {
+		return
+	}
+
+	if req.StateValue.ValueString() == hashString(req.PlanValue.ValueString()) {
+		resp.PlanValue = req.StateValue
+	}
+}
+
+func interfaceBlock(description string) schema.ListNestedBlock {
+	return schema.ListNestedBlock{
+		Description: description,
+		NestedObject: schema.NestedBlockObject{
+			Attributes: map[string]schema.Attribute{
+				"purpose": schema.StringAttribute{
+					Description: "The type of interface.",
+					Required:    true,
+					Validators: []validator.String{
+						stringvalidator.OneOfCaseInsensitive("public", "vlan", "vpc"),
+					},
+				},
+				"ipam_address": schema.StringAttribute{
+					Description: "This Network Interface's private IP address in " +
+						"Classless Inter-Domain Routing (CIDR) notation." +
+						onlyAllowedForVLANMsg,
+					Optional: true,
+					Computed: true,
+					Default:  stringdefault.StaticString(""),
+				},
+				"label": schema.StringAttribute{
+					Description: "The name of the VALN. " + requiredForVLANMsg +
+						" " + onlyAllowedForVLANMsg,
+					Optional: true,
+					Computed: true,
+					Default:  stringdefault.StaticString(""),
+				},
+				"id": schema.Int64Attribute{
+					Description: "The ID of the interface.",
+					Computed:    true,
+					PlanModifiers: []planmodifier.Int64{
+						int64planmodifier.UseStateForUnknown(),
+					},
+				},
+				"subnet_id": schema.Int64Attribute{
+					Description: "The ID of the subnet which the VPC interface is connected to." +
+						requiredForVPCMsg + onlyAllowedForVPCMsg,
+					Optional: true,
+				},
+				"vpc_id": schema.Int64Attribute{
+					Description: "The ID of VPC of the subnet which the VPC " +
+						"interface is connected to.",
+					Computed: true,
+					PlanModifiers: []planmodifier.Int64{
+						int64planmodifier.UseStateForUnknown(),
+					},
+				},
+				"primary": schema.BoolAttribute{
+					Description: "Whether the interface is the primary interface that should " +
+						"have the default route for this Linode.",
+					Optional: true,
+					Computed: true,
+					Default:  booldefault.StaticBool(false),
+				},
+				"active": schema.BoolAttribute{
+					Description: "Whether this interface is currently booted and active.",
+					Computed:    true,
+				},
+				"ip_ranges": schema.ListAttribute{
+					Description: "List of VPC IPs or IP ranges inside the VPC subnet.",
+					ElementType: types.StringType,
+					Optional:    true,
+					Validators: []validator.List{
+						listvalidator.ValueStringsAre(helper.IPRangeValidator()),
+					},
+				},
+			},
+			Blocks: map[string]schema.Block{
+				"ipv4": schema.ListNestedBlock{
+					Description: "The IPv4 configuration of the VPC interface." +
+						onlyAllowedForVPCMsg,
+					Validators: []validator.List{
+						listvalidator.SizeAtMost(1),
+					},
+					NestedObject: schema.NestedBlockObject{
+						Attributes: map[string]schema.Attribute{
+							"vpc": schema.StringAttribute{
+								Description: "The IP from the VPC subnet to use for this interface.",
+								Optional:    true,
+								Computed:    true,
+								PlanModifiers: []planmodifier.String{
+									stringplanmodifier.UseStateForUnknown(),
+								},
+							},
+							"nat_1_1": schema.StringAttribute{
+								Description: "The public IP that will be used for the " +
+									"one-to-one NAT purpose.",
+								Optional: true,
+								Computed: true,
+								PlanModifiers: []planmodifier.String{
+									stringplanmodifier.UseStateForUnknown(),
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func deviceBlock() schema.ListNestedBlock {
+	return schema.ListNestedBlock{
+		Description: deviceDescription,
+		Validators: []validator.List{
+			listvalidator.SizeAtMost(1),
+		},
+		NestedObject: schema.NestedBlockObject{
+			Attributes: map[string]schema.Attribute{
+				"disk_label": schema.StringAttribute{
+					Description: "The `label` of the `disk` to map to this `device` slot.",
+					Optional:    true,
+					Computed:    true,
+				},
+				"disk_id": schema.Int64Attribute{
+					Description: "The Disk ID to map to this disk slot",
+					Optional:    true,
+					Computed:    true,
+				},
+				"volume_id": schema.Int64Attribute{
+					Description: "The Block Storage volume ID to map to this disk slot",
+					Optional:    true,
+				},
+			},
+		},
+	}
+}
+
+func devicesBlock() schema.ListNestedBlock {
+	blocks := make(map[string]schema.Block, len(deviceSlots))
+	for _, slot := range deviceSlots {
+		blocks[slot] = deviceBlock()
+	}
+
+	return schema.ListNestedBlock{
+		Description: "Device sda-sdh can be either a Disk or Volume identified by disk_label or volume_id. " +
+			"Only one type per slot allowed.",
+		Validators: []validator.List{
+			listvalidator.SizeAtMost(1),
+		},
+		NestedObject: schema.NestedBlockObject{
+			Blocks: blocks,
+		},
+	}
+}
+
+var frameworkResourceSchema = schema.Schema{
+	Version: 1,
+	Attributes: map[string]schema.Attribute{
+		"id": schema.StringAttribute{
+			Description: "The unique ID of this Linode instance.",
+			Computed:    true,
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.UseStateForUnknown(),
+			},
+		},
+		"image": schema.StringAttribute{
+			Description: "An Image ID to deploy the Disk from. Official Linode Images start with linode/, " +
+				"while your Images start with private/. See /images for more information on the Images available " +
+				"for you to use.",
+			Optional: true,
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.RequiresReplace(),
+			},
+			Validators: []validator.String{
+				stringvalidator.ConflictsWith(path.MatchRoot("backup_id")),
+			},
+		},
+		"backup_id": schema.Int64Attribute{
+			Description: "A Backup ID from another Linode's available backups. Your User must have read_write " +
+				"access to that Linode, the Backup must have a status of successful, and the Linode must be deployed " +
+				"to the same region as the Backup. See /linode/instances/{linodeId}/backups for a Linode's available " +
+				"backups. This field and the image field are mutually exclusive.",
+			Optional: true,
+			PlanModifiers: []planmodifier.Int64{
+				int64planmodifier.RequiresReplace(),
+			},
+		},
+		"stackscript_id": schema.Int64Attribute{
+			Description: "The StackScript to deploy to the newly created Linode. If provided, 'image' must also be " +
+				"provided, and must be an Image that is compatible with this StackScript.",
+			Optional: true,
+			PlanModifiers: []planmodifier.Int64{
+				int64planmodifier.RequiresReplace(),
+			},
+			Validators: []validator.Int64{
+				int64validator.AlsoRequires(path.MatchRoot("image")),
+			},
+		},
+		"stackscript_data": schema.MapAttribute{
+			Description: "An object containing responses to any User Defined Fields present in the StackScript " +
+				"being deployed to this Linode. Only accepted if 'stackscript_id' is given. The required values depend " +
+				"on the StackScript being deployed.",
+			ElementType: types.StringType,
+			Optional:    true,
+			Sensitive:   true,
+			PlanModifiers: []planmodifier.Map{
+				mapplanmodifier.RequiresReplace(),
+			},
+			Validators: []validator.Map{
+				mapvalidator.AlsoRequires(path.MatchRoot("image")),
+			},
+		},
+		"label": schema.StringAttribute{
+			Description: "The Linode's label is for display purposes only. If no label is provided for a Linode, " +
+				"a default will be assigned",
+			Optional: true,
+			Computed: true,
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.UseStateForUnknown(),
+			},
+			Validators: []validator.String{
+				stringvalidator.LengthBetween(3, 50),
+			},
+		},
+		"group": schema.StringAttribute{
+			Description:        "The display group of the Linode instance.",
+			DeprecationMessage: "Group label is deprecated. We recommend using tags instead.",
+			Optional:           true,
+			Computed:           true,
+			Default:            stringdefault.StaticString(""),
+		},
+		"tags": schema.SetAttribute{
+			Description: "An array of tags applied to this object. Tags are for organizational purposes only.",
+			ElementType: types.StringType,
+			Optional:    true,
+			Computed:    true,
+			PlanModifiers: []planmodifier.Set{
+				linodeplanmodifiers.CaseInsensitiveSet(),
+			},
+			Default: helper.EmptySetDefault(types.StringType),
+		},
+		"capabilities": schema.SetAttribute{
+			Description: "A list of capabilities of this Linode instance.",
+			ElementType: types.StringType,
+			Computed:    true,
+			PlanModifiers: []planmodifier.Set{
+				setplanmodifier.UseStateForUnknown(),
+			},
+		},
+		"boot_config_label": schema.StringAttribute{
+			Description: "The Label of the Instance Config that should be used to boot the Linode instance.",
+			Optional:    true,
+			Computed:    true,
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.UseStateForUnknown(),
+			},
+		},
+		"region": schema.StringAttribute{
+			Description: "This is the location where the Linode was deployed. This cannot be changed without " +
+				"opening a support ticket.",
+			Required: true,
+		},
+		"type": schema.StringAttribute{
+			Description: "The type of instance to be deployed, determining the price and size.",
+			Optional:    true,
+			Computed:    true,
+			Default:     stringdefault.StaticString("g6-standard-1"),
+		},
+		"resize_disk": schema.BoolAttribute{
+			Description: "If true, changes in Linode type will attempt to upsize or downsize implicitly created disks. " +
+				"This must be false if explicit disks are defined. This is an irreversible action as Linode disks cannot " +
+				"be automatically downsized.",
+			Optional: true,
+			Computed: true,
+			Default:  booldefault.StaticBool(false),
+			Validators: []validator.Bool{
+				boolvalidator.AlsoRequires(path.MatchRoot("image")),
+			},
+		},
+		"migration_type": schema.StringAttribute{
+			Description: "The type of migration to use for resize and migration operations.",
+			Optional:    true,
+			Computed:    true,
+			Default:     stringdefault.StaticString("cold"),
+			Validators: []validator.String{
+				stringvalidator.OneOfCaseInsensitive("cold", "warm"),
+			},
+		},
+		"status": schema.StringAttribute{
+			Description: "The status of the instance, indicating the current readiness state.",
+			Computed:    true,
+		},
+		"ip_address": schema.StringAttribute{
+			Description: "This Linode's Public IPv4 Address. If there are multiple public IPv4 addresses on this " +
+				"Instance, an arbitrary address will be used for this field.",
+			Computed: true,
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.UseStateForUnknown(),
+			},
+		},
+		"ipv6": schema.StringAttribute{
+			Description: "This Linode's IPv6 SLAAC addresses. This address is specific to a Linode, and may not be shared.",
+			Computed:    true,
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.UseStateForUnknown(),
+			},
+		},
+		"ipv4": schema.SetAttribute{
+			Description: "This Linode's IPv4 Addresses. Each Linode is assigned a single public IPv4 address upon " +
+				"creation, and may get a single private IPv4 address if needed. You could pass a reserved IPv4 address " +
+				"here to create a linode with a particular reserved IP address. You may need to open a support ticket " +
+				"to get additional IPv4 addresses.",
+			ElementType: types.StringType,
+			Optional:    true,
+			Computed:    true,
+			PlanModifiers: []planmodifier.Set{
+				setplanmodifier.UseStateForUnknown(),
+				setplanmodifier.RequiresReplace(),
+			},
+		},
+		"private_ip": schema.BoolAttribute{
+			Description: "If true, the created Linode will have private networking enabled, allowing use of the " +
+				"192.168.128.0/17 network within the Linode's region.",
+			Optional: true,
+			Computed: true,
+			Default:  booldefault.StaticBool(false),
+		},
+		"private_ip_address": schema.StringAttribute{
+			Description: "This Linode's Private IPv4 Address.  The regional private IP address range is " +
+				"192.168.128/17 address shared by all Linode Instances in a region.",
+			Computed: true,
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.UseStateForUnknown(),
+			},
+		},
+		"authorized_keys": schema.ListAttribute{
+			Description: "A list of SSH public keys to deploy for the root user on the newly created Linode. " +
+				"Only accepted if 'image' is provided.",
+			ElementType: types.StringType,
+			Optional:    true,
+			PlanModifiers: []planmodifier.List{
+				listplanmodifier.RequiresReplace(),
+			},
+			Validators: []validator.List{
+				listvalidator.AlsoRequires(path.MatchRoot("image")),
+			},
+		},
+		"authorized_users": schema.ListAttribute{
+			Description: "A list of Linode usernames. If the usernames have associated SSH keys, the keys will " +
+				"be appended to the `root` user's `~/.ssh/authorized_keys` file automatically. Only accepted if " +
+				"'image' is provided.",
+			ElementType: types.StringType,
+			Optional:    true,
+			PlanModifiers: []planmodifier.List{
+				listplanmodifier.RequiresReplace(),
+			},
+			Validators: []validator.List{
+				listvalidator.AlsoRequires(path.MatchRoot("image")),
+			},
+		},
+		"root_pass": schema.StringAttribute{
+			Description: "The password that will be initially assigned to the 'root' user account.",
+			Optional:    true,
+			Sensitive:   true,
+			PlanModifiers: []planmodifier.String{
+				legacyRootPass(),
+				stringplanmodifier.RequiresReplace(),
+			},
+			Validators: []validator.String{
+				stringvalidator.LengthBetween(
+					helper.RootPassMinimumCharacters,
+					helper.RootPassMaximumCharacters,
+				),
+			},
+		},
+		"swap_size": schema.Int64Attribute{
+			Description: "When deploying from an Image, this field is optional with a Linode API default of " +
+				"512mb, otherwise it is ignored. This is used to set the swap disk size for the newly-created Linode.",
+			Optional: true,
+			Computed: true,
+			PlanModifiers: []planmodifier.Int64{
+				int64planmodifier.UseStateForUnknown(),
+			},
+			Validators: []validator.Int64{
+				int64validator.AlsoRequires(path.MatchRoot("image")),
+			},
+		},
+		"backups_enabled": schema.BoolAttribute{
+			Description: "If this field is set to true, the created Linode will automatically be enrolled in the " +
+				"Linode Backup service. This will incur an additional charge. The cost for the Backup service is " +
+				"dependent on the Type of Linode deployed.",
+			Optional: true,
+			Computed: true,
+			PlanModifiers: []planmodifier.Bool{
+				boolplanmodifier.UseStateForUnknown(),
+			},
+		},
+		"watchdog_enabled": schema.BoolAttribute{
+			Description: "The watchdog, named Lassie, is a Shutdown Watchdog that monitors your Linode and will " +
+				"reboot it if it powers off unexpectedly. It works by issuing a boot job when your Linode powers off " +
+				"without a shutdown job being responsible. To prevent a loop, Lassie will give up if there have been " +
+				"more than 5 boot jobs issued within 15 minutes.",
+			Optional: true,
+			Computed: true,
+			Default:  booldefault.StaticBool(true),
+		},
+		"host_uuid": schema.StringAttribute{
+			Description: "The Linode’s host machine, as a UUID.",
+			Computed:    true,
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.UseStateForUnknown(),
+			},
+		},
+		"booted": schema.BoolAttribute{
+			Description: "If true, then the instance is kept or converted into in a running state. " +
+				"If false, the instance will be shutdown. If unspecified, the Linode's power status will not be managed.",
+			Optional: true,
+			Computed: true,
+			PlanModifiers: []planmodifier.Bool{
+				boolplanmodifier.UseStateForUnknown(),
+			},
+		},
+		"rescue": schema.BoolAttribute{
+			Description: "If true, the Linode will be booted into rescue mode. " +
+				"If false and the Linode is in rescue mode, it will be returned to its boot config. " +
+				"If undefined, no action will be taken.",
+			Optional: true,
+			Computed: true,
+			PlanModifiers: []planmodifier.Bool{
+				boolplanmodifier.UseStateForUnknown(),
+			},
+		},
+		"firewall_id": schema.Int64Attribute{
+			Description: "The ID of the firewall applied to the Linode " +
+				"instance during creation.",
+			Optional: true,
+			PlanModifiers: []planmodifier.Int64{
+				int64planmodifier.RequiresReplace(),
+			},
+		},
+		"shared_ipv4": schema.SetAttribute{
+			Description: "A set of IPv4 addresses to share with this Linode.",
+			ElementType: types.StringType,
+			Optional:    true,
+			Computed:    true,
+			PlanModifiers: []planmodifier.Set{
+				setplanmodifier.UseStateForUnknown(),
+			},
+			Validators: []validator.Set{
+				setvalidator.ValueStringsAre(helper.IPv4AddressValidator()),
+			},
+		},
+		"placement_group_externally_managed": schema.BoolAttribute{
+			Description: "If true, this placement group's assignment is externally managed and will " +
+				"NOT be updated by this resource.",
+			Optional: true,
+			Computed: true,
+			Default:  booldefault.StaticBool(false),
+		},
+		"has_user_data": schema.BoolAttribute{
+			Description: "Whether or not this Instance was created with user-data.",
+			Computed:    true,
+			PlanModifiers: []planmodifier.Bool{
+				boolplanmodifier.UseStateForUnknown(),
+			},
+		},
+		"disk_encryption": schema.StringAttribute{
+			Description: "The disk encryption policy for this Instance. " +
+				"NOTE: Disk encryption may not currently be available to all users.",
+			Optional: true,
+			// This is necessary to prevent instances created pre-disk-encryption from being recreated.
+			Computed: true,
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.UseStateForUnknown(),
+				stringplanmodifier.RequiresReplace(),
+			},
+			Validators: []validator.String{
+				stringvalidator.OneOf("enabled", "disabled"),
+			},
+		},
+		"lke_cluster_id": schema.Int64Attribute{
+			Description: "If applicable, the ID of the LKE cluster this Instance is a node of.",
+			Computed:    true,
+			PlanModifiers: []planmodifier.Int64{
+				int64planmodifier.UseStateForUnknown(),
+			},
+		},
+		"specs": schema.ListAttribute{
+			Description: "Information about the resources available to this Linode.",
+			ElementType: specsObjectType,
+			Computed:    true,
+			PlanModifiers: []planmodifier.List{
+				listplanmodifier.UseStateForUnknown(),
+			},
+		},
+		"backups": schema.ListAttribute{
+			Description: "Information about this Linode's backups status.",
+			ElementType: backupsObjectType,
+			Computed:    true,
+			PlanModifiers: []planmodifier.List{
+				listplanmodifier.UseStateForUnknown(),
+			},
+		},
+	},
+	Blocks: map[string]schema.Block{
+		"rescue_device": schema.SetNestedBlock{
+			Description: "Blocks for the disks and volumes to attach to the Linode when booted into rescue mode.",
+			NestedObject: schema.NestedBlockObject{
+				Attributes: map[string]schema.Attribute{
+					"device_name": schema.StringAttribute{
+						Description: "The device slot to map the disk or volume to.",
+						Required:    true,
+						Validators: []validator.String{
+							stringvalidator.OneOf("sda", "sdb", "sdc", "sdd", "sde", "sdf", "sdg"),
+						},
+					},
+					"disk_label": schema.StringAttribute{
+						Description: "The `label` of the `disk` to map to this device slot.",
+						Optional:    true,
+					},
+					"disk_id": schema.Int64Attribute{
+						Description: "The Disk ID to map to this device slot.",
+						Optional:    true,
+					},
+					"volume_id": schema.Int64Attribute{
+						Description: "The Block Storage volume ID to map to this device slot.",
+						Optional:    true,
+					},
+				},
+			},
+		},
+		"metadata": schema.ListNestedBlock{
+			Description: "Various fields related to the Linode Metadata service.",
+			Validators: []validator.List{
+				listvalidator.SizeAtMost(1),
+			},
+			NestedObject: schema.NestedBlockObject{
+				Attributes: map[string]schema.Attribute{
+					"user_data": schema.StringAttribute{
+						Description: "The base64-encoded user-defined data exposed to this instance " +
+							"through the Linode Metadata service. Refer to the base64encode(...) function " +
+							"for information on encoding content for this field.",
+						Optional: true,
+						PlanModifiers: []planmodifier.String{
+							stringplanmodifier.RequiresReplace(),
+						},
+					},
+				},
+			},
+		},
+		"placement_group": schema.ListNestedBlock{
+			Description: "Fields related to the Placement Group this instance is assigned to.",
+			Validators: []validator.List{
+				listvalidator.SizeAtMost(1),
+			},
+			NestedObject: schema.NestedBlockObject{
+				Attributes: map[string]schema.Attribute{
+					"id": schema.Int64Attribute{
+						Description: "The ID of the Placement Group to assign this Linode to.",
+						Required:    true,
+					},
+					"compliant_only": schema.BoolAttribute{
+						Optional: true,
+					},
+					"label": schema.StringAttribute{
+						Description: "The label of this Placement Group.",
+						Computed:    true,
+					},
+					"placement_group_type": schema.StringAttribute{
+						Description: "The placement group type for this Placement Group.",
+						Computed:    true,
+					},
+					"placement_group_policy": schema.StringAttribute{
+						Description: "Whether compliance is strictly enforced by this Placement Group.",
+						Optional:    true,
+						Computed:    true,
+						Validators: []validator.String{
+							stringvalidator.OneOfCaseInsensitive("strict", "flexible"),
+						},
+					},
+				},
+			},
+		},
+		"alerts": schema.ListNestedBlock{
+			Description: "Configuration options for alert triggers on this Linode.",
+			Validators: []validator.List{
+				listvalidator.SizeAtMost(1),
+			},
+			NestedObject: schema.NestedBlockObject{
+				Attributes: map[string]schema.Attribute{
+					"cpu": schema.Int64Attribute{
+						Description: "The percentage of CPU usage required to trigger an alert. If the average CPU usage " +
+							"over two hours exceeds this value, we'll send you an alert. If this is set to 0, the alert is disabled.",
+						Optional: true,
+						Computed: true,
+						PlanModifiers: []planmodifier.Int64{
+							int64planmodifier.UseStateForUnknown(),
+						},
+					},
+					"network_in": schema.Int64Attribute{
+						Description: "The amount of incoming traffic, in Mbit/s, required to trigger an alert. If the average " +
+							"incoming traffic over two hours exceeds this value, we'll send you an alert. " +
+							"If this is set to 0 (zero), the alert is disabled.",
+						Optional: true,
+						Computed: true,
+						PlanModifiers: []planmodifier.Int64{
+							int64planmodifier.UseStateForUnknown(),
+						},
+					},
+					"network_out": schema.Int64Attribute{
+						Description: "The amount of outbound traffic, in Mbit/s, required to trigger an alert. " +
+							"If the average outbound traffic over two hours exceeds this value, we'll send you an alert. " +
+							"If this is set to 0 (zero), the alert is disabled.",
+						Optional: true,
+						Computed: true,
+						PlanModifiers: []planmodifier.Int64{
+							int64planmodifier.UseStateForUnknown(),
+						},
+					},
+					"transfer_quota": schema.Int64Attribute{
+						Description: "The percentage of network transfer that may be used before an alert is triggered. " +
+							"When this value is exceeded, we'll alert you. " +
+							"If this is set to 0 (zero), the alert is disabled.",
+						Optional: true,
+						Computed: true,
+						PlanModifiers: []planmodifier.Int64{
+							int64planmodifier.UseStateForUnknown(),
+						},
+					},
+					"io": schema.Int64Attribute{
+						Description: "The amount of disk IO operation per second required to trigger an alert. " +
+							"If the average disk IO over two hours exceeds this value, we'll send you an alert. " +
+							"If set to 0, this alert is disabled.",
+						Optional: true,
+						Computed: true,
+						PlanModifiers: []planmodifier.Int64{
+							int64planmodifier.UseStateForUnknown(),
+						},
+					},
+				},
+			},
+		},
+		"interface": interfaceBlock(
+			"An array of Network Interfaces for this Linode to be created with. " +
+				"If an explicit config or disk is defined, interfaces must be declared in the config block.",
+		),
+		"config": schema.ListNestedBlock{
+			Description: "Configuration profiles define the VM settings and boot behavior of the Linode Instance.",
+			DeprecationMessage: "The embedded config is deprecated and scheduled to be removed in the next major " +
+				"version. Please consider migrating it to linode_instance_config resource.",
+			NestedObject: schema.NestedBlockObject{
+				Attributes: map[string]schema.Attribute{
+					"id": schema.Int64Attribute{
+						Description: "The unique ID of this Config.",
+						Computed:    true,
+						PlanModifiers: []planmodifier.Int64{
+							int64planmodifier.UseStateForUnknown(),
+						},
+					},
+					"label": schema.StringAttribute{
+						Description: "The Config's label for display purposes.  Also used by `boot_config_label`.",
+						Required:    true,
+						Validators: []validator.String{
+							stringvalidator.LengthBetween(1, 48),
+						},
+					},
+					"kernel": schema.StringAttribute{
+						Description: "A Kernel ID to boot a Linode with. Default is based on image choice. " +
+							"(examples: linode/latest-64bit, linode/grub2, linode/direct-disk)",
+						Optional: true,
+						Computed: true,
+					},
+					"run_level": schema.StringAttribute{
+						Description: "Defines the state of your Linode after booting. Defaults to default.",
+						Optional:    true,
+						Computed:    true,
+						Default:     stringdefault.StaticString("default"),
+						Validators: []validator.String{
+							stringvalidator.OneOf("default", "single", "binbash"),
+						},
+					},
+					"virt_mode": schema.StringAttribute{
+						Description: "Controls the virtualization mode. Defaults to paravirt.",
+						Optional:    true,
+						Computed:    true,
+						Default:     stringdefault.StaticString("paravirt"),
+						Validators: []validator.String{
+							stringvalidator.OneOf("paravirt", "fullvirt"),
+						},
+					},
+					"root_device": schema.StringAttribute{
+						Description: "The root device to boot. The corresponding disk must be attached.",
+						Optional:    true,
+						Computed:    true,
+					},
+					"comments": schema.StringAttribute{
+						Description: "Optional field for arbitrary User comments on this Config.",
+						Optional:    true,
+						Computed:    true,
+						Default:     stringdefault.StaticString(""),
+					},
+					"memory_limit": schema.Int64Attribute{
+						Description: "Defaults to the total RAM of the Linode",
+						Optional:    true,
+						Computed:    true,
+						Default:     int64default.StaticInt64(0),
+					},
+				},
+				Blocks: map[string]schema.Block{
+					"helpers": schema.ListNestedBlock{
+						Description: "Helpers enabled when booting to this Linode Config.",
+						Validators: []validator.List{
+							listvalidator.SizeAtMost(1),
+						},
+						NestedObject: schema.NestedBlockObject{
+							Attributes: map[string]schema.Attribute{
+								"updatedb_disabled": schema.BoolAttribute{
+									Description: "Disables updatedb cron job to avoid disk thrashing.",
+									Optional:    true,
+									Computed:    true,
+									Default:     booldefault.StaticBool(true),
+								},
+								"distro": schema.BoolAttribute{
+									Description: "Controls the behavior of the Linode Config's Distribution Helper setting.",
+									Optional:    true,
+									Computed:    true,
+									Default:     booldefault.StaticBool(true),
+								},
+								"modules_dep": schema.BoolAttribute{
+									Description: "Creates a modules dependency file for the Kernel you run.",
+									Optional:    true,
+									Computed:    true,
+									Default:     booldefault.StaticBool(true),
+								},
+								"network": schema.BoolAttribute{
+									Description: "Controls the behavior of the Linode Config's Network Helper setting, " +
+										"used to automatically configure additional IP addresses assigned to this instance.",
+									Optional: true,
+									Computed: true,
+									Default:  booldefault.StaticBool(true),
+								},
+								"devtmpfs_automount": schema.BoolAttribute{
+									Description: "Populates the /dev directory early during boot without udev. Defaults to false.",
+									Optional:    true,
+									Computed:    true,
+									Default:     booldefault.StaticBool(false),
+								},
+							},
+						},
+					},
+					"devices": devicesBlock(),
+					"interface": interfaceBlock(
+						"An array of Network Interfaces for this Linode’s Configuration Profile.",
+					),
+				},
+			},
+		},
+		"disk": schema.ListNestedBlock{
+			DeprecationMessage: "The embedded disk block in linode_instance resource is deprecated and " +
+				"scheduled to be removed in the next major version. Please consider migrating " +
+				"it to be the linode_instance_disk resource.",
+			NestedObject: schema.NestedBlockObject{
+				Attributes: map[string]schema.Attribute{
+					"label": schema.StringAttribute{
+						Description: "The disks label, which acts as an identifier in Terraform.",
+						Required:    true,
+						Validators: []validator.String{
+							stringvalidator.LengthBetween(1, 48),
+						},
+					},
+					"size": schema.Int64Attribute{
+						Description: "The size of the Disk in MB.",
+						Required:    true,
+					},
+					"id": schema.Int64Attribute{
+						Description: "The ID of the Disk (for use in Linode Image resources and Linode Instance Config Devices)",
+						Computed:    true,
+						PlanModifiers: []planmodifier.Int64{
+							int64planmodifier.UseStateForUnknown(),
+						},
+					},
+					"filesystem": schema.StringAttribute{
+						Description: "The Disk filesystem can be one of: raw, swap, ext3, ext4, initrd (max 32mb)",
+						Optional:    true,
+						Computed:    true,
+						PlanModifiers: []planmodifier.String{
+							stringplanmodifier.UseStateForUnknown(),
+							requiresReplaceIfExistingString(),
+						},
+						Validators: []validator.String{
+							stringvalidator.OneOf("raw", "swap", "ext3", "ext4", "initrd"),
+						},
+					},
+					"read_only": schema.BoolAttribute{
+						Description: "If true, this Disk is read-only.",
+						Optional:    true,
+						Computed:    true,
+						Default:     booldefault.StaticBool(false),
+						PlanModifiers: []planmodifier.Bool{
+							requiresReplaceIfExistingBool(),
+						},
+					},
+					"image": schema.StringAttribute{
+						Description: "An Image ID to deploy the Disk from. Official Linode Images start with linode/, " +
+							"while your Images start with private/.",
+						Optional: true,
+					},
+					"authorized_keys": schema.ListAttribute{
+						Description: "A list of SSH public keys to deploy for the root user on the newly created Linode. " +
+							"Only accepted if 'image' is provided.",
+						ElementType: types.StringType,
+						Optional:    true,
+					},
+					"authorized_users": schema.ListAttribute{
+						Description: "A list of Linode usernames. If the usernames have associated SSH keys, " +
+							"the keys will be appended to the `root` user's `~/.ssh/authorized_keys` file automatically. " +
+							"Only accepted if 'image' is provided.",
+						ElementType: types.StringType,
+						Optional:    true,
+					},
+					"stackscript_id": schema.Int64Attribute{
+						Description: "The StackScript to deploy to the newly created Linode. If provided, 'image' " +
+							"must also be provided, and must be an Image that is compatible with this StackScript.",
+						Optional: true,
+					},
+					"stackscript_data": schema.MapAttribute{
+						Description: "An object containing responses to any User Defined Fields present in the " +
+							"StackScript being deployed to this Linode. Only accepted if 'stackscript_id' is given. " +
+							"The required values depend on the StackScript being deployed.",
+						ElementType: types.StringType,
+						Optional:    true,
+						Sensitive:   true,
+					},
+					"root_pass": schema.StringAttribute{
+						Description: "The password that will be initialially assigned to the 'root' user account.",
+						Optional:    true,
+						Sensitive:   true,
+						PlanModifiers: []planmodifier.String{
+							legacyRootPass(),
+						},
+						Validators: []validator.String{
+							stringvalidator.LengthBetween(
+								helper.RootPassMinimumCharacters,
+								helper.RootPassMaximumCharacters,
+							),
+						},
+					},
+				},
+			},
+		},
+	},
+}
+
+func interfaceBlockV0() schema.ListNestedBlock {
+	return schema.ListNestedBlock{
+		NestedObject: schema.NestedBlockObject{
+			Attributes: map[string]schema.Attribute{
+				"purpose": schema.StringAttribute{
+					Required: true,
+				},
+				"ipam_address": schema.StringAttribute{
+					Optional: true,
+				},
+				"label": schema.StringAttribute{
+					Optional: true,
+				},
+				"id": schema.Int64Attribute{
+					Computed: true,
+				},
+				"subnet_id": schema.Int64Attribute{
+					Optional: true,
+				},
+				"vpc_id": schema.Int64Attribute{
+					Computed: true,
+				},
+				"primary": schema.BoolAttribute{
+					Optional: true,
+				},
+				"active": schema.BoolAttribute{
+					Computed: true,
+				},
+				"ip_ranges": schema.ListAttribute{
+					ElementType: types.StringType,
+					Optional:    true,
+				},
+			},
+			Blocks: map[string]schema.Block{
+				"ipv4": schema.ListNestedBlock{
+					NestedObject: schema.NestedBlockObject{
+						Attributes: map[string]schema.Attribute{
+							"vpc": schema.StringAttribute{
+								Optional: true,
+								Computed: true,
+							},
+							"nat_1_1": schema.StringAttribute{
+								Optional: true,
+								Computed: true,
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func devicesBlockV0() schema.ListNestedBlock {
+	blocks := make(map[string]schema.Block, len(deviceSlots))
+	for _, slot := range deviceSlots {
+		blocks[slot] = schema.ListNestedBlock{
+			NestedObject: schema.NestedBlockObject{
+				Attributes: map[string]schema.Attribute{
+					"disk_label": schema.StringAttribute{
+						Optional: true,
+					},
+					"disk_id": schema.Int64Attribute{
+						Optional: true,
+						Computed: true,
+					},
+					"volume_id": schema.Int64Attribute{
+						Optional: true,
+					},
+				},
+			},
+		}
+	}
+
+	return schema.ListNestedBlock{
+		NestedObject: schema.NestedBlockObject{
+			Blocks: blocks,
+		},
+	}
+}
+
+// frameworkResourceSchemaV0 describes the state written by the SDKv2 implementation
+// of this resource.
+var frameworkResourceSchemaV0 = schema.Schema{
+	Version: 0,
+	Attributes: map[string]schema.Attribute{
+		"id": schema.StringAttribute{
+			Computed: true,
+		},
+		"image": schema.StringAttribute{
+			Optional: true,
+		},
+		"backup_id": schema.Int64Attribute{
+			Optional: true,
+		},
+		"stackscript_id": schema.Int64Attribute{
+			Optional: true,
+		},
+		"stackscript_data": schema.MapAttribute{
+			ElementType: types.StringType,
+			Optional:    true,
+			Sensitive:   true,
+		},
+		"label": schema.StringAttribute{
+			Optional: true,
+			Computed: true,
+		},
+		"group": schema.StringAttribute{
+			Optional: true,
+		},
+		"tags": schema.SetAttribute{
+			ElementType: types.StringType,
+			Optional:    true,
+			Computed:    true,
+		},
+		"capabilities": schema.SetAttribute{
+			ElementType: types.StringType,
+			Computed:    true,
+		},
+		"boot_config_label": schema.StringAttribute{
+			Optional: true,
+			Computed: true,
+		},
+		"region": schema.StringAttribute{
+			Required: true,
+		},
+		"type": schema.StringAttribute{
+			Optional: true,
+		},
+		"resize_disk": schema.BoolAttribute{
+			Optional: true,
+		},
+		"migration_type": schema.StringAttribute{
+			Optional: true,
+		},
+		"status": schema.StringAttribute{
+			Computed: true,
+		},
+		"ip_address": schema.StringAttribute{
+			Computed: true,
+		},
+		"ipv6": schema.StringAttribute{
+			Computed: true,
+		},
+		"ipv4": schema.SetAttribute{
+			ElementType: types.StringType,
+			Optional:    true,
+			Computed:    true,
+		},
+		"private_ip": schema.BoolAttribute{
+			Optional: true,
+		},
+		"private_ip_address": schema.StringAttribute{
+			Computed: true,
+		},
+		"authorized_keys": schema.ListAttribute{
+			ElementType: types.StringType,
+			Optional:    true,
+		},
+		"authorized_users": schema.ListAttribute{
+			ElementType: types.StringType,
+			Optional:    true,
+		},
+		"root_pass": schema.StringAttribute{
+			Optional:  true,
+			Sensitive: true,
+		},
+		"swap_size": schema.Int64Attribute{
+			Optional: true,
+			Computed: true,
+		},
+		"backups_enabled": schema.BoolAttribute{
+			Optional: true,
+			Computed: true,
+		},
+		"watchdog_enabled": schema.BoolAttribute{
+			Optional: true,
+		},
+		"host_uuid": schema.StringAttribute{
+			Computed: true,
+		},
+		"booted": schema.BoolAttribute{
+			Optional: true,
+			Computed: true,
+		},
+		"rescue": schema.BoolAttribute{
+			Optional: true,
+			Computed: true,
+		},
+		"firewall_id": schema.Int64Attribute{
+			Optional: true,
+		},
+		"shared_ipv4": schema.SetAttribute{
+			ElementType: types.StringType,
+			Optional:    true,
+			Computed:    true,
+		},
+		"placement_group_externally_managed": schema.BoolAttribute{
+			Optional: true,
+		},
+		"has_user_data": schema.BoolAttribute{
+			Computed: true,
+		},
+		"disk_encryption": schema.StringAttribute{
+			Optional: true,
+			Computed: true,
+		},
+		"lke_cluster_id": schema.Int64Attribute{
+			Computed: true,
+		},
+		"specs": schema.ListAttribute{
+			ElementType: types.ObjectType{
+				AttrTypes: map[string]attr.Type{
+					"disk":                types.Int64Type,
+					"memory":              types.Int64Type,
+					"vcpus":               types.Int64Type,
+					"transfer":            types.Int64Type,
+					"accelerated_devices": types.Int64Type,
+					"gpus":                types.Int64Type,
+				},
+			},
+			Computed: true,
+		},
+		"backups": schema.ListAttribute{
+			ElementType: types.ObjectType{
+				AttrTypes: map[string]attr.Type{
+					"available": types.BoolType,
+					"enabled":   types.BoolType,
+					"schedule": types.ListType{
+						ElemType: types.ObjectType{
+							AttrTypes: map[string]attr.Type{
+								"day":    types.StringType,
+								"window": types.StringType,
+							},
+						},
+					},
+				},
+			},
+			Computed: true,
+		},
+	},
+	Blocks: map[string]schema.Block{
+		"rescue_device": schema.SetNestedBlock{
+			NestedObject: schema.NestedBlockObject{
+				Attributes: map[string]schema.Attribute{
+					"device_name": schema.StringAttribute{
+						Required: true,
+					},
+					"disk_label": schema.StringAttribute{
+						Optional: true,
+					},
+					"disk_id": schema.Int64Attribute{
+						Optional: true,
+					},
+					"volume_id": schema.Int64Attribute{
+						Optional: true,
+					},
+				},
+			},
+		},
+		"metadata": schema.ListNestedBlock{
+			NestedObject: schema.NestedBlockObject{
+				Attributes: map[string]schema.Attribute{
+					"user_data": schema.StringAttribute{
+						Optional: true,
+					},
+				},
+			},
+		},
+		"placement_group": schema.ListNestedBlock{
+			NestedObject: schema.NestedBlockObject{
+				Attributes: map[string]schema.Attribute{
+					"id": schema.Int64Attribute{
+						Required: true,
+					},
+					"compliant_only": schema.BoolAttribute{
+						Optional: true,
+					},
+					"label": schema.StringAttribute{
+						Computed: true,
+					},
+					"placement_group_type": schema.StringAttribute{
+						Computed: true,
+					},
+					"placement_group_policy": schema.StringAttribute{
+						Optional: true,
+						Computed: true,
+					},
+				},
+			},
+		},
+		"alerts": schema.ListNestedBlock{
+			NestedObject: schema.NestedBlockObject{
+				Attributes: map[string]schema.Attribute{
+					"cpu": schema.Int64Attribute{
+						Optional: true,
+						Computed: true,
+					},
+					"network_in": schema.Int64Attribute{
+						Optional: true,
+						Computed: true,
+					},
+					"network_out": schema.Int64Attribute{
+						Optional: true,
+						Computed: true,
+					},
+					"transfer_quota": schema.Int64Attribute{
+						Optional: true,
+						Computed: true,
+					},
+					"io": schema.Int64Attribute{
+						Optional: true,
+						Computed: true,
+					},
+				},
+			},
+		},
+		"interface": interfaceBlockV0(),
+		"config": schema.ListNestedBlock{
+			NestedObject: schema.NestedBlockObject{
+				Attributes: map[string]schema.Attribute{
+					"id": schema.Int64Attribute{
+						Computed: true,
+					},
+					"label": schema.StringAttribute{
+						Required: true,
+					},
+					"kernel": schema.StringAttribute{
+						Optional: true,
+					},
+					"run_level": schema.StringAttribute{
+						Optional: true,
+					},
+					"virt_mode": schema.StringAttribute{
+						Optional: true,
+					},
+					"root_device": schema.StringAttribute{
+						Optional: true,
+						Computed: true,
+					},
+					"comments": schema.StringAttribute{
+						Optional: true,
+					},
+					"memory_limit": schema.Int64Attribute{
+						Optional: true,
+					},
+				},
+				Blocks: map[string]schema.Block{
+					"helpers": schema.ListNestedBlock{
+						NestedObject: schema.NestedBlockObject{
+							Attributes: map[string]schema.Attribute{
+								"updatedb_disabled": schema.BoolAttribute{
+									Optional: true,
+								},
+								"distro": schema.BoolAttribute{
+									Optional: true,
+								},
+								"modules_dep": schema.BoolAttribute{
+									Optional: true,
+								},
+								"network": schema.BoolAttribute{
+									Optional: true,
+								},
+								"devtmpfs_automount": schema.BoolAttribute{
+									Optional: true,
+								},
+							},
+						},
+					},
+					"devices":   devicesBlockV0(),
+					"interface": interfaceBlockV0(),
+				},
+			},
+		},
+		"disk": schema.ListNestedBlock{
+			NestedObject: schema.NestedBlockObject{
+				Attributes: map[string]schema.Attribute{
+					"label": schema.StringAttribute{
+						Required: true,
+					},
+					"size": schema.Int64Attribute{
+						Required: true,
+					},
+					"id": schema.Int64Attribute{
+						Computed: true,
+					},
+					"filesystem": schema.StringAttribute{
+						Optional: true,
+						Computed: true,
+					},
+					"read_only": schema.BoolAttribute{
+						Optional: true,
+						Computed: true,
+					},
+					"image": schema.StringAttribute{
+						Optional: true,
+						Computed: true,
+					},
+					"authorized_keys": schema.ListAttribute{
+						ElementType: types.StringType,
+						Optional:    true,
+					},
+					"authorized_users": schema.ListAttribute{
+						ElementType: types.StringType,
+						Optional:    true,
+					},
+					"stackscript_id": schema.Int64Attribute{
+						Optional: true,
+						Computed: true,
+					},
+					"stackscript_data": schema.MapAttribute{
+						ElementType: types.StringType,
+						Optional:    true,
+						Computed:    true,
+						Sensitive:   true,
+					},
+					"root_pass": schema.StringAttribute{
+						Optional:  true,
+						Sensitive: true,
+					},
+				},
+			},
+		},
+		"timeouts": timeouts.Block(
+			context.Background(),
+			timeouts.Opts{Create: true, Update: true, Delete: true},
+		),
+	},
+}
@@ -0,0 +1,70 @@
+//go:build unit
+
+package instance
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestLegacyRootPass(t *testing.T) {
+	password := "t0p-s3cret"
+
+	testCases := []struct {
+		name     string
+		state    types.String
+		plan     types.String
+		expected types.String
+	}{
+		{
+			name:     "hashed state matches the plan",
+			state:    types.StringValue(hashString(password)),
+			plan:     types.StringValue(password),
+			expected: types.StringValue(hashString(password)),
+		},
+		{
+			name:     "hashed state doesn't match the plan",
+			state:    types.StringValue(hashString(password)),
+			plan:     types.StringValue("n3w-s3cret"),
+			expected: types.StringValue("n3w-s3cret"),
+		},
+		{
+			name:     "plain state",
+			state:    types.StringValue(password),
+			plan:     types.StringValue(password),
+			expected: types.StringValue(password),
+		},
+		{
+			name:     "new instance",
+			state:    types.StringNull(),
+			plan:     types.StringValue(password),
+			expected: types.StringValue(password),
+		},
+		{
+			name:     "unset root password",
+			state:    types.StringValue(hashString(password)),
+			plan:     types.StringNull(),
+			expected: types.StringNull(),
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			req := planmodifier.StringRequest{
+				StateValue: tc.state,
+				PlanValue:  tc.plan,
+			}
+			resp := &planmodifier.StringResponse{
+				PlanValue: tc.plan,
+			}
+
+			legacyRootPass().PlanModifyString(context.Background(), req, resp)
+
+			assert.Equal(t, tc.expected, resp.PlanValue)
+		})
+	}
+}
@@ -4,16 +4,12 @@ import (
 	"context"
 	"encoding/base64"
 	"fmt"
-	"net"
 	"reflect"
-	"strconv"
-	"strings"
 	"sync"
 	"time"
 
+	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
-	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
-	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/linode/linodego"
 	"github.com/linode/terraform-provider-linode/v2/linode/helper"
 	"golang.org/x/crypto/sha3"
@@ -24,97 +20,41 @@ var (
 	boolTrue  = true
 )
 
-type diskSpec map[string]interface{}
+var downsizeFailedMessage = `
+Did you try to resize a linode with implicit, default disks to a smaller type? The provider does
+not automatically downsize the boot disk to fit an updated instance type. You may need to switch to
+an explicit disk configuration.
+
+Take a look at the example here:
+https://www.terraform.io/docs/providers/linode/r/instance.html#linode-instance-with-explicit-configs-and-disks`
+
+const invalidImplicitDiskConfigMessage = `
+Did you try to resize a Linode's implicit disks with more than two disks? 
+When resize_disk is true, your linode must have a single ext disk as well as an optional swap disk.
+
+You may need to switch to an explicit disk configuration.
+Take a look at the example here:
+https://www.terraform.io/docs/providers/linode/r/instance.html#linode-instance-with-explicit-configs-and-disks`
 
 // getDeadlineSeconds gets the seconds remaining until deadline is met.
-func getDeadlineSeconds(ctx context.Context, d *schema.ResourceData) int {
-	duration := d.Timeout(schema.TimeoutUpdate)
+func getDeadlineSeconds(ctx context.Context) int {
+	duration := LinodeInstanceUpdateTimeout
 	if deadline, ok := ctx.Deadline(); ok {
 		duration = time.Until(deadline)
 	}
 	return int(duration.Seconds())
 }
 
-func setPublicIPAddress(d *schema.ResourceData, ip string) {
-	d.Set("ip_address", ip)
-	d.SetConnInfo(map[string]string{
-		"type": "ssh",
-		"host": ip,
-	})
-}
-
-func createInstanceConfigsFromSet(
+// createInstanceConfigs creates the given configs on an instance and returns them indexed by ID.
+func createInstanceConfigs(
 	ctx context.Context,
 	client linodego.Client,
 	instanceID int,
-	cset []interface{},
-	diskIDLabelMap map[string]int,
+	configs []linodego.InstanceConfigCreateOptions,
 	detacher volumeDetacher,
 ) (map[int]linodego.InstanceConfig, error) {
-	configIDMap := make(map[int]linodego.InstanceConfig, len(cset))
-	for _, v := range cset {
-		config := v.(map[string]interface{})
-
-		configOpts := linodego.InstanceConfigCreateOptions{}
-		configOpts.Kernel = config["kernel"].(string)
-		configOpts.Label = config["label"].(string)
-		configOpts.Comments = config["comments"].(string)
-		configOpts.VirtMode = config["virt_mode"].(string)
-		configOpts.MemoryLimit = config["memory_limit"].(int)
-		configOpts.RunLevel = config["run_level"].(string)
-
-		if helpers, ok := config["helpers"].([]interface{}); ok {
-			for _, helper := range helpers {
-				if helperMap, helperMapOk := helper.(map[string]interface{}); helperMapOk {
-					configOpts.Helpers = &linodego.InstanceConfigHelpers{}
-					if updateDBDisabled, ok := helperMap["updatedb_disabled"]; ok {
-						configOpts.Helpers.UpdateDBDisabled = updateDBDisabled.(bool)
-					}
-					if distro, ok := helperMap["distro"]; ok {
-						configOpts.Helpers.Distro = distro.(bool)
-					}
-					if modulesDep, ok := helperMap["modules_dep"]; ok {
-						configOpts.Helpers.ModulesDep = modulesDep.(bool)
-					}
-					if network, ok := helperMap["network"]; ok {
-						configOpts.Helpers.Network = network.(bool)
-					}
-					if devTmpFsAutomount, ok := helperMap["devtmpfs_automount"]; ok {
-						configOpts.Helpers.DevTmpFsAutomount = devTmpFsAutomount.(bool)
-					}
-				}
-			}
-		}
-
-		if interfaces, ok := config["interface"]; ok {
-			interfaces := interfaces.([]interface{})
-			configOpts.Interfaces = make([]linodego.InstanceConfigInterfaceCreateOptions, len(interfaces))
-
-			for i, ni := range interfaces {
-				configOpts.Interfaces[i] = helper.ExpandConfigInterface(ni.(map[string]interface{}))
-			}
-		}
-
-		rootDevice := config["root_device"].(string)
-		if rootDevice != "" {
-			configOpts.RootDevice = &rootDevice
-		}
-
-		// configOpts.InitRD = config["initrd"].(string)
-		// TODO(displague) need a disk_label to initrd lookup?
-		devices, ok := config["devices"].([]interface{})
-		if !ok {
-			return configIDMap, fmt.Errorf("Error converting config devices")
-		}
-		for _, device := range devices {
-			deviceMap := device.(map[string]interface{})
-			confDevices, err := expandInstanceConfigDeviceMap(deviceMap, diskIDLabelMap)
-			if err != nil {
-				return configIDMap, err
-			}
-			configOpts.Devices = *confDevices
-		}
-
+	configIDMap := make(map[int]linodego.InstanceConfig, len(configs))
+	for _, configOpts := range configs {
 		if err := detachConfigVolumes(ctx, configOpts.Devices, detacher); err != nil {
 			return configIDMap, err
 		}
@@ -135,10 +75,9 @@ func createInstanceConfigsFromSet(
 func updateInstanceConfigs(
 	ctx context.Context,
 	client linodego.Client,
-	d *schema.ResourceData,
 	instance linodego.Instance,
-	tfConfigsOld, tfConfigsNew interface{},
-	diskIDLabelMap map[string]int,
+	oldConfigs []ConfigModel,
+	newConfigs []linodego.InstanceConfigCreateOptions,
 	bootConfigLabel string,
 ) (bool, map[string]int, []*linodego.InstanceConfig, error) {
 	var updatedConfigMap map[string]int
@@ -163,86 +102,59 @@ func updateInstanceConfigs(
 	oldConfigLabels := make([]string, 0)
 
 	var oldBootInterfaces, newBootInterfaces []string
-	for _, tfConfigOld := range tfConfigsOld.([]interface{}) {
-		if oldConfig, ok := tfConfigOld.(map[string]interface{}); ok {
-			oldLabel := oldConfig["label"].(string)
-			oldConfigLabels = append(oldConfigLabels, oldLabel)
-			if oldLabel == bootConfigLabel {
-				for _, iface := range oldConfig["interface"].([]interface{}) {
-					oldBootInterfaces = append(oldBootInterfaces, iface.(map[string]interface{})["ipam_address"].(string))
-				}
+	for _, oldConfig := range oldConfigs {
+		oldLabel := oldConfig.Label.ValueString()
+		oldConfigLabels = append(oldConfigLabels, oldLabel)
+		if oldLabel == bootConfigLabel {
+			for _, iface := range oldConfig.Interface {
+				oldBootInterfaces = append(oldBootInterfaces, iface.IPAMAddress.ValueString())
 			}
 		}
 	}
-	tfConfigs := tfConfigsNew.([]interface{})
-	updatedConfigs = make([]*linodego.InstanceConfig, 0)
-	updatedConfigMap = make(map[string]int, len(tfConfigs))
-	for _, tfConfig := range tfConfigs {
-		tfc, _ := tfConfig.(map[string]interface{})
-		label, _ := tfc["label"].(string)
-		rootDevice, _ := tfc["root_device"].(string)
-		if existingConfig, existing := configMap[label]; existing {
-			configUpdateOpts := existingConfig.GetUpdateOptions()
-			configUpdateOpts.Kernel = tfc["kernel"].(string)
-			configUpdateOpts.RunLevel = tfc["run_level"].(string)
-			configUpdateOpts.VirtMode = tfc["virt_mode"].(string)
-			configUpdateOpts.RootDevice = rootDevice
-			configUpdateOpts.Comments = tfc["comments"].(string)
-			configUpdateOpts.MemoryLimit = tfc["memory_limit"].(int)
-
-			tfcHelpersRaw, helpersFound := tfc["helpers"]
-			if tfcHelpers, ok := tfcHelpersRaw.([]interface{}); helpersFound && ok {
-				helpersMap := tfcHelpers[0].(map[string]interface{})
-				configUpdateOpts.Helpers = &linodego.InstanceConfigHelpers{
-					UpdateDBDisabled:  helpersMap["updatedb_disabled"].(bool),
-					Distro:            helpersMap["distro"].(bool),
-					ModulesDep:        helpersMap["modules_dep"].(bool),
-					Network:           helpersMap["network"].(bool),
-					DevTmpFsAutomount: helpersMap["devtmpfs_automount"].(bool),
-				}
 
+	updatedConfigs = make([]*linodego.InstanceConfig, 0)
+	updatedConfigMap = make(map[string]int, len(newConfigs))
+	for _, newConfig := range newConfigs {
+		if newConfig.Label == bootConfigLabel {
+			for _, iface := range newConfig.Interfaces {
+				newBootInterfaces = append(newBootInterfaces, iface.IPAMAddress)
 			}
+		}
 
-			configUpdateOpts.Interfaces = make([]linodego.InstanceConfigInterfaceCreateOptions, 0)
-
-			if interfaces, ok := tfc["interface"]; ok {
-				interfaces := interfaces.([]interface{})
-
-				configUpdateOpts.Interfaces = make([]linodego.InstanceConfigInterfaceCreateOptions, len(interfaces))
+		if existingConfig, existing := configMap[newConfig.Label]; existing {
+			configUpdateOpts := existingConfig.GetUpdateOptions()
+			configUpdateOpts.Kernel = newConfig.Kernel
+			configUpdateOpts.RunLevel = newConfig.RunLevel
+			configUpdateOpts.VirtMode = newConfig.VirtMode
+			configUpdateOpts.Comments = newConfig.Comments
+			configUpdateOpts.MemoryLimit = newConfig.MemoryLimit
+			configUpdateOpts.RootDevice = ""
+			if newConfig.RootDevice != nil {
+				configUpdateOpts.RootDevice = *newConfig.RootDevice
+			}
 
-				for i, ni := range interfaces {
-					mappedInterface := ni.(map[string]interface{})
-					configUpdateOpts.Interfaces[i] = helper.ExpandConfigInterface(mappedInterface)
-					if label == bootConfigLabel {
-						newBootInterfaces = append(newBootInterfaces, mappedInterface["ipam_address"].(string))
-					}
-				}
+			if newConfig.Helpers != nil {
+				configUpdateOpts.Helpers = newConfig.Helpers
+			}
 
+			configUpdateOpts.Interfaces = newConfig.Interfaces
+			if configUpdateOpts.Interfaces == nil {
+				configUpdateOpts.Interfaces = make([]linodego.InstanceConfigInterfaceCreateOptions, 0)
 			}
+			resolveInterfaceIPv4(configUpdateOpts.Interfaces, existingConfig.Interfaces)
 
 			if reflect.DeepEqual(configUpdateOpts.Interfaces, existingConfig.GetUpdateOptions().Interfaces) {
 				configUpdateOpts.Interfaces = nil
 			}
 
-			tfcDevicesRaw, devicesFound := tfc["devices"]
-			if tfcDevices, ok := tfcDevicesRaw.([]interface{}); devicesFound && ok {
-				devices := tfcDevices[0].(map[string]interface{})
-
-				configUpdateOpts.Devices, err = expandInstanceConfigDeviceMap(devices, diskIDLabelMap)
-				if err != nil {
-					return rebootInstance, updatedConfigMap, updatedConfigs, err
-				}
-				if configUpdateOpts.Devices != nil && emptyConfigDeviceMap(*configUpdateOpts.Devices) {
-					configUpdateOpts.Devices = nil
-				}
-			} else {
-				configUpdateOpts.Devices = nil
-			}
+			configUpdateOpts.Devices = nil
+			if !emptyConfigDeviceMap(newConfig.Devices) {
+				devices := newConfig.Devices
+				configUpdateOpts.Devices = &devices
 
-			if configUpdateOpts.Devices != nil {
-				detacher := makeVolumeDetacherIgnoreAttached(client, d)
+				detacher := makeVolumeDetacherIgnoreAttached(client, instance.ID)
 
-				if detachErr := detachConfigVolumes(ctx, *configUpdateOpts.Devices, detacher); detachErr != nil {
+				if detachErr := detachConfigVolumes(ctx, devices, detacher); detachErr != nil {
 					return rebootInstance, updatedConfigMap, updatedConfigs, detachErr
 				}
 			}
@@ -259,10 +171,10 @@ func updateInstanceConfigs(
 
 			updatedConfigMap[updatedConfig.Label] = updatedConfig.ID
 		} else {
-			detacher := makeVolumeDetacher(client, d)
+			detacher := makeVolumeDetacher(client)
 
-			configIDMap, err := createInstanceConfigsFromSet(
-				ctx, client, instance.ID, []interface{}{tfc}, diskIDLabelMap, detacher)
+			configIDMap, err := createInstanceConfigs(
+				ctx, client, instance.ID, []linodego.InstanceConfigCreateOptions{newConfig}, detacher)
 			if err != nil {
 				return rebootInstance, updatedConfigMap, updatedConfigs, err
 			}
@@ -366,7 +278,7 @@ func emptyConfigDeviceMap(dmap linodego.InstanceConfigDeviceMap) bool {
 
 type volumeDetacher func(context.Context, int, string) error
 
-func makeVolumeDetacher(client linodego.Client, d *schema.ResourceData) volumeDetacher {
+func makeVolumeDetacher(client linodego.Client) volumeDetacher {
 	return func(ctx context.Context, volumeID int, reason string) error {
 		ctx = helper.SetLogFieldBulk(ctx, map[string]any{
 			"volume_id": volumeID,
@@ -383,7 +295,7 @@ func makeVolumeDetacher(client linodego.Client, d *schema.ResourceData) volumeDe
 		tflog.Debug(ctx, "client.WaitForVolumeLinodeID(...)", map[string]any{
 			"linode_id": nil,
 		})
-		if _, err := client.WaitForVolumeLinodeID(ctx, volumeID, nil, getDeadlineSeconds(ctx, d)); err != nil {
+		if _, err := client.WaitForVolumeLinodeID(ctx, volumeID, nil, getDeadlineSeconds(ctx)); err != nil {
 			return err
 		}
 
@@ -393,29 +305,24 @@ func makeVolumeDetacher(client linodego.Client, d *schema.ResourceData) volumeDe
 	}
 }
 
-func makeVolumeDetacherIgnoreAttached(client linodego.Client, d *schema.ResourceData) volumeDetacher {
+func makeVolumeDetacherIgnoreAttached(client linodego.Client, instanceID int) volumeDetacher {
 	return func(ctx context.Context, volumeID int, reason string) error {
 		ctx = helper.SetLogFieldBulk(ctx, map[string]any{
 			"volume_id": volumeID,
 			"reason":    reason,
 		})
 
-		id, err := strconv.Atoi(d.Id())
-		if err != nil {
-			return err
-		}
-
 		vol, err := client.GetVolume(ctx, volumeID)
 		if err != nil {
 			return err
 		}
 
-		if vol.LinodeID != nil && *vol.LinodeID == id {
+		if vol.LinodeID != nil && *vol.LinodeID == instanceID {
 			tflog.Info(ctx, "Volume is already attached to Linode, ignoring ...")
 			return nil
 		}
 
-		return makeVolumeDetacher(client, d)(ctx, volumeID, reason)
+		return makeVolumeDetacher(client)(ctx, volumeID, reason)
 	}
 }
 
@@ -423,68 +330,10 @@ func createInstanceDisk(
 	ctx context.Context,
 	client linodego.Client,
 	instance linodego.Instance,
-	disk diskSpec,
-	d *schema.ResourceData,
+	diskOpts linodego.InstanceDiskCreateOptions,
 ) (*linodego.InstanceDisk, error) {
-	diskOpts := linodego.InstanceDiskCreateOptions{
-		Label:      disk["label"].(string),
-		Filesystem: disk["filesystem"].(string),
-		Size:       disk["size"].(int),
-	}
-
-	if image, ok := disk["image"]; ok {
-		diskOpts.Image = image.(string)
-
-		if rootPass, ok := disk["root_pass"]; ok && rootPass != "" {
-			diskOpts.RootPass = rootPass.(string)
-		} else {
-			var err error
-			diskOpts.RootPass, err = helper.CreateRandomRootPassword()
-			if err != nil {
-				return nil, err
-			}
-		}
-
-		if authorizedKeys, ok := disk["authorized_keys"]; ok {
-			for _, sshKey := range authorizedKeys.([]interface{}) {
-				if sshKey == nil {
-					return nil, fmt.Errorf(
-						"invalid input for disk authorized_keys: keys cannot be empty or null")
-				}
-
-				diskOpts.AuthorizedKeys = append(diskOpts.AuthorizedKeys, sshKey.(string))
-			}
-		}
-
-		if authorizedUsers, ok := disk["authorized_users"]; ok {
-			for _, user := range authorizedUsers.([]interface{}) {
-				if user == nil {
-					return nil, fmt.Errorf(
-						"invalid input for disk authorized_users: users cannot be empty or null")
-				}
-
-				diskOpts.AuthorizedUsers = append(diskOpts.AuthorizedUsers, user.(string))
-			}
-		}
-
-		if stackscriptID, ok := disk["stackscript_id"]; ok {
-			diskOpts.StackscriptID = stackscriptID.(int)
-		}
-
-		if stackscriptDataRaw, ok := disk["stackscript_data"]; ok {
-			stackscriptData, ok := stackscriptDataRaw.(map[string]interface{})
-			if !ok {
-				return nil, fmt.Errorf("Error parsing stackscript_data: expected map[string]interface{}")
-			}
-			diskOpts.StackscriptData = make(map[string]string, len(stackscriptData))
-			for name, value := range stackscriptData {
-				diskOpts.StackscriptData[name] = value.(string)
-			}
-		}
-	}
-
 	tflog.Info(ctx, "Creating new instance disk", map[string]any{
-		"options": diskOpts,
+		"label": diskOpts.Label,
 	})
 
 	p, err := client.NewEventPoller(ctx, instance.ID, linodego.EntityLinode, linodego.ActionDiskCreate)
@@ -504,7 +353,7 @@ func createInstanceDisk(
 	ctx = tflog.SetField(ctx, "disk_id", instanceDisk.ID)
 
 	tflog.Debug(ctx, "Waiting for disk creation to complete")
-	_, err = p.WaitForFinished(ctx, getDeadlineSeconds(ctx, d))
+	_, err = p.WaitForFinished(ctx, getDeadlineSeconds(ctx))
 	if err != nil {
 		return nil, fmt.Errorf("Error waiting for Linode instance %d disk: %s", instanceDisk.ID, err)
 	}
@@ -536,17 +385,15 @@ func getInstanceDisks(
 
 // getInstanceDiskLabelIDMap returns a map of an instances disk labels to their corresponding IDs.
 func getInstanceDiskLabelIDMap(
-	ctx context.Context, client linodego.Client, d *schema.ResourceData, instanceID int,
+	ctx context.Context, client linodego.Client, labels []string, instanceID int,
 ) (map[string]int, error) {
-	diskSpecs := d.Get("disk").([]interface{})
 	disks, err := getInstanceDisks(ctx, client, instanceID)
 	if err != nil {
 		return nil, err
 	}
 
 	labelIDMap := make(map[string]int)
-	for _, spec := range diskSpecs {
-		label := spec.(map[string]interface{})["label"].(string)
+	for _, label := range labels {
 		disk, ok := disks[label]
 		if !ok {
 			return nil, fmt.Errorf(`could not map disk label "%s" to an ID; not found`, label)
@@ -556,47 +403,32 @@ func getInstanceDiskLabelIDMap(
 	return labelIDMap, nil
 }
 
-// getInstanceDiskSpecChange returns a map of disk specs indexed by label.
-func getInstanceDiskSpecChange(d *schema.ResourceData) (oldDiskSpecs, newDiskSpecs map[string]diskSpec) {
-	old, new := d.GetChange("disk")
-	oldDisk := old.([]interface{})
-	newDisk := new.([]interface{})
-	oldDiskSpecs = make(map[string]diskSpec)
-	newDiskSpecs = make(map[string]diskSpec)
-
-	for _, spec := range oldDisk {
-		spec := spec.(map[string]interface{})
-		oldDiskSpecs[spec["label"].(string)] = spec
-	}
-	for _, spec := range newDisk {
-		spec := spec.(map[string]interface{})
-		newDiskSpecs[spec["label"].(string)] = spec
-	}
-	return
-}
-
 // getInstanceDiskSpecDiffs sorts the disk specs by added, removed, and existing.
 func getInstanceDiskSpecDiffs(
-	oldDiskSpecs, newDiskSpecs map[string]diskSpec,
-) (added, removed, existing map[string]diskSpec) {
-	added = make(map[string]diskSpec)
-	removed = make(map[string]diskSpec)
-	existing = make(map[string]diskSpec)
+	oldDisks []DiskModel, newDisks []linodego.InstanceDiskCreateOptions,
+) (added, existing map[string]linodego.InstanceDiskCreateOptions, removed map[string]struct{}) {
+	added = make(map[string]linodego.InstanceDiskCreateOptions)
+	existing = make(map[string]linodego.InstanceDiskCreateOptions)
+	removed = make(map[string]struct{})
+
+	oldLabels := make(map[string]struct{}, len(oldDisks))
+	for _, disk := range oldDisks {
+		oldLabels[disk.Label.ValueString()] = struct{}{}
+	}
 
 	placed := make(map[string]struct{})
-	for label, spec := range newDiskSpecs {
-		_, exists := oldDiskSpecs[label]
-		if exists {
-			existing[label] = spec
+	for _, spec := range newDisks {
+		if _, exists := oldLabels[spec.Label]; exists {
+			existing[spec.Label] = spec
 		} else {
-			added[label] = spec
+			added[spec.Label] = spec
 		}
-		placed[label] = struct{}{}
+		placed[spec.Label] = struct{}{}
 	}
 
-	for label, spec := range oldDiskSpecs {
+	for label := range oldLabels {
 		if _, ok := placed[label]; !ok {
-			removed[label] = spec
+			removed[label] = struct{}{}
 		}
 	}
 	return
@@ -608,18 +440,31 @@ func getInstanceDiskSpecDiffs(
 // This function will also warn when there are disks attached to an instance which are not managed by
 // terraform.
 func updateInstanceDisks(
-	ctx context.Context, client linodego.Client, d *schema.ResourceData, instance linodego.Instance,
+	ctx context.Context,
+	client linodego.Client,
+	instance linodego.Instance,
+	oldDisks []DiskModel,
+	newDisks []linodego.InstanceDiskCreateOptions,
 ) (bool, error) {
-	oldDisk, newDisk := getInstanceDiskSpecChange(d)
-	added, removed, existing := getInstanceDiskSpecDiffs(oldDisk, newDisk)
+	added, existing, removed := getInstanceDiskSpecDiffs(oldDisks, newDisks)
 	disks, err := getInstanceDisks(ctx, client, instance.ID)
 	if err != nil {
 		return false, err
 	}
+
+	// Disks that aren't tracked yet, e.g. after an import, are matched by their label
+	for label, spec := range added {
+		if _, ok := disks[label]; ok {
+			existing[label] = spec
+			delete(added, label)
+		}
+	}
+
 	hasChanges := len(added)+len(removed) > 0
 
 	// ensure all old disks which have not been removed are present on the instance
-	for label := range oldDisk {
+	for _, oldDisk := range oldDisks {
+		label := oldDisk.Label.ValueString()
 		_, wasRemoved := removed[label]
 		if _, ok := disks[label]; !ok && !wasRemoved {
 			return hasChanges, fmt.Errorf(`disk "%s" was not found on instance %d`, label, instance.ID)
@@ -652,7 +497,7 @@ func updateInstanceDisks(
 
 		tflog.Debug(ctx, "Waiting for unused disk to be deleted")
 
-		_, err = p.WaitForFinished(ctx, getDeadlineSeconds(ctx, d))
+		_, err = p.WaitForFinished(ctx, getDeadlineSeconds(ctx))
 		if err != nil {
 			return hasChanges, fmt.Errorf(
 				"error waiting for Instance %d Disk %d to finish deleting: %s", instance.ID, disk.ID, err)
@@ -668,13 +513,13 @@ func updateInstanceDisks(
 		existingDisk := disks[label]
 		// The only non-destructive change supported is resize.
 		// Label renames are not supported because this TF provider relies on the label as an identifier.
-		if spec["size"].(int) != existingDisk.Size {
-			if err := changeInstanceDiskSize(ctx, &client, instance, existingDisk, spec["size"].(int), d); err != nil {
+		if spec.Size != existingDisk.Size {
+			if err := changeInstanceDiskSize(ctx, &client, instance, existingDisk, spec.Size); err != nil {
 				return hasChanges, err
 			}
 			hasChanges = true
 		}
-		if spec["filesystem"].(string) != string(existingDisk.Filesystem) {
+		if spec.Filesystem != "" && spec.Filesystem != string(existingDisk.Filesystem) {
 			return hasChanges, fmt.Errorf("failed to update disk %d; filesystems can not be changed", existingDisk.ID)
 		}
 		visited[label] = struct{}{}
@@ -682,7 +527,7 @@ func updateInstanceDisks(
 
 	// create disks staged for creation
 	for _, spec := range added {
-		if _, err := createInstanceDisk(ctx, client, instance, spec, d); err != nil {
+		if _, err := createInstanceDisk(ctx, client, instance, spec); err != nil {
 			return hasChanges, err
 		}
 	}
@@ -698,16 +543,6 @@ func updateInstanceDisks(
 	return hasChanges, nil
 }
 
-// sshKeyState hashes a string passed in as an interface.
-func sshKeyState(val interface{}) string {
-	return hashString(strings.Join(val.([]string), "\n"))
-}
-
-// rootPasswordState hashes a string passed in as an interface.
-func rootPasswordState(val interface{}) string {
-	return hashString(val.(string))
-}
-
 // hashString hashes a string.
 func hashString(key string) string {
 	hash := sha3.Sum512([]byte(key))
@@ -752,14 +587,13 @@ func changeInstanceType(
 	targetType string,
 	migrationType linodego.InstanceMigrationType,
 	diskResize bool,
-	d *schema.ResourceData,
 ) (*linodego.Instance, error) {
 	var err error
 	var instance *linodego.Instance
 
 	if migrationType == linodego.ColdMigration {
 		// Cold migration: Ensure instance is offline
-		instance, err = ensureInstanceOffline(ctx, client, instanceID, getDeadlineSeconds(ctx, d))
+		instance, err = ensureInstanceOffline(ctx, client, instanceID, getDeadlineSeconds(ctx))
 		if err != nil {
 			return nil, fmt.Errorf("failed to shut down instance for cold migration: %w", err)
 		}
@@ -775,7 +609,7 @@ func changeInstanceType(
 
 	// We only need to make this request if we plan on resizing the disk
 	if diskResize {
-		primaryDisk, err = getPrimaryImplicitDisk(ctx, d, client, instanceID)
+		primaryDisk, err = getPrimaryImplicitDisk(ctx, client, instanceID)
 		if err != nil {
 			return nil, err
 		}
@@ -802,7 +636,7 @@ func changeInstanceType(
 		return nil, fmt.Errorf("Error resizing Instance %d: %s", instance.ID, err)
 	}
 
-	_, err = p.WaitForFinished(ctx, getDeadlineSeconds(ctx, d))
+	_, err = p.WaitForFinished(ctx, getDeadlineSeconds(ctx))
 	if err != nil {
 		return nil, fmt.Errorf("Error waiting for instance %d to finish resizing: %s", instance.ID, err)
 	}
@@ -811,7 +645,7 @@ func changeInstanceType(
 
 	// Wait for instance status to go back to idle, offline state
 	if instance, err = client.WaitForInstanceStatus(
-		ctx, instance.ID, linodego.InstanceOffline, getDeadlineSeconds(ctx, d),
+		ctx, instance.ID, linodego.InstanceOffline, getDeadlineSeconds(ctx),
 	); err != nil {
 		return nil, fmt.Errorf("Error waiting for Instance %d to enter offline state: %s", instance.ID, err)
 	}
@@ -819,7 +653,7 @@ func changeInstanceType(
 	// Sometimes the API falls behind on updating the disk info, let's make sure it has updated
 	if diskResize && primaryDisk != nil {
 		if _, err := waitForInstanceDiskSizeChange(ctx, client, instance.ID, primaryDisk.ID, primaryDisk.Size,
-			getDeadlineSeconds(ctx, d)); err != nil {
+			getDeadlineSeconds(ctx)); err != nil {
 			return nil, fmt.Errorf("failed to wait for disk resize: %s", err)
 		}
 	}
@@ -827,22 +661,13 @@ func changeInstanceType(
 	return instance, nil
 }
 
-// returns the amount of disk space used by the new plan and old plan.
-func getDiskSizeChange(oldDisk interface{}, newDisk interface{}) (int, int) {
-	tfDisksOldInterface := oldDisk.([]interface{})
-	tfDisksNewInterface := newDisk.([]interface{})
-
-	oldDiskSize := 0
-	newDiskSize := 0
-	// Get total amount of disk usage before and after
-	for _, disk := range tfDisksOldInterface {
-		oldDiskSize += disk.(map[string]interface{})["size"].(int)
-	}
-
-	for _, disk := range tfDisksNewInterface {
-		newDiskSize += disk.(map[string]interface{})["size"].(int)
+// sumDiskSizes returns the amount of disk space used by the given disks.
+func sumDiskSizes(disks []linodego.InstanceDiskCreateOptions) int {
+	size := 0
+	for _, disk := range disks {
+		size += disk.Size
 	}
-	return oldDiskSize, newDiskSize
+	return size
 }
 
 func changeInstanceDiskSize(
@@ -851,7 +676,6 @@ func changeInstanceDiskSize(
 	instance linodego.Instance,
 	disk linodego.InstanceDisk,
 	targetSize int,
-	d *schema.ResourceData,
 ) error {
 	if instance.Specs.Disk < targetSize {
 		return fmt.Errorf("Error resizing disk %d: size exceeds disk size for Instance %d", disk.ID, instance.ID)
@@ -864,7 +688,7 @@ func changeInstanceDiskSize(
 		tflog.Debug(ctx, "Waiting for instance to reach offline status for disk resize")
 
 		if _, err := client.WaitForInstanceStatus(
-			ctx, instance.ID, linodego.InstanceOffline, getDeadlineSeconds(ctx, d),
+			ctx, instance.ID, linodego.InstanceOffline, getDeadlineSeconds(ctx),
 		); err != nil {
 			return fmt.Errorf("Error waiting for Instance %d to go offline: %s", instance.ID, err)
 		}
@@ -879,7 +703,7 @@ func changeInstanceDiskSize(
 
 	// Wait for instance to go offline. Resize the disk once Linode is shut down.
 	if _, err := client.WaitForInstanceStatus(
-		ctx, instance.ID, linodego.InstanceOffline, getDeadlineSeconds(ctx, d),
+		ctx, instance.ID, linodego.InstanceOffline, getDeadlineSeconds(ctx),
 	); err != nil {
 		return fmt.Errorf("Error waiting for Instance %d to go offline: %s", instance.ID, err)
 	}
@@ -902,14 +726,14 @@ func changeInstanceDiskSize(
 	tflog.Debug(ctx, "Waiting for disk resize operation to complete")
 
 	// Wait for the disk resize operation to complete.
-	_, err = p.WaitForFinished(ctx, getDeadlineSeconds(ctx, d))
+	_, err = p.WaitForFinished(ctx, getDeadlineSeconds(ctx))
 	if err != nil {
 		return fmt.Errorf("Error waiting for resize of Instance %d Disk %d: %s", instance.ID, disk.ID, err)
 	}
 
 	// Check to see if the resize operation worked
 	if updatedDisk, err := client.WaitForInstanceDiskStatus(ctx, instance.ID, disk.ID, linodego.DiskReady,
-		getDeadlineSeconds(ctx, d)); err != nil {
+		getDeadlineSeconds(ctx)); err != nil {
 		return fmt.Errorf("Error waiting disk %d on instance %d to be ready: %s", disk.ID, instance.ID, err)
 	} else if updatedDisk.Size != targetSize {
 		return fmt.Errorf(
@@ -921,23 +745,15 @@ func changeInstanceDiskSize(
 	return nil
 }
 
-// privateIP determines if an IP is for private use (RFC1918)
-// https://stackoverflow.com/a/41273687
-func privateIP(ip net.IP) bool {
-	_, private24BitBlock, _ := net.ParseCIDR("10.0.0.0/8")
-	_, private20BitBlock, _ := net.ParseCIDR("172.16.0.0/12")
-	_, private16BitBlock, _ := net.ParseCIDR("192.168.0.0/16")
-	private := private24BitBlock.Contains(ip) || private20BitBlock.Contains(ip) || private16BitBlock.Contains(ip)
-	return private
-}
-
 func assignConfigDevice(
-	device *linodego.InstanceConfigDevice, dev map[string]interface{}, diskIDLabelMap map[string]int,
+	device *linodego.InstanceConfigDevice, dev ConfigDeviceModel, diskIDLabelMap map[string]int,
 ) error {
-	if label, ok := dev["disk_label"].(string); ok && len(label) > 0 {
-		if dev["disk_id"], ok = diskIDLabelMap[label]; !ok {
-			return fmt.Errorf(`Error mapping disk label "%s" to ID`, dev["disk_label"])
+	if label := dev.DiskLabel.ValueString(); label != "" {
+		diskID, ok := diskIDLabelMap[label]
+		if !ok {
+			return fmt.Errorf(`Error mapping disk label "%s" to ID`, label)
 		}
+		dev.DiskID = types.Int64Value(int64(diskID))
 	}
 	expanded := expandInstanceConfigDevice(dev)
 	if expanded != nil {
@@ -965,12 +781,9 @@ func getInstanceDefaultDisks(
 // getInstanceTypeChange checks to see if the linode itself was resized.
 func getInstanceTypeChange(
 	ctx context.Context,
-	d *schema.ResourceData,
 	client *linodego.Client,
+	oldType, newType string,
 ) (oldSpec, newSpec *linodego.LinodeType, err error) {
-	old, new := d.GetChange("type")
-	oldType, newType := old.(string), new.(string)
-
 	oldSpec, err = client.GetType(ctx, oldType)
 	if err != nil {
 		return
@@ -988,30 +801,77 @@ func getInstanceTypeChange(
 // returns bool describing whether change has occurred.
 func applyInstanceDiskSpec(
 	ctx context.Context,
-	d *schema.ResourceData,
 	client *linodego.Client,
 	instance *linodego.Instance,
 	typ *linodego.LinodeType,
+	oldDisks []DiskModel,
+	newDisks []linodego.InstanceDiskCreateOptions,
 ) (bool, error) {
-	if err := assertDiskConfigFitsInstanceType(d, typ); err != nil {
+	if err := assertDiskSizeFitsInstanceType(sumDiskSizes(newDisks), typ); err != nil {
 		return false, err
 	}
 
-	return updateInstanceDisks(ctx, *client, d, *instance)
+	return updateInstanceDisks(ctx, *client, *instance, oldDisks, newDisks)
 }
 
-// assertDiskConfigFitsInstanceType asserts that the cumulative disk space used by a given disk config fits a given
+// assertDiskSizeFitsInstanceType asserts that the given cumulative disk size fits a given
 // linode type spec for disk capacity.
-func assertDiskConfigFitsInstanceType(d *schema.ResourceData, typ *linodego.LinodeType) error {
-	oldDisks, newDisks := d.GetChange("disk")
+func assertDiskSizeFitsInstanceType(diskSize int, typ *linodego.LinodeType) error {
+	if typ.Disk < diskSize {
+		return fmt.Errorf(
+			"linode type %s has insufficient disk capacity for the config. Have %d; want %d",
+			typ.Label, typ.Disk, diskSize)
+	}
+	return nil
+}
 
-	_, newDiskSize := getDiskSizeChange(oldDisks, newDisks)
+// validateImplicitDiskLayoutForType validates that the implicit disks of an instance
+// fit the given linode type, or can be resized to it if resizeDisk is set.
+func validateImplicitDiskLayoutForType(
+	disks []linodego.InstanceDisk, typ *linodego.LinodeType, resizeDisk bool,
+) error {
+	usedSpace := 0
+	for _, disk := range disks {
+		usedSpace += disk.Size
+	}
 
-	if typ.Disk < newDiskSize {
+	if resizeDisk {
+		if err := validateImplicitDiskLayout(disks); err != nil {
+			return fmt.Errorf("resize_disk is incompatible with the current disk layout: %s", err)
+		}
+
+		if typ.Disk < usedSpace {
+			return fmt.Errorf("linode type %s can't fit the existing disks: %s", typ.Label, downsizeFailedMessage)
+		}
+
+		return nil
+	}
+
+	if typ.Disk < usedSpace {
 		return fmt.Errorf(
-			"linode type %s has insufficient disk capacity for the config. Have %d; want %d",
-			typ.Label, typ.Disk, newDiskSize)
+			"linode type %s has insufficient disk capacity for the existing disks. Have %d; want %d.%s",
+			typ.Label, typ.Disk, usedSpace, downsizeFailedMessage)
 	}
+
+	return nil
+}
+
+// validateSwapSizeChange validates that the boot disk of an instance with
+// implicit disks can make room for the new swap size.
+func validateSwapSizeChange(disks []linodego.InstanceDisk, oldSwap, newSwap int) error {
+	bootDisk := findDiskByFS(disks, linodego.FilesystemExt4)
+	swapDisk := findDiskByFS(disks, linodego.FilesystemSwap)
+
+	if bootDisk == nil || swapDisk == nil {
+		return fmt.Errorf("swap_size can only be changed on an instance with an ext4 boot disk and a swap disk")
+	}
+
+	if bootDisk.Size-(newSwap-oldSwap) <= 0 {
+		return fmt.Errorf(
+			"swap_size %d leaves no space for the boot disk %q of size %d",
+			newSwap, bootDisk.Label, bootDisk.Size)
+	}
+
 	return nil
 }
 
@@ -1019,19 +879,16 @@ func assertDiskConfigFitsInstanceType(d *schema.ResourceData, typ *linodego.Lino
 // specification. If there is sufficient space, it attempts to update the instance type.
 func applyInstanceTypeChange(
 	ctx context.Context,
-	d *schema.ResourceData,
 	client *linodego.Client,
 	instance *linodego.Instance,
 	typ *linodego.LinodeType,
+	resizeDisk bool,
+	migrationType linodego.InstanceMigrationType,
+	explicitDisks []linodego.InstanceDiskCreateOptions,
 ) (*linodego.Instance, error) {
-	resizeDisk := d.Get("resize_disk").(bool)
-	migrationType := linodego.InstanceMigrationType(
-		d.Get("migration_type").(string),
-	)
-
 	if resizeDisk {
 		// Verify that there are implicit disks defined
-		if d.GetRawConfig().GetAttr("image").IsNull() && d.GetRawConfig().GetAttr("disk").LengthInt() > 0 {
+		if len(explicitDisks) > 0 {
 			return nil, fmt.Errorf("resize_disk requires that no explicit disks are defined")
 		}
 
@@ -1039,7 +896,7 @@ func applyInstanceTypeChange(
 			return nil, err
 		}
 
-		usedSpace, err := getDiskSizeSum(ctx, d, client, instance.ID)
+		usedSpace, err := getDiskSizeSum(ctx, client, instance.ID)
 		if err != nil {
 			return nil, fmt.Errorf("failed to calculate total disk size: %s", err)
 		}
@@ -1049,24 +906,21 @@ func applyInstanceTypeChange(
 		}
 	}
 
-	if err := assertDiskConfigFitsInstanceType(d, typ); err != nil {
+	if err := assertDiskSizeFitsInstanceType(sumDiskSizes(explicitDisks), typ); err != nil {
 		return nil, err
 	}
 
-	return changeInstanceType(ctx, client, instance.ID, typ.ID, migrationType, resizeDisk, d)
+	return changeInstanceType(ctx, client, instance.ID, typ.ID, migrationType, resizeDisk)
 }
 
 // applyInstanceMigration synchronously migrates a Linode to a new region.
 func applyInstanceMigration(
 	ctx context.Context,
-	d *schema.ResourceData,
 	client *linodego.Client,
 	instance *linodego.Instance,
 	targetRegion string,
+	migrationType linodego.InstanceMigrationType,
 ) (*linodego.Instance, error) {
-	migrationType := linodego.InstanceMigrationType(
-		d.Get("migration_type").(string),
-	)
 
 	ctx = helper.SetLogFieldBulk(ctx, map[string]any{
 		"target_region":  targetRegion,
@@ -1075,33 +929,17 @@ func applyInstanceMigration(
 
 	tflog.Debug(ctx, "Migrating instance to new region")
 
-	p, err := client.NewEventPoller(ctx, instance.ID, linodego.EntityLinode, linodego.ActionLinodeMigrateDatacenter)
-	if err != nil {
-		return nil, fmt.Errorf("failed to initialize event poller %d: %s", instance.ID, err)
-	}
-
 	migrateOpts := linodego.InstanceMigrateOptions{
 		Region: targetRegion,
 		Type:   migrationType,
 	}
 
-	tflog.Debug(ctx, "client.MigrateInstance(...)", map[string]any{
-		"options": migrateOpts,
-	})
-
-	if err := client.MigrateInstance(ctx, instance.ID, migrateOpts); err != nil {
+	if err := helper.MigrateInstanceSync(
+		ctx, client, instance.ID, migrateOpts, getDeadlineSeconds(ctx),
+	); err != nil {
 		return nil, fmt.Errorf("failed to migrate instance %d to region %s: %w", instance.ID, targetRegion, err)
 	}
 
-	tflog.Debug(ctx, "Waiting for migration to finish")
-
-	_, err = p.WaitForFinished(ctx, getDeadlineSeconds(ctx, d))
-	if err != nil {
-		return nil, fmt.Errorf("failed to wait for instance %d to finish migration: %w", instance.ID, err)
-	}
-
-	tflog.Debug(ctx, "Instance migration has finished")
-
 	result, err := client.GetInstance(ctx, instance.ID)
 	if err != nil {
 		return nil, fmt.Errorf("failed to refresh instance %d: %w", instance.ID, err)
@@ -1171,49 +1009,29 @@ func isInstanceBooted(instance *linodego.Instance) bool {
 		instance.Status == linodego.InstanceBooting
 }
 
-func validateBooted(ctx context.Context, d *schema.ResourceData) error {
-	booted := d.Get("booted").(bool)
-	bootedNull := d.GetRawConfig().GetAttr("booted").IsNull()
-
-	_, imageOk := d.GetOk("image")
-	_, disksOk := d.GetOk("disk")
-	_, configsOk := d.GetOk("config")
-
-	if !bootedNull && booted && !imageOk && !(disksOk && configsOk) {
-		return fmt.Errorf("booted requires an image or disk/config be defined")
-	}
-
-	return nil
-}
-
 func handleBootedUpdate(
-	ctx context.Context, d *schema.ResourceData, meta interface{}, instanceID, configID int,
+	ctx context.Context, client *linodego.Client, booted types.Bool, instanceID, configID int,
 ) error {
-	client := meta.(*helper.ProviderMeta).Client
-
-	deadlineSeconds := getDeadlineSeconds(ctx, d)
-
-	booted := d.Get("booted")
-	bootedNull := d.GetRawConfig().GetAttr("booted").IsNull()
+	deadlineSeconds := getDeadlineSeconds(ctx)
 
-	if bootedNull {
+	if booted.IsNull() || booted.IsUnknown() {
 		return nil
 	}
 
-	instStatus, err := helper.WaitForInstanceNonTransientStatus(ctx, &client, instanceID, 120)
+	instStatus, err := helper.WaitForInstanceNonTransientStatus(ctx, client, instanceID, 120)
 	if err != nil {
 		return err
 	}
 
 	// Boot or shutdown the instance if necessary
-	if instStatus != linodego.InstanceRunning && booted.(bool) {
-		if err := helper.BootInstanceSync(ctx, &client, instanceID, configID, deadlineSeconds); err != nil {
+	if instStatus != linodego.InstanceRunning && booted.ValueBool() {
+		if err := helper.BootInstanceSync(ctx, client, instanceID, configID, deadlineSeconds); err != nil {
 			return err
 		}
 	}
 
-	if instStatus != linodego.InstanceOffline && !booted.(bool) {
-		if err := helper.ShutDownInstanceSync(ctx, &client, instanceID, deadlineSeconds); err != nil {
+	if instStatus != linodego.InstanceOffline && !booted.ValueBool() {
+		if err := helper.ShutDownInstanceSync(ctx, client, instanceID, deadlineSeconds); err != nil {
 			return err
 		}
 	}
@@ -1221,7 +1039,100 @@ func handleBootedUpdate(
 	return nil
 }
 
-func getDiskSizeSum(ctx context.Context, d *schema.ResourceData,
+// handleRescueUpdate boots the instance into or out of rescue mode according to the
+// configured rescue value. It returns true if the boot status has been handled
+// and the booted value should not be applied.
+func handleRescueUpdate(
+	ctx context.Context,
+	client *linodego.Client,
+	rescue, booted types.Bool,
+	rescueDevices []RescueDeviceModel,
+	rescueDevicesChanged bool,
+	instanceID, configID int,
+) (bool, error) {
+	deadlineSeconds := getDeadlineSeconds(ctx)
+
+	if rescue.IsNull() || rescue.IsUnknown() {
+		return false, nil
+	}
+
+	inRescue, err := helper.IsInstanceInRescueMode(ctx, client, instanceID)
+	if err != nil {
+		return false, err
+	}
+
+	if rescue.ValueBool() {
+		// Instance is already in desired state
+		if inRescue && !rescueDevicesChanged {
+			return true, nil
+		}
+
+		devices, err := expandRescueDevices(ctx, *client, rescueDevices, instanceID)
+		if err != nil {
+			return false, err
+		}
+
+		return true, helper.RescueInstanceSync(ctx, client, instanceID, devices, deadlineSeconds)
+	}
+
+	if !inRescue {
+		return false, nil
+	}
+
+	tflog.Info(ctx, "Instance is in rescue mode; returning it to its boot config")
+
+	// Leaving rescue mode boots the instance unless it is explicitly not booted
+	if !booted.IsUnknown() && !booted.IsNull() && !booted.ValueBool() {
+		return true, helper.ShutDownInstanceSync(ctx, client, instanceID, deadlineSeconds)
+	}
+
+	if diags := helper.FrameworkRebootInstance(ctx, instanceID, client, configID); diags.HasError() {
+		return true, fmt.Errorf("failed to reboot instance out of rescue mode: %s", diags.Errors()[0].Detail())
+	}
+
+	return true, nil
+}
+
+// expandRescueDevices expands the rescue_device blocks into a device map,
+// resolving disk labels against the disks of the instance.
+func expandRescueDevices(
+	ctx context.Context, client linodego.Client, rescueDevices []RescueDeviceModel, instanceID int,
+) (linodego.InstanceConfigDeviceMap, error) {
+	var deviceMap linodego.InstanceConfigDeviceMap
+
+	if len(rescueDevices) == 0 {
+		return deviceMap, nil
+	}
+
+	disks, err := getInstanceDisks(ctx, client, instanceID)
+	if err != nil {
+		return deviceMap, err
+	}
+
+	diskIDLabelMap := make(map[string]int, len(disks))
+	for label, disk := range disks {
+		diskIDLabelMap[label] = disk.ID
+	}
+
+	for _, rescueDevice := range rescueDevices {
+		dev := ConfigDeviceModel{
+			DiskLabel: rescueDevice.DiskLabel,
+			DiskID:    rescueDevice.DiskID,
+			VolumeID:  rescueDevice.VolumeID,
+		}
+
+		device := new(linodego.InstanceConfigDevice)
+		if err := assignConfigDevice(device, dev, diskIDLabelMap); err != nil {
+			return deviceMap, err
+		}
+
+		deviceMap = changeInstanceConfigDevice(deviceMap, rescueDevice.DeviceName.ValueString(), device)
+	}
+
+	return deviceMap, nil
+}
+
+func getDiskSizeSum(ctx context.Context,
 	client *linodego.Client, instanceID int,
 ) (int, error) {
 	disks, err := client.ListInstanceDisks(ctx, instanceID, nil)
@@ -1259,6 +1170,10 @@ func validateImplicitDisks(ctx context.Context,
 		return fmt.Errorf("failed to get instance disks: %s", err)
 	}
 
+	return validateImplicitDiskLayout(disks)
+}
+
+func validateImplicitDiskLayout(disks []linodego.InstanceDisk) error {
 	// No disks are an acceptable case
 	if len(disks) < 1 {
 		return nil
@@ -1272,7 +1187,7 @@ func validateImplicitDisks(ctx context.Context,
 	return nil
 }
 
-func getPrimaryImplicitDisk(ctx context.Context, d *schema.ResourceData,
+func getPrimaryImplicitDisk(ctx context.Context,
 	client *linodego.Client, instanceID int,
 ) (*linodego.InstanceDisk, error) {
 	disks, err := client.ListInstanceDisks(ctx, instanceID, nil)
@@ -1349,12 +1264,12 @@ func VPCInterfaceIncluded(
 	return false
 }
 
-func BootInstanceAfterVPCInterfaceUpdate(ctx context.Context, meta *helper.ProviderMeta, instanceID, targetConfigID, deadlineSeconds int) diag.Diagnostics {
+func BootInstanceAfterVPCInterfaceUpdate(ctx context.Context, client *linodego.Client, instanceID, targetConfigID, deadlineSeconds int) error {
 	tflog.Debug(ctx, "Booting instance after VPC interface change applied")
 	if err := helper.BootInstanceSync(
-		ctx, &meta.Client, instanceID, targetConfigID, deadlineSeconds,
+		ctx, client, instanceID, targetConfigID, deadlineSeconds,
 	); err != nil {
-		return diag.Errorf("failed to boot instance after VPC interface change applied: %s", err)
+		return fmt.Errorf("failed to boot instance after VPC interface change applied: %s", err)
 	}
 	return nil
 }
@@ -1466,24 +1381,3 @@ func reassignPlacementGroup(
 
 	return nil
 }
-
-// expandInstancePlacementGroup expands the user-defined `placement_group` block into the
-// linodego InstanceCreatePlacementGroupOptions struct.
-func getPlacementGroupCreateOptions(ctx context.Context, d *schema.ResourceData) *linodego.InstanceCreatePlacementGroupOptions {
-	if _, ok := d.GetOk("placement_group.0"); !ok {
-		return nil
-	}
-
-	pgOptions := linodego.InstanceCreatePlacementGroupOptions{
-		// ID is required when the block is defined, so we don't
-		// need to do an OK check here
-		ID: d.Get("placement_group.0.id").(int),
-		CompliantOnly: helper.SDKv2UnwrapOptionalConfigAttr[bool](
-			ctx,
-			d,
-			"placement_group.0.compliant_only",
-		),
-	}
-
-	return &pgOptions
-}
@@ -0,0 +1,67 @@
+//go:build unit
+
+package instance
+
+import (
+	"testing"
+
+	"github.com/linode/linodego"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestValidateImplicitDiskLayoutForType(t *testing.T) {
+	nanode := &linodego.LinodeType{Label: "Nanode 1GB", Disk: 25600}
+
+	implicitDisks := []linodego.InstanceDisk{
+		{Label: "boot", Filesystem: linodego.FilesystemExt4, Size: 25344},
+		{Label: "swap", Filesystem: linodego.FilesystemSwap, Size: 256},
+	}
+
+	oversizedDisks := []linodego.InstanceDisk{
+		{Label: "boot", Filesystem: linodego.FilesystemExt4, Size: 50944},
+		{Label: "swap", Filesystem: linodego.FilesystemSwap, Size: 256},
+	}
+
+	extraDisks := []linodego.InstanceDisk{
+		{Label: "boot", Filesystem: linodego.FilesystemExt4, Size: 10000},
+		{Label: "swap", Filesystem: linodego.FilesystemSwap, Size: 256},
+		{Label: "data", Filesystem: linodego.FilesystemExt4, Size: 10000},
+	}
+
+	assert.NoError(t, validateImplicitDiskLayoutForType(implicitDisks, nanode, false))
+	assert.NoError(t, validateImplicitDiskLayoutForType(implicitDisks, nanode, true))
+
+	err := validateImplicitDiskLayoutForType(oversizedDisks, nanode, false)
+	assert.ErrorContains(t, err, "insufficient disk capacity")
+
+	err = validateImplicitDiskLayoutForType(oversizedDisks, nanode, true)
+	assert.ErrorContains(t, err, "Did you try to resize a linode with implicit")
+
+	assert.NoError(t, validateImplicitDiskLayoutForType(extraDisks, nanode, false))
+
+	err = validateImplicitDiskLayoutForType(extraDisks, nanode, true)
+	assert.ErrorContains(t, err, "resize_disk is incompatible with the current disk layout")
+}
+
+func TestValidateSwapSizeChange(t *testing.T) {
+	disks := []linodego.InstanceDisk{
+		{Label: "boot", Filesystem: linodego.FilesystemExt4, Size: 25344},
+		{Label: "swap", Filesystem: linodego.FilesystemSwap, Size: 256},
+	}
+
+	assert.NoError(t, validateSwapSizeChange(disks, 256, 512))
+	assert.NoError(t, validateSwapSizeChange(disks, 256, 128))
+
+	err := validateSwapSizeChange(disks, 256, 25600)
+	assert.ErrorContains(t, err, "leaves no space for the boot disk")
+
+	err = validateSwapSizeChange(disks[:1], 0, 512)
+	assert.ErrorContains(t, err, "ext4 boot disk and a swap disk")
+}
+
+func TestAssertDiskSizeFitsInstanceType(t *testing.T) {
+	typ := &linodego.LinodeType{Label: "Nanode 1GB", Disk: 25600}
+
+	assert.NoError(t, assertDiskSizeFitsInstanceType(25600, typ))
+	assert.ErrorContains(t, assertDiskSizeFitsInstanceType(25601, typ), "insufficient disk capacity")
+}
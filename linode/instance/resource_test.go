@@ -115,6 +115,61 @@ func TestAccResourceInstance_basic_smoke(t *testing.T) {
 	})
 }
 
+func TestAccResourceInstance_upgradeFromSDKv2(t *testing.T) {
+	t.Parallel()
+
+	resName := "linode_instance.foobar"
+	instanceName := acctest.RandomWithPrefix("tf_test")
+	rootPass := acctest.RandString(64)
+	config := tmpl.Basic(t, instanceName, acceptance.PublicKeyMaterial, testRegion, rootPass)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { acceptance.PreCheck(t) },
+		CheckDestroy: acceptance.CheckInstanceDestroy,
+
+		Steps: []resource.TestStep{
+			{
+				ExternalProviders: acceptance.SDKv2ExternalProviders,
+				Config:            config,
+				Check:             resource.TestCheckResourceAttrSet(resName, "id"),
+			},
+			// The hashed root_pass of the SDKv2 state must not cause a diff
+			{
+				ProtoV5ProviderFactories: acceptance.ProtoV5ProviderFactories,
+				Config:                   config,
+				PlanOnly:                 true,
+			},
+		},
+	})
+}
+
+func TestAccResourceInstance_upgradeDisksFromSDKv2(t *testing.T) {
+	t.Parallel()
+
+	resName := "linode_instance.foobar"
+	instanceName := acctest.RandomWithPrefix("tf_test")
+	rootPass := acctest.RandString(64)
+	config := tmpl.DiskConfig(t, instanceName, acceptance.PublicKeyMaterial, testRegion, rootPass)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { acceptance.PreCheck(t) },
+		CheckDestroy: acceptance.CheckInstanceDestroy,
+
+		Steps: []resource.TestStep{
+			{
+				ExternalProviders: acceptance.SDKv2ExternalProviders,
+				Config:            config,
+				Check:             resource.TestCheckResourceAttrSet(resName, "id"),
+			},
+			{
+				ProtoV5ProviderFactories: acceptance.ProtoV5ProviderFactories,
+				Config:                   config,
+				PlanOnly:                 true,
+			},
+		},
+	})
+}
+
 func TestAccResourceInstance_vpu(t *testing.T) {
 	t.Parallel()
 
@@ -1475,6 +1530,27 @@ func TestAccResourceInstance_swapUpsize(t *testing.T) {
 	})
 }
 
+func TestAccResourceInstance_swapSizePlanValidation(t *testing.T) {
+	t.Parallel()
+
+	instanceName := acctest.RandomWithPrefix("tf_test")
+	rootPass := acctest.RandString(64)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acceptance.PreCheck(t) },
+		ProtoV5ProviderFactories: acceptance.ProtoV5ProviderFactories,
+		CheckDestroy:             acceptance.CheckInstanceDestroy,
+
+		Steps: []resource.TestStep{
+			{
+				Config:      tmpl.WithSwapSize(t, instanceName, acceptance.PublicKeyMaterial, testRegion, 30000, rootPass),
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile("leaves no space for the boot disk"),
+			},
+		},
+	})
+}
+
 func TestAccResourceInstance_swapDownsize(t *testing.T) {
 	t.Parallel()
 
@@ -2039,6 +2115,42 @@ func TestAccResourceInstance_powerStateBooted(t *testing.T) {
 	})
 }
 
+func TestAccResourceInstance_rescue(t *testing.T) {
+	t.Parallel()
+
+	resName := "linode_instance.foobar"
+	var instance linodego.Instance
+	instanceName := acctest.RandomWithPrefix("tf_test")
+	rootPass := acctest.RandString(64)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acceptance.PreCheck(t) },
+		ProtoV5ProviderFactories: acceptance.ProtoV5ProviderFactories,
+		CheckDestroy:             acceptance.CheckInstanceDestroy,
+
+		Steps: []resource.TestStep{
+			{
+				Config: tmpl.Rescue(t, instanceName, testRegion, true, rootPass),
+				Check: resource.ComposeTestCheckFunc(
+					acceptance.CheckInstanceExists(resName, &instance),
+					checkRescueMode(&instance, true),
+					resource.TestCheckResourceAttr(resName, "status", "running"),
+					resource.TestCheckResourceAttr(resName, "rescue", "true"),
+				),
+			},
+			{
+				Config: tmpl.Rescue(t, instanceName, testRegion, false, rootPass),
+				Check: resource.ComposeTestCheckFunc(
+					acceptance.CheckInstanceExists(resName, &instance),
+					checkRescueMode(&instance, false),
+					resource.TestCheckResourceAttr(resName, "status", "running"),
+					resource.TestCheckResourceAttr(resName, "rescue", "false"),
+				),
+			},
+		},
+	})
+}
+
 func TestAccResourceInstance_powerStateNoImage(t *testing.T) {
 	t.Parallel()
 
@@ -2685,6 +2797,23 @@ func checkInstancePrivateNetworkAttributes(n string) resource.TestCheckFunc {
 	}
 }
 
+func checkRescueMode(instance *linodego.Instance, expected bool) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		client := acceptance.TestAccProvider.Meta().(*helper.ProviderMeta).Client
+
+		inRescue, err := helper.IsInstanceInRescueMode(context.Background(), &client, instance.ID)
+		if err != nil {
+			return err
+		}
+
+		if inRescue != expected {
+			return fmt.Errorf("expected instance rescue mode to be %t, got %t", expected, inRescue)
+		}
+
+		return nil
+	}
+}
+
 type (
 	testDiskFunc  func(disk linodego.InstanceDisk) error
 	testDisksFunc func(disk []linodego.InstanceDisk) error
@@ -2,8 +2,13 @@ package instance
 
 import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/linode/terraform-provider-linode/v2/linode/helper"
 )
 
+const deviceDescription = "Device can be either a Disk or Volume identified by disk_id or " +
+	"volume_id. Only one type per slot allowed."
+
 var instanceDataSourceSchema = map[string]*schema.Schema{
 	"id": {
 		Type:        schema.TypeInt,
@@ -469,3 +474,124 @@ var instanceDataSourceSchema = map[string]*schema.Schema{
 		},
 	},
 }
+
+func resourceDeviceDisk() *schema.Resource {
+	return &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"disk_label": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "The `label` of the `disk` to map to this `device` slot.",
+			},
+			"disk_id": {
+				Type:        schema.TypeInt,
+				Optional:    true,
+				Computed:    true,
+				Description: "The Disk ID to map to this disk slot",
+			},
+			"volume_id": {
+				Type:        schema.TypeInt,
+				Optional:    true,
+				Description: "The Block Storage volume ID to map to this disk slot",
+			},
+		},
+	}
+}
+
+var InterfaceSchema = &schema.Resource{
+	Schema: map[string]*schema.Schema{
+		"purpose": {
+			Type:        schema.TypeString,
+			Description: "The type of interface.",
+			Required:    true,
+			ValidateDiagFunc: validation.ToDiagFunc(
+				validation.StringInSlice([]string{"public", "vlan", "vpc"}, true),
+			),
+		},
+		"ipam_address": {
+			Type: schema.TypeString,
+			Description: "This Network Interface's private IP address in " +
+				"Classless Inter-Domain Routing (CIDR) notation." +
+				onlyAllowedForVLANMsg,
+			Optional: true,
+		},
+		"label": {
+			Type: schema.TypeString,
+			Description: "The name of the VALN. " + requiredForVLANMsg +
+				" " + onlyAllowedForVLANMsg,
+			Optional: true,
+		},
+		"id": {
+			Type:        schema.TypeInt,
+			Description: "The ID of the interface.",
+			Computed:    true,
+		},
+		"subnet_id": {
+			Type: schema.TypeInt,
+			Description: "The ID of the subnet which the VPC interface is connected to." +
+				requiredForVPCMsg + onlyAllowedForVPCMsg,
+			Optional: true,
+		},
+		"vpc_id": {
+			Type: schema.TypeInt,
+			Description: "The ID of VPC of the subnet which the VPC " +
+				"interface is connected to.",
+			Computed: true,
+		},
+		"primary": {
+			Type: schema.TypeBool,
+			Description: "Whether the interface is the primary interface that should " +
+				"have the default route for this Linode.",
+			Optional: true,
+			Default:  false,
+		},
+		"active": {
+			Type:        schema.TypeBool,
+			Description: "Whether this interface is currently booted and active.",
+			Computed:    true,
+		},
+		"ip_ranges": {
+			Type:        schema.TypeList,
+			Description: "List of VPC IPs or IP ranges inside the VPC subnet.",
+			Optional:    true,
+			Elem: &schema.Schema{
+				Type: schema.TypeString,
+				ValidateDiagFunc: validation.AnyDiag(
+					helper.SDKv2ValidateIPv4Range,
+					helper.SDKv2ValidateIPv6Range,
+				),
+			},
+		},
+		"ipv4": {
+			Type: schema.TypeList,
+			Description: "The IPv4 configuration of the VPC interface." +
+				onlyAllowedForVPCMsg,
+			Computed: true,
+			Optional: true,
+			MaxItems: 1,
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"vpc": {
+						Type:        schema.TypeString,
+						Description: "The IP from the VPC subnet to use for this interface.",
+						Computed:    true,
+						Optional:    true,
+					},
+					"nat_1_1": {
+						Type: schema.TypeString,
+						Description: "The public IP that will be used for the " +
+							"one-to-one NAT purpose.",
+						Computed: true,
+						Optional: true,
+						DiffSuppressFunc: func(k, old, new string, d *schema.ResourceData) bool {
+							if new == "any" && old != "" {
+								return true
+							}
+							return old == new
+						},
+					},
+				},
+			},
+		},
+	},
+}
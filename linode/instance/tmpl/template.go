@@ -23,6 +23,7 @@ type TemplateData struct {
 	StackScriptName string
 
 	Booted     bool
+	Rescue     bool
 	ResizeDisk bool
 
 	PlacementGroups []string
@@ -513,6 +514,17 @@ func BootStateConfig(t testing.TB, label, region string, booted bool, rootPass s
 		})
 }
 
+func Rescue(t testing.TB, label, region string, rescue bool, rootPass string) string {
+	return acceptance.ExecuteTemplate(t,
+		"instance_rescue", TemplateData{
+			Label:    label,
+			Rescue:   rescue,
+			Image:    acceptance.TestImageLatest,
+			Region:   region,
+			RootPass: rootPass,
+		})
+}
+
 func TypeChangeDisk(t testing.TB, label, instanceType, region string, resizeDisk bool) string {
 	return acceptance.ExecuteTemplate(t,
 		"instance_type_change_disk", TemplateData{
@@ -0,0 +1,24 @@
+package instancebackuprestore
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/linode/terraform-provider-linode/v2/linode/helper"
+)
+
+type ResourceModel struct {
+	ID             types.String   `tfsdk:"id"`
+	LinodeID       types.Int64    `tfsdk:"linode_id"`
+	BackupID       types.Int64    `tfsdk:"backup_id"`
+	TargetLinodeID types.Int64    `tfsdk:"target_linode_id"`
+	Overwrite      types.Bool     `tfsdk:"overwrite"`
+	Timeouts       timeouts.Value `tfsdk:"timeouts"`
+}
+
+func (data *ResourceModel) CopyFrom(other ResourceModel, preserveKnown bool) {
+	data.ID = helper.KeepOrUpdateValue(data.ID, other.ID, preserveKnown)
+	data.LinodeID = helper.KeepOrUpdateValue(data.LinodeID, other.LinodeID, preserveKnown)
+	data.BackupID = helper.KeepOrUpdateValue(data.BackupID, other.BackupID, preserveKnown)
+	data.TargetLinodeID = helper.KeepOrUpdateValue(data.TargetLinodeID, other.TargetLinodeID, preserveKnown)
+	data.Overwrite = helper.KeepOrUpdateValue(data.Overwrite, other.Overwrite, preserveKnown)
+}
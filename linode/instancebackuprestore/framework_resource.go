@@ -0,0 +1,153 @@
+package instancebackuprestore
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/linode/linodego"
+	"github.com/linode/terraform-provider-linode/v2/linode/helper"
+)
+
+const DefaultRestoreCreateTimeout = 30 * time.Minute
+
+func NewResource() resource.Resource {
+	return &Resource{
+		BaseResource: helper.NewBaseResource(
+			helper.BaseResourceConfig{
+				Name:   "linode_instance_backup_restore",
+				IDType: types.StringType,
+				Schema: &frameworkResourceSchema,
+				TimeoutOpts: &timeouts.Opts{
+					Create: true,
+				},
+			},
+		),
+	}
+}
+
+type Resource struct {
+	helper.BaseResource
+}
+
+func (r *Resource) Create(
+	ctx context.Context,
+	req resource.CreateRequest,
+	resp *resource.CreateResponse,
+) {
+	tflog.Debug(ctx, "Create "+r.Config.Name)
+
+	var plan ResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	createTimeout, diags := plan.Timeouts.Create(ctx, DefaultRestoreCreateTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, createTimeout)
+	defer cancel()
+
+	if plan.TargetLinodeID.IsUnknown() || plan.TargetLinodeID.IsNull() {
+		plan.TargetLinodeID = plan.LinodeID
+	}
+
+	client := r.Meta.Client
+
+	timeoutSeconds := helper.FrameworkSafeFloat64ToInt(createTimeout.Seconds(), &resp.Diagnostics)
+	linodeID := helper.FrameworkSafeInt64ToInt(plan.LinodeID.ValueInt64(), &resp.Diagnostics)
+	backupID := helper.FrameworkSafeInt64ToInt(plan.BackupID.ValueInt64(), &resp.Diagnostics)
+	targetLinodeID := helper.FrameworkSafeInt64ToInt(plan.TargetLinodeID.ValueInt64(), &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx = helper.SetLogFieldBulk(ctx, map[string]any{
+		"linode_id":        linodeID,
+		"backup_id":        backupID,
+		"target_linode_id": targetLinodeID,
+	})
+
+	p, err := client.NewEventPoller(ctx, targetLinodeID, linodego.EntityLinode, linodego.ActionBackupsRestore)
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to Poll for Events", err.Error())
+		return
+	}
+
+	restoreOpts := linodego.RestoreInstanceOptions{
+		LinodeID:  targetLinodeID,
+		Overwrite: plan.Overwrite.ValueBool(),
+	}
+
+	tflog.Debug(ctx, "client.RestoreInstanceBackup(...)", map[string]any{
+		"options": restoreOpts,
+	})
+	if err := client.RestoreInstanceBackup(ctx, linodeID, backupID, restoreOpts); err != nil {
+		resp.Diagnostics.AddError(
+			fmt.Sprintf("Failed to Restore Backup %d of Linode Instance %d", backupID, linodeID),
+			err.Error(),
+		)
+		return
+	}
+
+	tflog.Debug(ctx, "Waiting for the restore to finish")
+	if _, err := p.WaitForFinished(ctx, timeoutSeconds); err != nil {
+		resp.Diagnostics.AddError(
+			fmt.Sprintf("Failed to Wait for Backup %d to be Restored onto Linode Instance %d", backupID, targetLinodeID),
+			err.Error(),
+		)
+		return
+	}
+
+	plan.ID = types.StringValue(strconv.Itoa(backupID))
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+// Read is a no-op because a restore has no remote state to refresh.
+func (r *Resource) Read(
+	ctx context.Context,
+	req resource.ReadRequest,
+	resp *resource.ReadResponse,
+) {
+	tflog.Debug(ctx, "Read "+r.Config.Name)
+}
+
+func (r *Resource) Update(
+	ctx context.Context,
+	req resource.UpdateRequest,
+	resp *resource.UpdateResponse,
+) {
+	tflog.Debug(ctx, "Update "+r.Config.Name)
+
+	var plan, state ResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Only the timeouts can be updated in place
+	plan.CopyFrom(state, true)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+// Delete only removes the restore from state, the restored
+// disks and configuration profiles are left in place.
+func (r *Resource) Delete(
+	ctx context.Context,
+	req resource.DeleteRequest,
+	resp *resource.DeleteResponse,
+) {
+	tflog.Debug(ctx, "Delete "+r.Config.Name)
+}
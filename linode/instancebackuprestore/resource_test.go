@@ -0,0 +1,81 @@
+//go:build integration || instancebackuprestore
+
+package instancebackuprestore_test
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/linode/linodego"
+	"github.com/linode/terraform-provider-linode/v2/linode/acceptance"
+	"github.com/linode/terraform-provider-linode/v2/linode/helper"
+	"github.com/linode/terraform-provider-linode/v2/linode/instancebackuprestore/tmpl"
+)
+
+const testRestoreResName = "linode_instance_backup_restore.foobar"
+
+var testRegion string
+
+func init() {
+	region, err := acceptance.GetRandomRegionWithCaps([]string{"Linodes", "Backups"}, "core")
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	testRegion = region
+}
+
+func TestAccResourceInstanceBackupRestore_basic(t *testing.T) {
+	t.Parallel()
+
+	var target linodego.Instance
+
+	label := acctest.RandomWithPrefix("tf-test")
+	rootPass := acctest.RandString(64)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acceptance.PreCheck(t) },
+		ProtoV5ProviderFactories: acceptance.ProtoV5ProviderFactories,
+		CheckDestroy:             acceptance.CheckInstanceDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: tmpl.Basic(t, label, testRegion, rootPass),
+				Check: resource.ComposeTestCheckFunc(
+					acceptance.CheckInstanceExists("linode_instance.target", &target),
+					resource.TestCheckResourceAttrPair(
+						testRestoreResName, "id",
+						"linode_instance_snapshot.foobar", "id",
+					),
+					resource.TestCheckResourceAttrPair(
+						testRestoreResName, "target_linode_id",
+						"linode_instance.target", "id",
+					),
+					resource.TestCheckResourceAttr(testRestoreResName, "overwrite", "true"),
+					checkRestoredDisks(&target),
+				),
+			},
+		},
+	})
+}
+
+func checkRestoredDisks(instance *linodego.Instance) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		client := acceptance.TestAccProvider.Meta().(*helper.ProviderMeta).Client
+
+		disks, err := client.ListInstanceDisks(context.Background(), instance.ID, nil)
+		if err != nil {
+			return fmt.Errorf("failed to list disks of Linode %d: %w", instance.ID, err)
+		}
+
+		if len(disks) == 0 {
+			return fmt.Errorf("expected the backup to be restored onto Linode %d", instance.ID)
+		}
+
+		return nil
+	}
+}
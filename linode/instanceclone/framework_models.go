@@ -0,0 +1,103 @@
+package instanceclone
+
+import (
+	"context"
+	"strconv"
+
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/linode/linodego"
+	"github.com/linode/terraform-provider-linode/v2/linode/helper"
+)
+
+type ResourceModel struct {
+	ID             types.String   `tfsdk:"id"`
+	SourceLinodeID types.Int64    `tfsdk:"source_linode_id"`
+	TargetLinodeID types.Int64    `tfsdk:"target_linode_id"`
+	Region         types.String   `tfsdk:"region"`
+	Type           types.String   `tfsdk:"type"`
+	Label          types.String   `tfsdk:"label"`
+	Disks          types.Set      `tfsdk:"disks"`
+	Configs        types.Set      `tfsdk:"configs"`
+	BackupsEnabled types.Bool     `tfsdk:"backups_enabled"`
+	PrivateIP      types.Bool     `tfsdk:"private_ip"`
+	Status         types.String   `tfsdk:"status"`
+	IPv4           types.Set      `tfsdk:"ipv4"`
+	IPv6           types.String   `tfsdk:"ipv6"`
+	Timeouts       timeouts.Value `tfsdk:"timeouts"`
+}
+
+func (data *ResourceModel) GetCloneOptions(
+	ctx context.Context,
+	diags *diag.Diagnostics,
+) linodego.InstanceCloneOptions {
+	opts := linodego.InstanceCloneOptions{
+		Region:         data.Region.ValueString(),
+		Type:           data.Type.ValueString(),
+		Label:          data.Label.ValueString(),
+		BackupsEnabled: data.BackupsEnabled.ValueBool(),
+		PrivateIP:      data.PrivateIP.ValueBool(),
+	}
+
+	if !data.TargetLinodeID.IsNull() {
+		opts.LinodeID = helper.FrameworkSafeInt64ToInt(data.TargetLinodeID.ValueInt64(), diags)
+	}
+
+	if !data.Disks.IsNull() {
+		diags.Append(data.Disks.ElementsAs(ctx, &opts.Disks, false)...)
+	}
+
+	if !data.Configs.IsNull() {
+		diags.Append(data.Configs.ElementsAs(ctx, &opts.Configs, false)...)
+	}
+
+	return opts
+}
+
+func (data *ResourceModel) FlattenInstance(
+	ctx context.Context,
+	instance *linodego.Instance,
+	preserveKnown bool,
+	diags *diag.Diagnostics,
+) {
+	data.ID = helper.KeepOrUpdateString(data.ID, strconv.Itoa(instance.ID), preserveKnown)
+	data.Region = helper.KeepOrUpdateString(data.Region, instance.Region, preserveKnown)
+	data.Type = helper.KeepOrUpdateString(data.Type, instance.Type, preserveKnown)
+	data.Label = helper.KeepOrUpdateString(data.Label, instance.Label, preserveKnown)
+	data.BackupsEnabled = helper.KeepOrUpdateBool(
+		data.BackupsEnabled, instance.Backups != nil && instance.Backups.Enabled, preserveKnown,
+	)
+	data.Status = helper.KeepOrUpdateString(data.Status, string(instance.Status), preserveKnown)
+	data.IPv6 = helper.KeepOrUpdateString(data.IPv6, instance.IPv6, preserveKnown)
+
+	ipv4 := make([]string, len(instance.IPv4))
+	for i, ip := range instance.IPv4 {
+		ipv4[i] = ip.String()
+	}
+
+	ipv4Set, newDiags := types.SetValueFrom(ctx, types.StringType, ipv4)
+	diags.Append(newDiags...)
+	if diags.HasError() {
+		return
+	}
+
+	data.IPv4 = helper.KeepOrUpdateValue(data.IPv4, ipv4Set, preserveKnown)
+}
+
+func (data *ResourceModel) CopyFrom(other ResourceModel, preserveKnown bool) {
+	data.ID = helper.KeepOrUpdateValue(data.ID, other.ID, preserveKnown)
+	data.SourceLinodeID = helper.KeepOrUpdateValue(data.SourceLinodeID, other.SourceLinodeID, preserveKnown)
+	data.TargetLinodeID = helper.KeepOrUpdateValue(data.TargetLinodeID, other.TargetLinodeID, preserveKnown)
+	data.Region = helper.KeepOrUpdateValue(data.Region, other.Region, preserveKnown)
+	data.Type = helper.KeepOrUpdateValue(data.Type, other.Type, preserveKnown)
+	data.Label = helper.KeepOrUpdateValue(data.Label, other.Label, preserveKnown)
+	data.Disks = helper.KeepOrUpdateValue(data.Disks, other.Disks, preserveKnown)
+	data.Configs = helper.KeepOrUpdateValue(data.Configs, other.Configs, preserveKnown)
+	data.BackupsEnabled = helper.KeepOrUpdateValue(data.BackupsEnabled, other.BackupsEnabled, preserveKnown)
+	data.PrivateIP = helper.KeepOrUpdateValue(data.PrivateIP, other.PrivateIP, preserveKnown)
+	data.Status = helper.KeepOrUpdateValue(data.Status, other.Status, preserveKnown)
+	data.IPv4 = helper.KeepOrUpdateValue(data.IPv4, other.IPv4, preserveKnown)
+	data.IPv6 = helper.KeepOrUpdateValue(data.IPv6, other.IPv6, preserveKnown)
+	data.Timeouts = helper.KeepOrUpdateValue(data.Timeouts, other.Timeouts, preserveKnown)
+}
@@ -0,0 +1,71 @@
+//go:build unit
+
+package instanceclone
+
+import (
+	"context"
+	"net"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/linode/linodego"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGetCloneOptions(t *testing.T) {
+	ctx := context.Background()
+
+	data := ResourceModel{
+		SourceLinodeID: types.Int64Value(123),
+		TargetLinodeID: types.Int64Value(456),
+		Disks:          types.SetValueMust(types.Int64Type, nil),
+		Configs: types.SetValueMust(
+			types.Int64Type,
+			[]attr.Value{types.Int64Value(789)},
+		),
+		BackupsEnabled: types.BoolNull(),
+		PrivateIP:      types.BoolValue(true),
+	}
+
+	var diags diag.Diagnostics
+	opts := data.GetCloneOptions(ctx, &diags)
+	assert.False(t, diags.HasError())
+
+	assert.Equal(t, 456, opts.LinodeID)
+	assert.Empty(t, opts.Disks)
+	assert.Equal(t, []int{789}, opts.Configs)
+	assert.False(t, opts.BackupsEnabled)
+	assert.True(t, opts.PrivateIP)
+}
+
+func TestFlattenInstance(t *testing.T) {
+	ctx := context.Background()
+	ip := net.ParseIP("192.0.2.1")
+
+	instance := &linodego.Instance{
+		ID:      12345,
+		Label:   "my-clone",
+		Region:  "us-mia",
+		Type:    "g6-standard-1",
+		Status:  linodego.InstanceOffline,
+		Backups: &linodego.InstanceBackup{Enabled: true},
+		IPv4:    []*net.IP{&ip},
+		IPv6:    "2001:db8::1/128",
+	}
+
+	var data ResourceModel
+	var diags diag.Diagnostics
+	data.FlattenInstance(ctx, instance, false, &diags)
+	assert.False(t, diags.HasError())
+
+	assert.Equal(t, types.StringValue("12345"), data.ID)
+	assert.Equal(t, types.StringValue("my-clone"), data.Label)
+	assert.Equal(t, types.StringValue("us-mia"), data.Region)
+	assert.Equal(t, types.StringValue("g6-standard-1"), data.Type)
+	assert.Equal(t, types.StringValue("offline"), data.Status)
+	assert.Equal(t, types.BoolValue(true), data.BackupsEnabled)
+	assert.Equal(t, types.StringValue("2001:db8::1/128"), data.IPv6)
+	assert.Contains(t, data.IPv4.String(), "192.0.2.1")
+}
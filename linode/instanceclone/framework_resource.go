@@ -0,0 +1,317 @@
+package instanceclone
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/linode/linodego"
+	"github.com/linode/terraform-provider-linode/v2/linode/helper"
+)
+
+const (
+	DefaultCloneCreateTimeout = 30 * time.Minute
+	DefaultCloneUpdateTimeout = 5 * time.Minute
+	DefaultCloneDeleteTimeout = 10 * time.Minute
+)
+
+func NewResource() resource.Resource {
+	return &Resource{
+		BaseResource: helper.NewBaseResource(
+			helper.BaseResourceConfig{
+				Name:   "linode_instance_clone",
+				IDType: types.StringType,
+				Schema: &frameworkResourceSchema,
+				TimeoutOpts: &timeouts.Opts{
+					Create: true,
+					Update: true,
+					Delete: true,
+				},
+			},
+		),
+	}
+}
+
+type Resource struct {
+	helper.BaseResource
+}
+
+func (r *Resource) Create(
+	ctx context.Context,
+	req resource.CreateRequest,
+	resp *resource.CreateResponse,
+) {
+	tflog.Debug(ctx, "Create "+r.Config.Name)
+
+	var plan ResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	createTimeout, diags := plan.Timeouts.Create(ctx, DefaultCloneCreateTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, createTimeout)
+	defer cancel()
+
+	client := r.Meta.Client
+
+	timeoutSeconds := helper.FrameworkSafeFloat64ToInt(createTimeout.Seconds(), &resp.Diagnostics)
+	sourceLinodeID := helper.FrameworkSafeInt64ToInt(plan.SourceLinodeID.ValueInt64(), &resp.Diagnostics)
+	cloneOpts := plan.GetCloneOptions(ctx, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx = helper.SetLogFieldBulk(ctx, map[string]any{
+		"source_linode_id": sourceLinodeID,
+		"target_linode_id": cloneOpts.LinodeID,
+	})
+
+	// The clone event is attached to the source Linode
+	p, err := client.NewEventPoller(ctx, sourceLinodeID, linodego.EntityLinode, linodego.ActionLinodeClone)
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to Poll for Events", err.Error())
+		return
+	}
+
+	tflog.Debug(ctx, "client.CloneInstance(...)", map[string]any{
+		"options": cloneOpts,
+	})
+	instance, err := client.CloneInstance(ctx, sourceLinodeID, cloneOpts)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			fmt.Sprintf("Failed to Clone Linode Instance %d", sourceLinodeID),
+			err.Error(),
+		)
+		return
+	}
+
+	// Add resource to TF states earlier to prevent
+	// dangling resources (resources created but not managed by TF)
+	plan.FlattenInstance(ctx, instance, true, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+
+	id := instance.ID
+	ctx = tflog.SetField(ctx, "linode_id", id)
+
+	tflog.Debug(ctx, "Waiting for the clone to finish")
+	if _, err := p.WaitForFinished(ctx, timeoutSeconds); err != nil {
+		resp.Diagnostics.AddError(
+			fmt.Sprintf("Failed to Wait for Linode Instance %d to be Cloned", sourceLinodeID),
+			err.Error(),
+		)
+		return
+	}
+
+	tflog.Trace(ctx, "client.GetInstance(...)")
+	instance, err = client.GetInstance(ctx, id)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			fmt.Sprintf("Failed to Get Linode Instance %d", id),
+			err.Error(),
+		)
+		return
+	}
+
+	plan.FlattenInstance(ctx, instance, true, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *Resource) Read(
+	ctx context.Context,
+	req resource.ReadRequest,
+	resp *resource.ReadResponse,
+) {
+	tflog.Debug(ctx, "Read "+r.Config.Name)
+
+	var state ResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if helper.FrameworkAttemptRemoveResourceForEmptyID(ctx, state.ID, resp) {
+		return
+	}
+
+	ctx = populateLogAttributes(ctx, state)
+
+	id := helper.FrameworkSafeStringToInt(state.ID.ValueString(), &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	client := r.Meta.Client
+
+	tflog.Trace(ctx, "client.GetInstance(...)")
+	instance, err := client.GetInstance(ctx, id)
+	if err != nil {
+		if linodego.IsNotFound(err) {
+			resp.Diagnostics.AddWarning(
+				"Linode Instance Not Found",
+				fmt.Sprintf(
+					"Removing clone Linode Instance %d from state because it no longer exists",
+					id,
+				),
+			)
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError(
+			fmt.Sprintf("Failed to Get Linode Instance %d", id),
+			err.Error(),
+		)
+		return
+	}
+
+	state.FlattenInstance(ctx, instance, false, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+func (r *Resource) Update(
+	ctx context.Context,
+	req resource.UpdateRequest,
+	resp *resource.UpdateResponse,
+) {
+	tflog.Debug(ctx, "Update "+r.Config.Name)
+
+	var plan, state ResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx = populateLogAttributes(ctx, state)
+
+	updateTimeout, diags := plan.Timeouts.Update(ctx, DefaultCloneUpdateTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, updateTimeout)
+	defer cancel()
+
+	if !plan.Label.IsUnknown() && !plan.Label.Equal(state.Label) {
+		id := helper.FrameworkSafeStringToInt(state.ID.ValueString(), &resp.Diagnostics)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+
+		client := r.Meta.Client
+
+		tflog.Debug(ctx, "client.UpdateInstance(...)")
+		instance, err := client.UpdateInstance(ctx, id, linodego.InstanceUpdateOptions{
+			Label: plan.Label.ValueString(),
+		})
+		if err != nil {
+			resp.Diagnostics.AddError(
+				fmt.Sprintf("Failed to Update Linode Instance %d", id),
+				err.Error(),
+			)
+			return
+		}
+
+		plan.FlattenInstance(ctx, instance, true, &resp.Diagnostics)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+
+	plan.CopyFrom(state, true)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *Resource) Delete(
+	ctx context.Context,
+	req resource.DeleteRequest,
+	resp *resource.DeleteResponse,
+) {
+	tflog.Debug(ctx, "Delete "+r.Config.Name)
+
+	var state ResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx = populateLogAttributes(ctx, state)
+
+	// The target Linode is managed outside of this resource
+	if !state.TargetLinodeID.IsNull() {
+		tflog.Info(ctx, "Clone target Linode is not managed by this resource, skipping deletion")
+		return
+	}
+
+	deleteTimeout, diags := state.Timeouts.Delete(ctx, DefaultCloneDeleteTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, deleteTimeout)
+	defer cancel()
+
+	client := r.Meta.Client
+
+	id := helper.FrameworkSafeStringToInt(state.ID.ValueString(), &resp.Diagnostics)
+	timeoutSeconds := helper.FrameworkSafeFloat64ToInt(deleteTimeout.Seconds(), &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	p, err := client.NewEventPoller(ctx, id, linodego.EntityLinode, linodego.ActionLinodeDelete)
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to Poll for Events", err.Error())
+		return
+	}
+
+	tflog.Debug(ctx, "client.DeleteInstance(...)")
+	if err := client.DeleteInstance(ctx, id); err != nil {
+		if !linodego.IsNotFound(err) {
+			resp.Diagnostics.AddError(
+				fmt.Sprintf("Failed to Delete Linode Instance %d", id),
+				err.Error(),
+			)
+		}
+		return
+	}
+
+	if !r.Meta.Config.SkipInstanceDeletePoll.ValueBool() {
+		if _, err := p.WaitForFinished(ctx, timeoutSeconds); err != nil {
+			resp.Diagnostics.AddError(
+				fmt.Sprintf("Failed to Wait for Linode Instance %d to be Deleted", id),
+				err.Error(),
+			)
+		}
+	}
+}
+
+func populateLogAttributes(ctx context.Context, data ResourceModel) context.Context {
+	return helper.SetLogFieldBulk(ctx, map[string]any{
+		"linode_id":        data.ID.ValueString(),
+		"source_linode_id": data.SourceLinodeID.ValueInt64(),
+	})
+}
@@ -0,0 +1,140 @@
+package instanceclone
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/setplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var frameworkResourceSchema = schema.Schema{
+	Attributes: map[string]schema.Attribute{
+		"id": schema.StringAttribute{
+			Description: "The ID of the Linode the source Linode was cloned into.",
+			Computed:    true,
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.UseStateForUnknown(),
+			},
+		},
+		"source_linode_id": schema.Int64Attribute{
+			Description: "The ID of the Linode to clone.",
+			Required:    true,
+			PlanModifiers: []planmodifier.Int64{
+				int64planmodifier.RequiresReplace(),
+			},
+		},
+		"target_linode_id": schema.Int64Attribute{
+			Description: "The ID of an existing Linode to clone the source Linode onto. " +
+				"The target Linode must have enough resources to accept the clone.",
+			Optional: true,
+			PlanModifiers: []planmodifier.Int64{
+				int64planmodifier.RequiresReplace(),
+			},
+			Validators: []validator.Int64{
+				int64validator.ExactlyOneOf(
+					path.MatchRoot("region"),
+				),
+				int64validator.ConflictsWith(
+					path.MatchRoot("type"),
+					path.MatchRoot("label"),
+					path.MatchRoot("backups_enabled"),
+				),
+			},
+		},
+		"region": schema.StringAttribute{
+			Description: "The region to clone the source Linode into.",
+			Optional:    true,
+			Computed:    true,
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.RequiresReplaceIfConfigured(),
+				stringplanmodifier.UseStateForUnknown(),
+			},
+			Validators: []validator.String{
+				stringvalidator.AlsoRequires(path.MatchRoot("type")),
+			},
+		},
+		"type": schema.StringAttribute{
+			Description: "The Linode type of the clone.",
+			Optional:    true,
+			Computed:    true,
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.RequiresReplaceIfConfigured(),
+				stringplanmodifier.UseStateForUnknown(),
+			},
+			Validators: []validator.String{
+				stringvalidator.AlsoRequires(path.MatchRoot("region")),
+			},
+		},
+		"label": schema.StringAttribute{
+			Description: "The label of the clone.",
+			Optional:    true,
+			Computed:    true,
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.UseStateForUnknown(),
+			},
+			Validators: []validator.String{
+				stringvalidator.LengthBetween(3, 64),
+			},
+		},
+		"disks": schema.SetAttribute{
+			Description: "The IDs of the disks of the source Linode to clone. " +
+				"All disks are cloned if neither disks nor configs are specified.",
+			Optional:    true,
+			ElementType: types.Int64Type,
+			PlanModifiers: []planmodifier.Set{
+				setplanmodifier.RequiresReplace(),
+			},
+		},
+		"configs": schema.SetAttribute{
+			Description: "The IDs of the configuration profiles of the source Linode to clone, " +
+				"along with the disks they use.",
+			Optional:    true,
+			ElementType: types.Int64Type,
+			PlanModifiers: []planmodifier.Set{
+				setplanmodifier.RequiresReplace(),
+			},
+		},
+		"backups_enabled": schema.BoolAttribute{
+			Description: "Whether backups are enabled on the clone.",
+			Optional:    true,
+			Computed:    true,
+			PlanModifiers: []planmodifier.Bool{
+				boolplanmodifier.RequiresReplaceIfConfigured(),
+				boolplanmodifier.UseStateForUnknown(),
+			},
+		},
+		"private_ip": schema.BoolAttribute{
+			Description: "Whether a private IPv4 address is allocated to the clone.",
+			Optional:    true,
+			PlanModifiers: []planmodifier.Bool{
+				boolplanmodifier.RequiresReplace(),
+			},
+		},
+		"status": schema.StringAttribute{
+			Description: "The status of the clone.",
+			Computed:    true,
+		},
+		"ipv4": schema.SetAttribute{
+			Description: "The IPv4 addresses of the clone.",
+			Computed:    true,
+			ElementType: types.StringType,
+			PlanModifiers: []planmodifier.Set{
+				setplanmodifier.UseStateForUnknown(),
+			},
+		},
+		"ipv6": schema.StringAttribute{
+			Description: "The IPv6 SLAAC address of the clone.",
+			Computed:    true,
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.UseStateForUnknown(),
+			},
+		},
+	},
+}
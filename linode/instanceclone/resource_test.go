@@ -0,0 +1,167 @@
+//go:build integration || instanceclone
+
+package instanceclone_test
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strconv"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/linode/linodego"
+	"github.com/linode/terraform-provider-linode/v2/linode/acceptance"
+	"github.com/linode/terraform-provider-linode/v2/linode/helper"
+	"github.com/linode/terraform-provider-linode/v2/linode/instanceclone/tmpl"
+)
+
+const testCloneResName = "linode_instance_clone.foobar"
+
+var testRegion string
+
+func init() {
+	region, err := acceptance.GetRandomRegionWithCaps([]string{"Linodes"}, "core")
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	testRegion = region
+}
+
+func TestAccResourceInstanceClone_basic(t *testing.T) {
+	t.Parallel()
+
+	var instance linodego.Instance
+
+	label := acctest.RandomWithPrefix("tf-test")
+	rootPass := acctest.RandString(64)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acceptance.PreCheck(t) },
+		ProtoV5ProviderFactories: acceptance.ProtoV5ProviderFactories,
+		CheckDestroy:             checkDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: tmpl.Basic(t, label, testRegion, rootPass),
+				Check: resource.ComposeTestCheckFunc(
+					acceptance.CheckInstanceExists(testCloneResName, &instance),
+					resource.TestCheckResourceAttr(testCloneResName, "label", label),
+					resource.TestCheckResourceAttr(testCloneResName, "region", testRegion),
+					resource.TestCheckResourceAttr(testCloneResName, "type", "g6-nanode-1"),
+					resource.TestCheckResourceAttrSet(testCloneResName, "status"),
+					resource.TestCheckResourceAttrSet(testCloneResName, "ipv6"),
+					resource.TestCheckResourceAttr(testCloneResName, "ipv4.#", "1"),
+					checkDiskCount(&instance, 2),
+				),
+			},
+			{
+				Config: tmpl.Basic(t, label+"-renamed", testRegion, rootPass),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(testCloneResName, "label", label+"-renamed"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccResourceInstanceClone_disks(t *testing.T) {
+	t.Parallel()
+
+	var instance linodego.Instance
+
+	label := acctest.RandomWithPrefix("tf-test")
+	rootPass := acctest.RandString(64)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acceptance.PreCheck(t) },
+		ProtoV5ProviderFactories: acceptance.ProtoV5ProviderFactories,
+		CheckDestroy:             checkDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: tmpl.Disks(t, label, testRegion, rootPass),
+				Check: resource.ComposeTestCheckFunc(
+					acceptance.CheckInstanceExists(testCloneResName, &instance),
+					resource.TestCheckResourceAttr(testCloneResName, "configs.#", "1"),
+					resource.TestCheckResourceAttr(testCloneResName, "disks.#", "1"),
+					checkDiskCount(&instance, 1),
+				),
+			},
+		},
+	})
+}
+
+func TestAccResourceInstanceClone_target(t *testing.T) {
+	t.Parallel()
+
+	var instance linodego.Instance
+
+	label := acctest.RandomWithPrefix("tf-test")
+	rootPass := acctest.RandString(64)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acceptance.PreCheck(t) },
+		ProtoV5ProviderFactories: acceptance.ProtoV5ProviderFactories,
+		CheckDestroy:             acceptance.CheckInstanceDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: tmpl.Target(t, label, testRegion, rootPass),
+				Check: resource.ComposeTestCheckFunc(
+					acceptance.CheckInstanceExists(testCloneResName, &instance),
+					resource.TestCheckResourceAttrPair(
+						testCloneResName, "id",
+						"linode_instance.target", "id",
+					),
+					resource.TestCheckResourceAttr(testCloneResName, "label", label+"-dst"),
+					resource.TestCheckResourceAttr(testCloneResName, "type", "g6-standard-1"),
+					checkDiskCount(&instance, 2),
+				),
+			},
+		},
+	})
+}
+
+func checkDiskCount(instance *linodego.Instance, expected int) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		client := acceptance.TestAccProvider.Meta().(*helper.ProviderMeta).Client
+
+		disks, err := client.ListInstanceDisks(context.Background(), instance.ID, nil)
+		if err != nil {
+			return fmt.Errorf("failed to list disks of Linode %d: %w", instance.ID, err)
+		}
+
+		if len(disks) != expected {
+			return fmt.Errorf("expected %d disks on Linode %d, got %d", expected, instance.ID, len(disks))
+		}
+
+		return nil
+	}
+}
+
+func checkDestroy(s *terraform.State) error {
+	client := acceptance.TestAccProvider.Meta().(*helper.ProviderMeta).Client
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "linode_instance_clone" {
+			continue
+		}
+
+		id, err := strconv.Atoi(rs.Primary.ID)
+		if err != nil {
+			return fmt.Errorf("Error parsing %v as int", rs.Primary.ID)
+		}
+
+		_, err = client.GetInstance(context.Background(), id)
+		if err == nil {
+			return fmt.Errorf("should not find Linode ID %d existing after delete", id)
+		}
+
+		if !linodego.IsNotFound(err) {
+			return fmt.Errorf("Error getting Linode ID %d: %s", id, err)
+		}
+	}
+
+	return acceptance.CheckInstanceDestroy(s)
+}
@@ -0,0 +1,40 @@
+package tmpl
+
+import (
+	"testing"
+
+	"github.com/linode/terraform-provider-linode/v2/linode/acceptance"
+)
+
+type TemplateData struct {
+	Label    string
+	Region   string
+	RootPass string
+}
+
+func Basic(t testing.TB, label, region, rootPass string) string {
+	return acceptance.ExecuteTemplate(t,
+		"instance_clone_basic", TemplateData{
+			Label:    label,
+			Region:   region,
+			RootPass: rootPass,
+		})
+}
+
+func Disks(t testing.TB, label, region, rootPass string) string {
+	return acceptance.ExecuteTemplate(t,
+		"instance_clone_disks", TemplateData{
+			Label:    label,
+			Region:   region,
+			RootPass: rootPass,
+		})
+}
+
+func Target(t testing.TB, label, region, rootPass string) string {
+	return acceptance.ExecuteTemplate(t,
+		"instance_clone_target", TemplateData{
+			Label:    label,
+			Region:   region,
+			RootPass: rootPass,
+		})
+}
@@ -310,6 +310,51 @@ func applyBootStatus(ctx context.Context, client *linodego.Client, linodeID int,
 	return err
 }
 
+// applyRescueStatus boots the Linode into or out of rescue mode according to the
+// configured rescue value. It returns true if the boot status has been handled
+// and no further boot actions should be taken.
+func applyRescueStatus(ctx context.Context, client *linodego.Client, d *schema.ResourceData,
+	linodeID int, configID int, timeoutSeconds int,
+) (bool, error) {
+	// We should not use `HasChange(...)` here because of possible mid-apply changes
+	if d.GetRawConfig().GetAttr("rescue").IsNull() {
+		return false, nil
+	}
+
+	inRescue, err := helper.IsInstanceInRescueMode(ctx, client, linodeID)
+	if err != nil {
+		return false, fmt.Errorf("failed to check whether instance is in rescue mode: %s", err)
+	}
+
+	if d.Get("rescue").(bool) {
+		// Instance is already in desired state
+		if inRescue && !d.HasChange("rescue_device") {
+			return true, nil
+		}
+
+		var devices linodego.InstanceConfigDeviceMap
+		if rescueDevices := expandDevicesBlock(d.Get("rescue_device")); rescueDevices != nil {
+			devices = *rescueDevices
+		}
+
+		return true, helper.RescueInstanceSync(ctx, client, linodeID, devices, timeoutSeconds)
+	}
+
+	if !inRescue {
+		return false, nil
+	}
+
+	tflog.Info(ctx, "Instance is in rescue mode; returning it to its config")
+
+	// Leaving rescue mode boots the config unless it is explicitly not booted
+	bootedNull := d.GetRawConfig().GetAttr("booted").IsNull()
+	if !bootedNull && !d.Get("booted").(bool) {
+		return true, helper.ShutDownInstanceSync(ctx, client, linodeID, timeoutSeconds)
+	}
+
+	return true, applyBootStatus(ctx, client, linodeID, configID, timeoutSeconds, true, false)
+}
+
 func isConfigBooted(
 	ctx context.Context,
 	client *linodego.Client,
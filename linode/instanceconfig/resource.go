@@ -101,6 +101,16 @@ func readResource(ctx context.Context, d *schema.ResourceData, meta any) diag.Di
 		return diag.Errorf("failed to check instance boot status: %s", err)
 	}
 
+	// Rescue mode isn't tied to a config, so it is only tracked
+	// for the config that requested it.
+	inRescue := false
+	if d.Get("rescue").(bool) {
+		inRescue, err = helper.IsInstanceInRescueMode(ctx, &client, linodeID)
+		if err != nil {
+			return diag.Errorf("failed to check instance rescue status: %s", err)
+		}
+	}
+
 	d.Set("linode_id", linodeID)
 	d.Set("label", cfg.Label)
 	d.Set("comments", cfg.Comments)
@@ -111,6 +121,7 @@ func readResource(ctx context.Context, d *schema.ResourceData, meta any) diag.Di
 	d.Set("virt_mode", cfg.VirtMode)
 	d.Set("interface", helper.FlattenInterfaces(cfg.Interfaces))
 	d.Set("booted", configBooted)
+	d.Set("rescue", inRescue)
 
 	if cfg.Devices != nil {
 		d.Set("devices", flattenDeviceMapToNamedBlock(*cfg.Devices))
@@ -176,7 +187,12 @@ func createResource(ctx context.Context, d *schema.ResourceData, meta any) diag.
 
 	d.SetId(strconv.Itoa(cfg.ID))
 
-	if !d.GetRawConfig().GetAttr("booted").IsNull() {
+	rescueHandled, err := applyRescueStatus(ctx, &client, d, linodeID, cfg.ID, helper.GetDeadlineSeconds(ctx, d))
+	if err != nil {
+		return diag.Errorf("failed to update rescue status: %s", err)
+	}
+
+	if !rescueHandled && !d.GetRawConfig().GetAttr("booted").IsNull() {
 		if err := applyBootStatus(ctx, &client, linodeID, cfg.ID, helper.GetDeadlineSeconds(ctx, d),
 			d.Get("booted").(bool), false); err != nil {
 			return diag.Errorf("failed to update boot status: %s", err)
@@ -308,14 +324,21 @@ func updateResource(ctx context.Context, d *schema.ResourceData, meta any) diag.
 		}
 
 		if shouldPowerBackOn {
-			instancehelpers.BootInstanceAfterVPCInterfaceUpdate(
-				ctx, meta.(*helper.ProviderMeta), linodeID, id, helper.GetDeadlineSeconds(ctx, d),
-			)
+			if err := instancehelpers.BootInstanceAfterVPCInterfaceUpdate(
+				ctx, &client, linodeID, id, helper.GetDeadlineSeconds(ctx, d),
+			); err != nil {
+				return diag.FromErr(err)
+			}
 		}
 	}
 
+	rescueHandled, err := applyRescueStatus(ctx, &client, d, linodeID, id, helper.GetDeadlineSeconds(ctx, d))
+	if err != nil {
+		return diag.Errorf("failed to update rescue status: %s", err)
+	}
+
 	shouldReboot := isBootedConfig && shouldUpdate && !powerOffRequired && !meta.(*helper.ProviderMeta).Config.SkipImplicitReboots
-	if managedBoot {
+	if managedBoot && !rescueHandled {
 		if err := applyBootStatus(ctx, &client, linodeID, id,
 			helper.GetDeadlineSeconds(ctx, d),
 			d.Get("booted").(bool),
@@ -523,6 +523,68 @@ func TestAccResourceInstanceConfig_rescueBooted(t *testing.T) {
 	})
 }
 
+func TestAccResourceInstanceConfig_rescue(t *testing.T) {
+	t.Parallel()
+
+	var instance linodego.Instance
+
+	resName := "linode_instance_config.foobar"
+	instanceName := acctest.RandomWithPrefix("tf_test")
+	rootPass := acctest.RandString(64)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acceptance.PreCheck(t) },
+		ProtoV5ProviderFactories: acceptance.ProtoV5ProviderFactories,
+		CheckDestroy:             checkDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: tmpl.Rescue(t, instanceName, testRegion, true, rootPass),
+				Check: resource.ComposeTestCheckFunc(
+					acceptance.CheckInstanceExists("linode_instance.foobar", &instance),
+					checkExists(resName, nil),
+					checkRescueMode(&instance, true),
+					resource.TestCheckResourceAttr(resName, "rescue", "true"),
+					resource.TestCheckResourceAttr(resName, "rescue_device.#", "1"),
+				),
+			},
+			{
+				Config: tmpl.Rescue(t, instanceName, testRegion, false, rootPass),
+				Check: resource.ComposeTestCheckFunc(
+					acceptance.CheckInstanceExists("linode_instance.foobar", &instance),
+					checkExists(resName, nil),
+					checkRescueMode(&instance, false),
+					resource.TestCheckResourceAttr(resName, "rescue", "false"),
+					resource.TestCheckResourceAttr(resName, "booted", "true"),
+				),
+			},
+			{
+				ResourceName:            resName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateIdFunc:       resourceImportStateID,
+				ImportStateVerifyIgnore: []string{"device", "rescue_device"},
+			},
+		},
+	})
+}
+
+func checkRescueMode(instance *linodego.Instance, expected bool) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		client := acceptance.TestAccProvider.Meta().(*helper.ProviderMeta).Client
+
+		inRescue, err := helper.IsInstanceInRescueMode(context.Background(), &client, instance.ID)
+		if err != nil {
+			return err
+		}
+
+		if inRescue != expected {
+			return fmt.Errorf("expected instance rescue mode to be %t, got %t", expected, inRescue)
+		}
+
+		return nil
+	}
+}
+
 func checkExists(name string, config *linodego.InstanceConfig) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		client := acceptance.TestAccProvider.Meta().(*helper.ProviderMeta).Client
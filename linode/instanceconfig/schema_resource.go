@@ -49,6 +49,21 @@ var resourceSchema = map[string]*schema.Schema{
 		Description: "If true, the Linode will be booted to running state. " +
 			"If false, the Linode will be shutdown. If undefined, no action will be taken.",
 	},
+	"rescue": {
+		Type:     schema.TypeBool,
+		Optional: true,
+		Computed: true,
+		Description: "If true, the Linode will be booted into rescue mode. " +
+			"If false and the Linode is in rescue mode, it will be returned to this config. " +
+			"If undefined, no action will be taken.",
+	},
+	"rescue_device": {
+		Type:         schema.TypeSet,
+		Elem:         &schema.Resource{Schema: rescueDeviceSchema},
+		Optional:     true,
+		RequiredWith: []string{"rescue"},
+		Description:  "Blocks for the disks and volumes to attach to the Linode when booted into rescue mode.",
+	},
 	"comments": {
 		Type:        schema.TypeString,
 		Optional:    true,
@@ -194,6 +209,25 @@ var deviceV2Schema = map[string]*schema.Schema{
 	},
 }
 
+var rescueDeviceSchema = map[string]*schema.Schema{
+	"device_name": {
+		Type:        schema.TypeString,
+		Required:    true,
+		Description: "The device slot to map the disk or volume to. sdh is reserved for the rescue image.",
+		ValidateDiagFunc: validation.ToDiagFunc(
+			validation.StringInSlice(
+				[]string{
+					"sda", "sdb", "sdc", "sdd",
+					"sde", "sdf", "sdg",
+				},
+				false,
+			),
+		),
+	},
+	"disk_id":   deviceV2Schema["disk_id"],
+	"volume_id": deviceV2Schema["volume_id"],
+}
+
 var deviceSchema = map[string]*schema.Schema{
 	"disk_id": {
 		Type:        schema.TypeInt,
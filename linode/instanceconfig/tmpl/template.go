@@ -9,6 +9,7 @@ import (
 type TemplateData struct {
 	Label    string
 	Booted   bool
+	Rescue   bool
 	Swap     bool
 	Region   string
 	RootPass string
@@ -51,6 +52,16 @@ func Booted(t testing.TB, label, region string, booted bool, rootPass string) st
 		})
 }
 
+func Rescue(t testing.TB, label, region string, rescue bool, rootPass string) string {
+	return acceptance.ExecuteTemplate(t,
+		"instance_config_rescue", TemplateData{
+			Label:    label,
+			Rescue:   rescue,
+			Region:   region,
+			RootPass: rootPass,
+		})
+}
+
 func BootedSwap(t testing.TB, label, region string, swap bool, rootPass string) string {
 	return acceptance.ExecuteTemplate(t,
 		"instance_config_booted_swap", TemplateData{
@@ -11,6 +11,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/linode/linodego"
@@ -44,6 +45,149 @@ type Resource struct {
 	helper.BaseResource
 }
 
+func (r *Resource) ModifyPlan(
+	ctx context.Context,
+	req resource.ModifyPlanRequest,
+	resp *resource.ModifyPlanResponse,
+) {
+	// Nothing to validate when the resource is being destroyed
+	// or the provider hasn't been configured yet
+	if req.Plan.Raw.IsNull() || r.Meta == nil {
+		return
+	}
+
+	var plan ResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if plan.LinodeID.IsUnknown() || plan.Size.IsUnknown() {
+		return
+	}
+
+	var state *ResourceModel
+	if !req.State.Raw.IsNull() {
+		state = new(ResourceModel)
+		resp.Diagnostics.Append(req.State.Get(ctx, state)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+
+		if state.Size.Equal(plan.Size) {
+			return
+		}
+	}
+
+	linodeID := helper.FrameworkSafeInt64ToInt(plan.LinodeID.ValueInt64(), &resp.Diagnostics)
+	size := helper.FrameworkSafeInt64ToInt(plan.Size.ValueInt64(), &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	client := r.Meta.Client
+
+	instance, err := client.GetInstance(ctx, linodeID)
+	if err != nil {
+		// The instance may be planned for replacement
+		if linodego.IsNotFound(err) {
+			return
+		}
+
+		resp.Diagnostics.AddError(
+			fmt.Sprintf("Failed to Get Linode Instance %d", linodeID), err.Error(),
+		)
+		return
+	}
+
+	typ, err := client.GetType(ctx, instance.Type)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			fmt.Sprintf("Failed to Get Linode Type %s", instance.Type), err.Error(),
+		)
+		return
+	}
+
+	// New disks inherit the disk encryption of their instance
+	if state == nil && instance.DiskEncryption != "" {
+		if plan.DiskEncryption.IsUnknown() {
+			resp.Diagnostics.Append(resp.Plan.SetAttribute(
+				ctx, path.Root("disk_encryption"), types.StringValue(string(instance.DiskEncryption)),
+			)...)
+		}
+
+		if instance.DiskEncryption == linodego.InstanceDiskEncryptionEnabled {
+			helper.ValidateRegionCapabilities(ctx, client, instance.Region, []helper.RegionCapabilityRequirement{
+				{
+					Capability: linodego.CapabilityDiskEncryption,
+					Path:       path.Root("linode_id"),
+					Reason:     fmt.Sprintf("the disk encryption of Linode instance %d", linodeID),
+				},
+			}, &resp.Diagnostics)
+			if resp.Diagnostics.HasError() {
+				return
+			}
+		}
+	}
+
+	disks, err := client.ListInstanceDisks(ctx, linodeID, nil)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			fmt.Sprintf("Failed to List Disks of Linode Instance %d", linodeID), err.Error(),
+		)
+		return
+	}
+
+	usedSpace := size
+	for _, disk := range disks {
+		if state != nil && strconv.Itoa(disk.ID) == state.ID.ValueString() {
+			continue
+		}
+		usedSpace += disk.Size
+	}
+
+	if typ.Disk < usedSpace {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("size"),
+			"Insufficient Disk Capacity",
+			fmt.Sprintf(
+				"Linode type %s of instance %d has insufficient disk capacity for this disk. Have %d; want %d",
+				typ.Label, linodeID, typ.Disk, usedSpace,
+			),
+		)
+		return
+	}
+
+	// Resizing a disk requires the instance to be shut down
+	if state == nil || !helper.IsInstanceInBootedState(instance.Status) {
+		return
+	}
+
+	if r.Meta.Config.SkipImplicitReboots.ValueBool() {
+		resp.Diagnostics.AddAttributeWarning(
+			path.Root("size"),
+			"Linode Instance Shutdown Required",
+			fmt.Sprintf(
+				"Resizing this disk requires Linode instance %d to be shut down, "+
+					"which is not done implicitly while 'skip_implicit_reboots' is enabled. "+
+					"The apply will fail unless the instance is shut down beforehand.",
+				linodeID,
+			),
+		)
+		return
+	}
+
+	resp.Diagnostics.AddAttributeWarning(
+		path.Root("size"),
+		"Linode Instance Will Be Shut Down",
+		fmt.Sprintf(
+			"Resizing this disk will shut down Linode instance %d and boot it back up "+
+				"once the resize is complete.",
+			linodeID,
+		),
+	)
+}
+
 func (r *Resource) Create(
 	ctx context.Context,
 	req resource.CreateRequest,
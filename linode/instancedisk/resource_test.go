@@ -6,6 +6,7 @@ import (
 	"context"
 	"fmt"
 	"log"
+	"regexp"
 	"strconv"
 	"testing"
 
@@ -103,6 +104,34 @@ func TestAccResourceInstanceDisk_basic_smoke(t *testing.T) {
 	})
 }
 
+func TestAccResourceInstanceDisk_exceedsTypeCapacity(t *testing.T) {
+	t.Parallel()
+
+	resName := "linode_instance_disk.foobar"
+	label := acctest.RandomWithPrefix("tf_test")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acceptance.PreCheck(t) },
+		ProtoV5ProviderFactories: acceptance.ProtoV5ProviderFactories,
+		CheckDestroy:             checkDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: tmpl.Basic(t, label, testRegion, 2048),
+				Check: resource.ComposeTestCheckFunc(
+					checkExists(resName, nil),
+					resource.TestCheckResourceAttr(resName, "size", "2048"),
+				),
+			},
+			// The disk no longer fits the Linode type and should be rejected at plan time
+			{
+				Config:      tmpl.Basic(t, label, testRegion, 1024000),
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile("Insufficient Disk Capacity"),
+			},
+		},
+	})
+}
+
 func TestAccResourceInstanceDisk_complex(t *testing.T) {
 	t.Parallel()
 
@@ -0,0 +1,217 @@
+package instanceinterface
+
+import (
+	"context"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/linode/linodego"
+	"github.com/linode/terraform-provider-linode/v2/linode/helper"
+)
+
+type ResourceModel struct {
+	ID          types.String   `tfsdk:"id"`
+	LinodeID    types.Int64    `tfsdk:"linode_id"`
+	ConfigID    types.Int64    `tfsdk:"config_id"`
+	Purpose     types.String   `tfsdk:"purpose"`
+	Label       types.String   `tfsdk:"label"`
+	IPAMAddress types.String   `tfsdk:"ipam_address"`
+	SubnetID    types.Int64    `tfsdk:"subnet_id"`
+	VPCID       types.Int64    `tfsdk:"vpc_id"`
+	Primary     types.Bool     `tfsdk:"primary"`
+	Active      types.Bool     `tfsdk:"active"`
+	IPRanges    types.List     `tfsdk:"ip_ranges"`
+	Position    types.Int64    `tfsdk:"position"`
+	IPv4        []IPv4Model    `tfsdk:"ipv4"`
+	Timeouts    timeouts.Value `tfsdk:"timeouts"`
+}
+
+type IPv4Model struct {
+	VPC     types.String `tfsdk:"vpc"`
+	NAT1To1 types.String `tfsdk:"nat_1_1"`
+}
+
+// FlattenInterface flattens the interface at the given position of the interfaces of a config.
+// The IPv4 block is only refreshed if it is tracked.
+func (data *ResourceModel) FlattenInterface(
+	ctx context.Context,
+	linodeID, configID int,
+	iface linodego.InstanceConfigInterface,
+	position int,
+	preserveKnown bool,
+	diags *diag.Diagnostics,
+) {
+	data.ID = helper.KeepOrUpdateString(data.ID, strconv.Itoa(iface.ID), preserveKnown)
+	data.LinodeID = helper.KeepOrUpdateInt64(data.LinodeID, int64(linodeID), preserveKnown)
+	data.ConfigID = helper.KeepOrUpdateInt64(data.ConfigID, int64(configID), preserveKnown)
+
+	// The purpose is validated case-insensitively
+	if !strings.EqualFold(data.Purpose.ValueString(), string(iface.Purpose)) {
+		data.Purpose = helper.KeepOrUpdateString(data.Purpose, string(iface.Purpose), preserveKnown)
+	}
+
+	data.Label = helper.KeepOrUpdateValue(data.Label, stringOrNull(iface.Label), preserveKnown)
+	data.IPAMAddress = helper.KeepOrUpdateValue(data.IPAMAddress, stringOrNull(iface.IPAMAddress), preserveKnown)
+	data.SubnetID = helper.KeepOrUpdateIntPointer(data.SubnetID, iface.SubnetID, preserveKnown)
+	data.VPCID = helper.KeepOrUpdateIntPointer(data.VPCID, iface.VPCID, preserveKnown)
+	data.Primary = helper.KeepOrUpdateBool(data.Primary, iface.Primary, preserveKnown)
+	data.Active = helper.KeepOrUpdateBool(data.Active, iface.Active, preserveKnown)
+	data.Position = helper.KeepOrUpdateInt64(data.Position, int64(position), preserveKnown)
+
+	if len(iface.IPRanges) > 0 {
+		ipRanges, d := types.ListValueFrom(ctx, types.StringType, iface.IPRanges)
+		diags.Append(d...)
+		data.IPRanges = helper.KeepOrUpdateValue(data.IPRanges, ipRanges, preserveKnown)
+	} else if data.IPRanges.IsUnknown() || len(data.IPRanges.Elements()) > 0 {
+		// Keep an explicitly configured empty list of ranges
+		data.IPRanges = helper.KeepOrUpdateValue(data.IPRanges, types.ListNull(types.StringType), preserveKnown)
+	}
+
+	if len(data.IPv4) == 0 {
+		return
+	}
+
+	vpc, nat := types.StringNull(), types.StringNull()
+	if iface.IPv4 != nil {
+		vpc = types.StringValue(iface.IPv4.VPC)
+		nat = types.StringPointerValue(iface.IPv4.NAT1To1)
+	}
+
+	ipv4 := data.IPv4[0]
+	ipv4.VPC = helper.KeepOrUpdateValue(ipv4.VPC, vpc, preserveKnown)
+
+	// A configured "any" address stays as is as long as any address is assigned
+	if ipv4.NAT1To1.ValueString() != "any" || nat.ValueString() == "" {
+		ipv4.NAT1To1 = helper.KeepOrUpdateValue(ipv4.NAT1To1, nat, preserveKnown)
+	}
+
+	data.IPv4 = []IPv4Model{ipv4}
+}
+
+// ExpandCreateOptions expands the model into the options to append the interface to a config.
+func (data *ResourceModel) ExpandCreateOptions(
+	ctx context.Context, diags *diag.Diagnostics,
+) linodego.InstanceConfigInterfaceCreateOptions {
+	result := linodego.InstanceConfigInterfaceCreateOptions{
+		Purpose:     data.purpose(),
+		Primary:     data.Primary.ValueBool(),
+		Label:       data.Label.ValueString(),
+		IPAMAddress: data.IPAMAddress.ValueString(),
+		IPv4:        data.expandIPv4(),
+	}
+
+	if !data.SubnetID.IsNull() {
+		subnetID := helper.FrameworkSafeInt64ToInt(data.SubnetID.ValueInt64(), diags)
+		result.SubnetID = &subnetID
+	}
+
+	// Keep the ranges nil rather than an empty slice when there is no range
+	if len(data.IPRanges.Elements()) > 0 {
+		diags.Append(data.IPRanges.ElementsAs(ctx, &result.IPRanges, false)...)
+	}
+
+	return result
+}
+
+// ExpandUpdateOptions expands the model into the options to update the interface,
+// keeping the current IPv4 configuration where it isn't specified.
+func (data *ResourceModel) ExpandUpdateOptions(
+	ctx context.Context, current *linodego.InstanceConfigInterface, diags *diag.Diagnostics,
+) linodego.InstanceConfigInterfaceUpdateOptions {
+	result := linodego.InstanceConfigInterfaceUpdateOptions{
+		Primary: data.Primary.ValueBool(),
+	}
+
+	if data.purpose() != linodego.InterfacePurposeVPC {
+		return result
+	}
+
+	ipRanges := make([]string, 0)
+	if len(data.IPRanges.Elements()) > 0 {
+		diags.Append(data.IPRanges.ElementsAs(ctx, &ipRanges, false)...)
+	}
+	result.IPRanges = &ipRanges
+
+	result.IPv4 = data.expandIPv4()
+	if current.IPv4 == nil {
+		return result
+	}
+
+	if result.IPv4 == nil {
+		result.IPv4 = &linodego.VPCIPv4{VPC: current.IPv4.VPC, NAT1To1: current.IPv4.NAT1To1}
+		return result
+	}
+
+	if result.IPv4.VPC == "" {
+		result.IPv4.VPC = current.IPv4.VPC
+	}
+
+	if nat := result.IPv4.NAT1To1; nat != nil && *nat == "any" &&
+		current.IPv4.NAT1To1 != nil && *current.IPv4.NAT1To1 != "" {
+		result.IPv4.NAT1To1 = current.IPv4.NAT1To1
+	}
+
+	return result
+}
+
+func (data *ResourceModel) purpose() linodego.ConfigInterfacePurpose {
+	return linodego.ConfigInterfacePurpose(strings.ToLower(data.Purpose.ValueString()))
+}
+
+func (data *ResourceModel) expandIPv4() *linodego.VPCIPv4 {
+	if len(data.IPv4) == 0 {
+		return nil
+	}
+
+	vpc, nat := data.IPv4[0].VPC.ValueString(), data.IPv4[0].NAT1To1.ValueString()
+	if vpc == "" && nat == "" {
+		return nil
+	}
+
+	result := &linodego.VPCIPv4{VPC: vpc}
+	if nat != "" {
+		result.NAT1To1 = &nat
+	}
+
+	return result
+}
+
+// findInterface returns the interface with the given ID and its position in the given interfaces.
+func findInterface(
+	interfaces []linodego.InstanceConfigInterface, id int,
+) (*linodego.InstanceConfigInterface, int) {
+	position := slices.IndexFunc(interfaces, func(iface linodego.InstanceConfigInterface) bool {
+		return iface.ID == id
+	})
+	if position < 0 {
+		return nil, -1
+	}
+
+	return &interfaces[position], position
+}
+
+// moveInterface returns the IDs of the given interfaces with the interface
+// of the given ID moved to the given position.
+func moveInterface(interfaces []linodego.InstanceConfigInterface, id, position int) []int {
+	result := make([]int, 0, len(interfaces))
+	for _, iface := range interfaces {
+		if iface.ID != id {
+			result = append(result, iface.ID)
+		}
+	}
+
+	position = min(position, len(result))
+
+	return slices.Insert(result, position, id)
+}
+
+func stringOrNull(value string) types.String {
+	if value == "" {
+		return types.StringNull()
+	}
+	return types.StringValue(value)
+}
@@ -0,0 +1,205 @@
+//go:build unit
+
+package instanceinterface
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/linode/linodego"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestFlattenInterface(t *testing.T) {
+	iface := linodego.InstanceConfigInterface{
+		ID:       456,
+		Purpose:  linodego.InterfacePurposeVPC,
+		Primary:  true,
+		Active:   true,
+		VPCID:    linodego.Pointer(10),
+		SubnetID: linodego.Pointer(20),
+		IPv4: &linodego.VPCIPv4{
+			VPC:     "10.0.4.250",
+			NAT1To1: linodego.Pointer("192.0.2.10"),
+		},
+		IPRanges: []string{"10.0.4.101/32"},
+	}
+
+	var data ResourceModel
+	var diags diag.Diagnostics
+
+	data.FlattenInterface(context.Background(), 123, 789, iface, 1, false, &diags)
+	require.False(t, diags.HasError(), diags.Errors())
+
+	assert.Equal(t, "456", data.ID.ValueString())
+	assert.Equal(t, int64(123), data.LinodeID.ValueInt64())
+	assert.Equal(t, int64(789), data.ConfigID.ValueInt64())
+	assert.Equal(t, "vpc", data.Purpose.ValueString())
+	assert.True(t, data.Label.IsNull())
+	assert.True(t, data.IPAMAddress.IsNull())
+	assert.Equal(t, int64(20), data.SubnetID.ValueInt64())
+	assert.Equal(t, int64(10), data.VPCID.ValueInt64())
+	assert.True(t, data.Primary.ValueBool())
+	assert.True(t, data.Active.ValueBool())
+	assert.Equal(t, int64(1), data.Position.ValueInt64())
+	assert.Len(t, data.IPRanges.Elements(), 1)
+
+	// The IPv4 block isn't tracked unless configured
+	assert.Empty(t, data.IPv4)
+}
+
+func TestFlattenInterface_preserveKnown(t *testing.T) {
+	iface := linodego.InstanceConfigInterface{
+		ID:      456,
+		Purpose: linodego.InterfacePurposeVPC,
+		VPCID:   linodego.Pointer(10),
+		IPv4: &linodego.VPCIPv4{
+			VPC:     "10.0.4.250",
+			NAT1To1: linodego.Pointer("192.0.2.10"),
+		},
+	}
+
+	data := ResourceModel{
+		ID:       types.StringUnknown(),
+		Purpose:  types.StringValue("VPC"),
+		Primary:  types.BoolValue(false),
+		Active:   types.BoolUnknown(),
+		VPCID:    types.Int64Unknown(),
+		Position: types.Int64Unknown(),
+		IPRanges: types.ListNull(types.StringType),
+		IPv4: []IPv4Model{
+			{
+				VPC:     types.StringUnknown(),
+				NAT1To1: types.StringValue("any"),
+			},
+		},
+	}
+
+	var diags diag.Diagnostics
+
+	data.FlattenInterface(context.Background(), 123, 789, iface, 0, true, &diags)
+	require.False(t, diags.HasError(), diags.Errors())
+
+	assert.Equal(t, "456", data.ID.ValueString())
+	assert.Equal(t, "VPC", data.Purpose.ValueString())
+	assert.False(t, data.Active.ValueBool())
+	assert.Equal(t, int64(10), data.VPCID.ValueInt64())
+	assert.Equal(t, int64(0), data.Position.ValueInt64())
+	assert.Equal(t, "10.0.4.250", data.IPv4[0].VPC.ValueString())
+	assert.Equal(t, "any", data.IPv4[0].NAT1To1.ValueString())
+}
+
+func TestExpandCreateOptions(t *testing.T) {
+	ipRanges, _ := types.ListValueFrom(context.Background(), types.StringType, []string{"10.0.4.101/32"})
+
+	data := ResourceModel{
+		Purpose:  types.StringValue("VPC"),
+		Primary:  types.BoolValue(true),
+		SubnetID: types.Int64Value(20),
+		IPRanges: ipRanges,
+		IPv4: []IPv4Model{
+			{
+				VPC:     types.StringNull(),
+				NAT1To1: types.StringValue("any"),
+			},
+		},
+	}
+
+	var diags diag.Diagnostics
+
+	opts := data.ExpandCreateOptions(context.Background(), &diags)
+	require.False(t, diags.HasError(), diags.Errors())
+
+	assert.Equal(t, linodego.InterfacePurposeVPC, opts.Purpose)
+	assert.True(t, opts.Primary)
+	assert.Equal(t, 20, *opts.SubnetID)
+	assert.Equal(t, []string{"10.0.4.101/32"}, opts.IPRanges)
+	assert.Equal(t, "", opts.IPv4.VPC)
+	assert.Equal(t, "any", *opts.IPv4.NAT1To1)
+
+	vlan := ResourceModel{
+		Purpose:     types.StringValue("vlan"),
+		Label:       types.StringValue("my-vlan"),
+		IPAMAddress: types.StringValue("10.0.0.1/24"),
+		SubnetID:    types.Int64Null(),
+		IPRanges:    types.ListNull(types.StringType),
+	}
+
+	opts = vlan.ExpandCreateOptions(context.Background(), &diags)
+	require.False(t, diags.HasError(), diags.Errors())
+
+	assert.Equal(t, "my-vlan", opts.Label)
+	assert.Equal(t, "10.0.0.1/24", opts.IPAMAddress)
+	assert.Nil(t, opts.SubnetID)
+	assert.Nil(t, opts.IPRanges)
+	assert.Nil(t, opts.IPv4)
+}
+
+func TestExpandUpdateOptions(t *testing.T) {
+	current := &linodego.InstanceConfigInterface{
+		ID:      456,
+		Purpose: linodego.InterfacePurposeVPC,
+		IPv4: &linodego.VPCIPv4{
+			VPC:     "10.0.4.250",
+			NAT1To1: linodego.Pointer("192.0.2.10"),
+		},
+		IPRanges: []string{"10.0.4.101/32"},
+	}
+
+	data := ResourceModel{
+		Purpose:  types.StringValue("vpc"),
+		Primary:  types.BoolValue(true),
+		IPRanges: types.ListNull(types.StringType),
+		IPv4: []IPv4Model{
+			{
+				VPC:     types.StringValue(""),
+				NAT1To1: types.StringValue("any"),
+			},
+		},
+	}
+
+	var diags diag.Diagnostics
+
+	opts := data.ExpandUpdateOptions(context.Background(), current, &diags)
+	require.False(t, diags.HasError(), diags.Errors())
+
+	assert.True(t, opts.Primary)
+
+	// Removed ranges are cleared explicitly
+	require.NotNil(t, opts.IPRanges)
+	assert.Empty(t, *opts.IPRanges)
+
+	// Unspecified addresses keep the current addresses
+	assert.Equal(t, "10.0.4.250", opts.IPv4.VPC)
+	assert.Equal(t, "192.0.2.10", *opts.IPv4.NAT1To1)
+
+	public := ResourceModel{
+		Purpose:  types.StringValue("public"),
+		Primary:  types.BoolValue(false),
+		IPRanges: types.ListNull(types.StringType),
+	}
+
+	opts = public.ExpandUpdateOptions(context.Background(), &linodego.InstanceConfigInterface{}, &diags)
+	assert.Nil(t, opts.IPRanges)
+	assert.Nil(t, opts.IPv4)
+}
+
+func TestMoveInterface(t *testing.T) {
+	interfaces := []linodego.InstanceConfigInterface{{ID: 1}, {ID: 2}, {ID: 3}}
+
+	assert.Equal(t, []int{3, 1, 2}, moveInterface(interfaces, 3, 0))
+	assert.Equal(t, []int{2, 1, 3}, moveInterface(interfaces, 1, 1))
+	assert.Equal(t, []int{2, 3, 1}, moveInterface(interfaces, 1, 2))
+	assert.Equal(t, []int{1, 2, 3}, moveInterface(interfaces, 2, 1))
+
+	iface, position := findInterface(interfaces, 2)
+	require.NotNil(t, iface)
+	assert.Equal(t, 1, position)
+
+	iface, position = findInterface(interfaces, 4)
+	assert.Nil(t, iface)
+	assert.Equal(t, -1, position)
+}
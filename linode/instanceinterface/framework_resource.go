@@ -0,0 +1,675 @@
+package instanceinterface
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/linode/linodego"
+	"github.com/linode/terraform-provider-linode/v2/linode/helper"
+	"github.com/linode/terraform-provider-linode/v2/linode/instance"
+)
+
+const (
+	DefaultInterfaceCreateTimeout = 15 * time.Minute
+	DefaultInterfaceUpdateTimeout = 15 * time.Minute
+	DefaultInterfaceDeleteTimeout = 15 * time.Minute
+)
+
+func NewResource() resource.Resource {
+	return &Resource{
+		BaseResource: helper.NewBaseResource(
+			helper.BaseResourceConfig{
+				Name:   "linode_instance_interface",
+				IDType: types.StringType,
+				Schema: &frameworkResourceSchema,
+				TimeoutOpts: &timeouts.Opts{
+					Create: true,
+					Update: true,
+					Delete: true,
+				},
+			},
+		),
+	}
+}
+
+type Resource struct {
+	helper.BaseResource
+}
+
+func (r *Resource) ValidateConfig(
+	ctx context.Context,
+	req resource.ValidateConfigRequest,
+	resp *resource.ValidateConfigResponse,
+) {
+	var purpose types.String
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("purpose"), &purpose)...)
+	if resp.Diagnostics.HasError() || purpose.IsUnknown() || purpose.IsNull() {
+		return
+	}
+
+	purposeName := strings.ToLower(purpose.ValueString())
+
+	validateAttributes := func(paths []path.Path, allowedPurpose linodego.ConfigInterfacePurpose) {
+		for _, attrPath := range paths {
+			var value attr.Value
+			resp.Diagnostics.Append(req.Config.GetAttribute(ctx, attrPath, &value)...)
+
+			if purposeName != string(allowedPurpose) && configured(value) {
+				resp.Diagnostics.AddAttributeError(
+					attrPath,
+					"Invalid Attribute Combination",
+					fmt.Sprintf("%s is only allowed with %s interfaces", attrPath, allowedPurpose),
+				)
+			}
+		}
+	}
+
+	validateAttributes(vlanOnlyAttributes, linodego.InterfacePurposeVLAN)
+	validateAttributes(vpcOnlyAttributes, linodego.InterfacePurposeVPC)
+
+	requiredAttributes := map[linodego.ConfigInterfacePurpose]path.Path{
+		linodego.InterfacePurposeVLAN: path.Root("label"),
+		linodego.InterfacePurposeVPC:  path.Root("subnet_id"),
+	}
+
+	if attrPath, ok := requiredAttributes[linodego.ConfigInterfacePurpose(purposeName)]; ok {
+		var value attr.Value
+		resp.Diagnostics.Append(req.Config.GetAttribute(ctx, attrPath, &value)...)
+
+		if value != nil && value.IsNull() {
+			resp.Diagnostics.AddAttributeError(
+				attrPath,
+				"Missing Required Attribute",
+				fmt.Sprintf("%s is required for %s interfaces", attrPath, purposeName),
+			)
+		}
+	}
+}
+
+// configured returns whether the given config value is set, treating empty blocks as unset.
+func configured(value attr.Value) bool {
+	if value == nil || value.IsNull() {
+		return false
+	}
+
+	if list, ok := value.(types.List); ok {
+		return list.IsUnknown() || len(list.Elements()) > 0
+	}
+
+	return true
+}
+
+func (r *Resource) Create(
+	ctx context.Context,
+	req resource.CreateRequest,
+	resp *resource.CreateResponse,
+) {
+	tflog.Debug(ctx, "Create "+r.Config.Name)
+
+	var plan ResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	createTimeout, diags := plan.Timeouts.Create(ctx, DefaultInterfaceCreateTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, createTimeout)
+	defer cancel()
+
+	timeoutSeconds := helper.FrameworkSafeFloat64ToInt(createTimeout.Seconds(), &resp.Diagnostics)
+	linodeID := helper.FrameworkSafeInt64ToInt(plan.LinodeID.ValueInt64(), &resp.Diagnostics)
+	configID := helper.FrameworkSafeInt64ToInt(plan.ConfigID.ValueInt64(), &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx = helper.SetLogFieldBulk(ctx, map[string]any{
+		"linode_id": linodeID,
+		"config_id": configID,
+	})
+
+	client := r.Meta.Client
+
+	createOpts := plan.ExpandCreateOptions(ctx, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var created *linodego.InstanceConfigInterface
+
+	r.applyChange(ctx, &plan, timeoutSeconds, func(config *linodego.InstanceConfig) error {
+		position := len(config.Interfaces)
+		if !plan.Position.IsUnknown() {
+			position = helper.FrameworkSafeInt64ToInt(plan.Position.ValueInt64(), &resp.Diagnostics)
+		}
+
+		if position > len(config.Interfaces) {
+			return &positionOutOfRangeError{
+				position: position, configID: configID, interfaces: len(config.Interfaces),
+			}
+		}
+
+		tflog.Debug(ctx, "client.AppendInstanceConfigInterface(...)", map[string]any{
+			"options": createOpts,
+		})
+
+		iface, err := client.AppendInstanceConfigInterface(ctx, linodeID, configID, createOpts)
+		if err != nil {
+			return fmt.Errorf("failed to append interface: %w", err)
+		}
+
+		created = iface
+
+		if position == len(config.Interfaces) {
+			return nil
+		}
+
+		return reorderInterfaces(
+			ctx, client, linodeID, configID,
+			moveInterface(append(config.Interfaces, *iface), iface.ID, position),
+		)
+	}, "Failed to Create Linode Instance Interface", &resp.Diagnostics)
+
+	if created == nil {
+		return
+	}
+
+	// Track the interface right after its creation to prevent
+	// it from leaking when the remaining steps fail
+	plan.ID = types.StringValue(strconv.Itoa(created.ID))
+	if resp.Diagnostics.HasError() {
+		resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), plan.ID)...)
+		resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("linode_id"), plan.LinodeID)...)
+		resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("config_id"), plan.ConfigID)...)
+		return
+	}
+
+	r.refresh(ctx, &plan, true, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *Resource) Read(
+	ctx context.Context,
+	req resource.ReadRequest,
+	resp *resource.ReadResponse,
+) {
+	tflog.Debug(ctx, "Read "+r.Config.Name)
+
+	var state ResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if helper.FrameworkAttemptRemoveResourceForEmptyID(ctx, state.ID, resp) {
+		return
+	}
+
+	ctx = populateLogAttributes(ctx, state)
+
+	if !r.refresh(ctx, &state, false, &resp.Diagnostics) {
+		resp.Diagnostics.AddWarning(
+			"Linode Instance Interface Not Found",
+			fmt.Sprintf(
+				"Removing interface %s of config %d from state because it no longer exists",
+				state.ID.ValueString(), state.ConfigID.ValueInt64(),
+			),
+		)
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+func (r *Resource) Update(
+	ctx context.Context,
+	req resource.UpdateRequest,
+	resp *resource.UpdateResponse,
+) {
+	tflog.Debug(ctx, "Update "+r.Config.Name)
+
+	var plan, state ResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx = populateLogAttributes(ctx, state)
+
+	updateTimeout, diags := plan.Timeouts.Update(ctx, DefaultInterfaceUpdateTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, updateTimeout)
+	defer cancel()
+
+	timeoutSeconds := helper.FrameworkSafeFloat64ToInt(updateTimeout.Seconds(), &resp.Diagnostics)
+	linodeID := helper.FrameworkSafeInt64ToInt(state.LinodeID.ValueInt64(), &resp.Diagnostics)
+	configID := helper.FrameworkSafeInt64ToInt(state.ConfigID.ValueInt64(), &resp.Diagnostics)
+	id := helper.FrameworkSafeStringToInt(state.ID.ValueString(), &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	client := r.Meta.Client
+
+	shouldUpdate := !plan.Primary.Equal(state.Primary) ||
+		!plan.IPRanges.Equal(state.IPRanges) ||
+		!ipv4Equal(plan.IPv4, state.IPv4)
+	shouldReorder := !plan.Position.IsUnknown() && !plan.Position.Equal(state.Position)
+
+	if shouldUpdate || shouldReorder {
+		r.applyChange(ctx, &plan, timeoutSeconds, func(config *linodego.InstanceConfig) error {
+			current, _ := findInterface(config.Interfaces, id)
+			if current == nil {
+				return fmt.Errorf("interface %d no longer exists in config %d", id, configID)
+			}
+
+			if shouldUpdate {
+				updateOpts := plan.ExpandUpdateOptions(ctx, current, &resp.Diagnostics)
+
+				tflog.Debug(ctx, "client.UpdateInstanceConfigInterface(...)", map[string]any{
+					"options": updateOpts,
+				})
+
+				if _, err := client.UpdateInstanceConfigInterface(
+					ctx, linodeID, configID, id, updateOpts,
+				); err != nil {
+					return fmt.Errorf("failed to update interface: %w", err)
+				}
+			}
+
+			if !shouldReorder {
+				return nil
+			}
+
+			position := helper.FrameworkSafeInt64ToInt(plan.Position.ValueInt64(), &resp.Diagnostics)
+			if position >= len(config.Interfaces) {
+				return fmt.Errorf(
+					"position %d is out of range for config %d with %d interfaces",
+					position, configID, len(config.Interfaces),
+				)
+			}
+
+			return reorderInterfaces(
+				ctx, client, linodeID, configID, moveInterface(config.Interfaces, id, position),
+			)
+		}, "Failed to Update Linode Instance Interface", &resp.Diagnostics)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+
+	plan.ID = state.ID
+
+	if !r.refresh(ctx, &plan, true, &resp.Diagnostics) {
+		resp.Diagnostics.AddError(
+			"Linode Instance Interface Not Found",
+			fmt.Sprintf("Interface %d no longer exists in config %d", id, configID),
+		)
+	}
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *Resource) Delete(
+	ctx context.Context,
+	req resource.DeleteRequest,
+	resp *resource.DeleteResponse,
+) {
+	tflog.Debug(ctx, "Delete "+r.Config.Name)
+
+	var state ResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx = populateLogAttributes(ctx, state)
+
+	deleteTimeout, diags := state.Timeouts.Delete(ctx, DefaultInterfaceDeleteTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, deleteTimeout)
+	defer cancel()
+
+	timeoutSeconds := helper.FrameworkSafeFloat64ToInt(deleteTimeout.Seconds(), &resp.Diagnostics)
+	linodeID := helper.FrameworkSafeInt64ToInt(state.LinodeID.ValueInt64(), &resp.Diagnostics)
+	configID := helper.FrameworkSafeInt64ToInt(state.ConfigID.ValueInt64(), &resp.Diagnostics)
+	id := helper.FrameworkSafeStringToInt(state.ID.ValueString(), &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	client := r.Meta.Client
+
+	r.applyChange(ctx, &state, timeoutSeconds, func(config *linodego.InstanceConfig) error {
+		if current, _ := findInterface(config.Interfaces, id); current == nil {
+			return nil
+		}
+
+		tflog.Debug(ctx, "client.DeleteInstanceConfigInterface(...)")
+
+		if err := client.DeleteInstanceConfigInterface(ctx, linodeID, configID, id); err != nil {
+			if !linodego.IsNotFound(err) {
+				return fmt.Errorf("failed to delete interface: %w", err)
+			}
+		}
+
+		return nil
+	}, "Failed to Delete Linode Instance Interface", &resp.Diagnostics)
+}
+
+func (r *Resource) ImportState(
+	ctx context.Context,
+	req resource.ImportStateRequest,
+	resp *resource.ImportStateResponse,
+) {
+	tflog.Debug(ctx, "Import "+r.Config.Name)
+
+	helper.ImportStateWithMultipleIDs(
+		ctx,
+		req,
+		resp,
+		[]helper.ImportableID{
+			{
+				Name:          "linode_id",
+				TypeConverter: helper.IDTypeConverterInt64,
+			},
+			{
+				Name:          "config_id",
+				TypeConverter: helper.IDTypeConverterInt64,
+			},
+			{
+				Name:          "id",
+				TypeConverter: helper.IDTypeConverterString,
+			},
+		},
+	)
+}
+
+// refresh refreshes the given model from the config of the interface,
+// returning false if the config or the interface no longer exists.
+func (r *Resource) refresh(
+	ctx context.Context,
+	data *ResourceModel,
+	preserveKnown bool,
+	diags *diag.Diagnostics,
+) bool {
+	linodeID := helper.FrameworkSafeInt64ToInt(data.LinodeID.ValueInt64(), diags)
+	configID := helper.FrameworkSafeInt64ToInt(data.ConfigID.ValueInt64(), diags)
+	id := helper.FrameworkSafeStringToInt(data.ID.ValueString(), diags)
+	if diags.HasError() {
+		return true
+	}
+
+	config, err := r.Meta.Client.GetInstanceConfig(ctx, linodeID, configID)
+	if err != nil {
+		if linodego.IsNotFound(err) {
+			return false
+		}
+
+		diags.AddError(
+			fmt.Sprintf("Failed to Get Config %d of Linode Instance %d", configID, linodeID),
+			err.Error(),
+		)
+		return true
+	}
+
+	iface, position := findInterface(config.Interfaces, id)
+	if iface == nil {
+		return false
+	}
+
+	data.FlattenInterface(ctx, linodeID, configID, *iface, position, preserveKnown, diags)
+
+	return true
+}
+
+// applyChange applies the given change to the interfaces of a config as part of the power
+// cycle batch of its Linode. Changes involving VPC interfaces shut down a Linode running
+// the config beforehand and other changes reboot it afterwards, which is only done once
+// the last of the concurrent interface changes to the Linode has been applied.
+func (r *Resource) applyChange(
+	ctx context.Context,
+	data *ResourceModel,
+	deadlineSeconds int,
+	change func(config *linodego.InstanceConfig) error,
+	errorSummary string,
+	diags *diag.Diagnostics,
+) {
+	linodeID := helper.FrameworkSafeInt64ToInt(data.LinodeID.ValueInt64(), diags)
+	configID := helper.FrameworkSafeInt64ToInt(data.ConfigID.ValueInt64(), diags)
+	if diags.HasError() {
+		return
+	}
+
+	batch := batches.join(linodeID)
+
+	if err := r.applyChangeInBatch(ctx, batch, data, linodeID, configID, deadlineSeconds, change, diags); err != nil {
+		diags.AddError(errorSummary, err.Error())
+	}
+
+	if !batches.leave(ctx, linodeID, batch) {
+		return
+	}
+
+	r.completePowerCycle(ctx, batch, linodeID, deadlineSeconds, diags)
+}
+
+func (r *Resource) applyChangeInBatch(
+	ctx context.Context,
+	batch *powerCycleBatch,
+	data *ResourceModel,
+	linodeID, configID int,
+	deadlineSeconds int,
+	change func(config *linodego.InstanceConfig) error,
+	diags *diag.Diagnostics,
+) error {
+	client := r.Meta.Client
+	skipImplicitReboots := r.Meta.Config.SkipImplicitReboots.ValueBool()
+
+	batch.mu.Lock()
+	defer batch.mu.Unlock()
+	defer batch.finishChange()
+
+	if !batch.inspected {
+		status, err := helper.WaitForInstanceNonTransientStatus(ctx, client, linodeID, deadlineSeconds)
+		if err != nil {
+			return fmt.Errorf(
+				"failed waiting for instance %d to be in running or offline state: %w", linodeID, err,
+			)
+		}
+
+		batch.running = status == linodego.InstanceRunning
+
+		if batch.running {
+			bootedConfigID, err := helper.GetCurrentBootedConfig(ctx, client, linodeID)
+			if err != nil {
+				tflog.Warn(ctx, fmt.Sprintf("failed to get current booted config of Linode %d", linodeID))
+			}
+
+			batch.bootedConfigID = bootedConfigID
+		}
+
+		batch.inspected = true
+	}
+
+	config, err := client.GetInstanceConfig(ctx, linodeID, configID)
+	if err != nil {
+		return fmt.Errorf("failed to get config %d: %w", configID, err)
+	}
+
+	affectsRunningConfig := batch.affectsRunningConfig(configID)
+
+	powerOffRequired := affectsRunningConfig && instance.VPCInterfaceIncluded(
+		config.Interfaces,
+		[]linodego.InstanceConfigInterfaceCreateOptions{{Purpose: data.purpose()}},
+	)
+
+	if powerOffRequired && !batch.shutDown {
+		if err := instance.ShutdownInstanceForVPCInterfaceUpdate(
+			ctx, client, skipImplicitReboots, linodeID, deadlineSeconds,
+		); err != nil {
+			return fmt.Errorf("failed to shutdown linode instance for VPC interface update: %w", err)
+		}
+
+		batch.shutDown = true
+	}
+
+	// Interfaces at a position beyond the current interfaces of the config
+	// wait for the interfaces at the lower positions of the same batch
+	for {
+		err := change(config)
+
+		var positionErr *positionOutOfRangeError
+		if !errors.As(err, &positionErr) {
+			if err != nil {
+				return err
+			}
+			break
+		}
+
+		if !batch.waitForChange(ctx) {
+			return err
+		}
+
+		if config, err = client.GetInstanceConfig(ctx, linodeID, configID); err != nil {
+			return fmt.Errorf("failed to get config %d: %w", configID, err)
+		}
+	}
+
+	if !affectsRunningConfig || powerOffRequired {
+		return nil
+	}
+
+	if skipImplicitReboots {
+		diags.AddWarning(
+			"Linode Instance Reboot Required",
+			fmt.Sprintf(
+				"The interface change takes effect once Linode instance %d is rebooted, "+
+					"which is not done implicitly while 'skip_implicit_reboots' is enabled.",
+				linodeID,
+			),
+		)
+		return nil
+	}
+
+	batch.reboot = true
+
+	return nil
+}
+
+// completePowerCycle boots or reboots the Linode once all interface changes of the batch have been applied.
+func (r *Resource) completePowerCycle(
+	ctx context.Context,
+	batch *powerCycleBatch,
+	linodeID int,
+	deadlineSeconds int,
+	diags *diag.Diagnostics,
+) {
+	client := r.Meta.Client
+
+	batch.mu.Lock()
+	defer batch.mu.Unlock()
+
+	switch {
+	case batch.shutDown:
+		if err := instance.BootInstanceAfterVPCInterfaceUpdate(
+			ctx, client, linodeID, batch.bootedConfigID, deadlineSeconds,
+		); err != nil {
+			diags.AddError("Failed to Boot Linode Instance", err.Error())
+		}
+	case batch.reboot:
+		tflog.Info(ctx, "Rebooting instance to apply interface changes")
+		diags.Append(helper.FrameworkRebootInstance(ctx, linodeID, client, batch.bootedConfigID)...)
+	}
+}
+
+// positionOutOfRangeError is returned when an interface is created at a position
+// beyond the current interfaces of the config.
+type positionOutOfRangeError struct {
+	position   int
+	configID   int
+	interfaces int
+}
+
+func (e *positionOutOfRangeError) Error() string {
+	return fmt.Sprintf(
+		"position %d is out of range for config %d with %d interfaces, "+
+			"the position must not be greater than the number of interfaces of the config",
+		e.position, e.configID, e.interfaces,
+	)
+}
+
+func reorderInterfaces(
+	ctx context.Context, client *linodego.Client, linodeID, configID int, ids []int,
+) error {
+	opts := linodego.InstanceConfigInterfacesReorderOptions{IDs: ids}
+
+	tflog.Debug(ctx, "client.ReorderInstanceConfigInterfaces(...)", map[string]any{
+		"options": opts,
+	})
+
+	if err := client.ReorderInstanceConfigInterfaces(ctx, linodeID, configID, opts); err != nil {
+		return fmt.Errorf("failed to reorder interfaces: %w", err)
+	}
+
+	return nil
+}
+
+func ipv4Equal(a, b []IPv4Model) bool {
+	if len(a) != len(b) {
+		return false
+	}
+
+	for i := range a {
+		if !a[i].VPC.Equal(b[i].VPC) || !a[i].NAT1To1.Equal(b[i].NAT1To1) {
+			return false
+		}
+	}
+
+	return true
+}
+
+func populateLogAttributes(ctx context.Context, data ResourceModel) context.Context {
+	return helper.SetLogFieldBulk(ctx, map[string]any{
+		"linode_id": data.LinodeID.ValueInt64(),
+		"config_id": data.ConfigID.ValueInt64(),
+		"id":        data.ID.ValueString(),
+	})
+}
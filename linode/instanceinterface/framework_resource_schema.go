@@ -0,0 +1,148 @@
+package instanceinterface
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/linode/terraform-provider-linode/v2/linode/helper"
+)
+
+var frameworkResourceSchema = schema.Schema{
+	Attributes: map[string]schema.Attribute{
+		"id": schema.StringAttribute{
+			Description: "The ID of the interface.",
+			Computed:    true,
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.UseStateForUnknown(),
+			},
+		},
+		"linode_id": schema.Int64Attribute{
+			Description: "The ID of the Linode the config belongs to.",
+			Required:    true,
+			PlanModifiers: []planmodifier.Int64{
+				int64planmodifier.RequiresReplace(),
+			},
+		},
+		"config_id": schema.Int64Attribute{
+			Description: "The ID of the config to add the interface to.",
+			Required:    true,
+			PlanModifiers: []planmodifier.Int64{
+				int64planmodifier.RequiresReplace(),
+			},
+		},
+		"purpose": schema.StringAttribute{
+			Description: "The type of interface.",
+			Required:    true,
+			Validators: []validator.String{
+				stringvalidator.OneOfCaseInsensitive("public", "vlan", "vpc"),
+			},
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.RequiresReplace(),
+			},
+		},
+		"label": schema.StringAttribute{
+			Description: "The name of the VLAN. Required for and only allowed with VLAN interfaces.",
+			Optional:    true,
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.RequiresReplace(),
+			},
+		},
+		"ipam_address": schema.StringAttribute{
+			Description: "The private IP address of the VLAN interface in CIDR notation. " +
+				"Only allowed with VLAN interfaces.",
+			Optional: true,
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.RequiresReplace(),
+			},
+		},
+		"subnet_id": schema.Int64Attribute{
+			Description: "The ID of the subnet the VPC interface is connected to. " +
+				"Required for and only allowed with VPC interfaces.",
+			Optional: true,
+			PlanModifiers: []planmodifier.Int64{
+				int64planmodifier.RequiresReplace(),
+			},
+		},
+		"vpc_id": schema.Int64Attribute{
+			Description: "The ID of the VPC of the subnet the VPC interface is connected to.",
+			Computed:    true,
+			PlanModifiers: []planmodifier.Int64{
+				int64planmodifier.UseStateForUnknown(),
+			},
+		},
+		"primary": schema.BoolAttribute{
+			Description: "Whether the interface is the primary interface that should " +
+				"have the default route for the Linode.",
+			Optional: true,
+			Computed: true,
+			Default:  booldefault.StaticBool(false),
+		},
+		"active": schema.BoolAttribute{
+			Description: "Whether the interface is currently booted and active.",
+			Computed:    true,
+		},
+		"ip_ranges": schema.ListAttribute{
+			Description: "IPv4 ranges inside the VPC subnet to route to the VPC interface.",
+			ElementType: types.StringType,
+			Optional:    true,
+			Validators: []validator.List{
+				listvalidator.ValueStringsAre(helper.IPRangeValidator()),
+			},
+		},
+		"position": schema.Int64Attribute{
+			Description: "The position of the interface in the interfaces of the config, " +
+				"starting from 0 for eth0. If not specified, new interfaces are appended.",
+			Optional: true,
+			Computed: true,
+			Validators: []validator.Int64{
+				int64validator.AtLeast(0),
+			},
+			PlanModifiers: []planmodifier.Int64{
+				int64planmodifier.UseStateForUnknown(),
+			},
+		},
+	},
+	Blocks: map[string]schema.Block{
+		"ipv4": schema.ListNestedBlock{
+			Description: "The IPv4 configuration of the VPC interface. Only allowed with VPC interfaces.",
+			Validators: []validator.List{
+				listvalidator.SizeAtMost(1),
+			},
+			NestedObject: schema.NestedBlockObject{
+				Attributes: map[string]schema.Attribute{
+					"vpc": schema.StringAttribute{
+						Description: "The IP from the VPC subnet to use for the interface.",
+						Optional:    true,
+						Computed:    true,
+						PlanModifiers: []planmodifier.String{
+							stringplanmodifier.UseStateForUnknown(),
+						},
+					},
+					"nat_1_1": schema.StringAttribute{
+						Description: "The public IP that will be used for the one-to-one NAT purpose, " +
+							"or any to assign one.",
+						Optional: true,
+						Computed: true,
+						PlanModifiers: []planmodifier.String{
+							stringplanmodifier.UseStateForUnknown(),
+						},
+					},
+				},
+			},
+		},
+	},
+}
+
+// vlanOnlyAttributes and vpcOnlyAttributes are validated against the purpose of the interface.
+var (
+	vlanOnlyAttributes = []path.Path{path.Root("label"), path.Root("ipam_address")}
+	vpcOnlyAttributes  = []path.Path{path.Root("subnet_id"), path.Root("ip_ranges"), path.Root("ipv4")}
+)
@@ -0,0 +1,154 @@
+package instanceinterface
+
+import (
+	"context"
+	"sync"
+	"sync/atomic"
+	"time"
+)
+
+// powerCycleSettleDelay is how long the last interface change of a batch waits for
+// further changes to the same Linode before power cycling it, so that the interfaces
+// applied concurrently within a single apply share one power cycle.
+var powerCycleSettleDelay = 5 * time.Second
+
+// powerCycleBatch tracks the power cycle of a Linode across concurrent interface changes.
+type powerCycleBatch struct {
+	// mu serializes the interface changes and power operations on the Linode
+	mu sync.Mutex
+
+	// applied is broadcast whenever a change of the batch has been applied, using mu
+	applied *sync.Cond
+
+	// outstanding is the number of changes that may still be applied to the Linode
+	outstanding atomic.Int32
+
+	// pending is the number of changes in the batch, guarded by powerCycleBatches.mu
+	pending int
+
+	// leader identifies the change that completes the batch, guarded by powerCycleBatches.mu
+	leader int
+
+	inspected      bool
+	running        bool
+	bootedConfigID int
+
+	shutDown bool
+	reboot   bool
+}
+
+func newPowerCycleBatch() *powerCycleBatch {
+	batch := &powerCycleBatch{}
+	batch.applied = sync.NewCond(&batch.mu)
+
+	return batch
+}
+
+// affectsRunningConfig returns whether the given config is the config the Linode was running
+// when the batch was started, which requires a power cycle for interface changes to take effect.
+func (b *powerCycleBatch) affectsRunningConfig(configID int) bool {
+	return b.running && b.bootedConfigID == configID
+}
+
+func (b *powerCycleBatch) needsPowerCycle() bool {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	return b.shutDown || b.reboot
+}
+
+// finishChange marks a change of the batch as applied and wakes up
+// the changes waiting for it. It must be called with mu held.
+func (b *powerCycleBatch) finishChange() {
+	b.outstanding.Add(-1)
+	b.applied.Broadcast()
+}
+
+// waitForChange waits for another change of the batch to be applied, e.g. an interface
+// at a lower position. It returns false without waiting if no other change may still be
+// applied, or once the context is done. It must be called with mu held.
+func (b *powerCycleBatch) waitForChange(ctx context.Context) bool {
+	if b.outstanding.Add(-1) == 0 {
+		b.outstanding.Add(1)
+		return false
+	}
+
+	stop := context.AfterFunc(ctx, func() {
+		b.mu.Lock()
+		defer b.mu.Unlock()
+
+		b.applied.Broadcast()
+	})
+	defer stop()
+
+	b.applied.Wait()
+	b.outstanding.Add(1)
+
+	return ctx.Err() == nil
+}
+
+type powerCycleBatches struct {
+	mu      sync.Mutex
+	batches map[int]*powerCycleBatch
+}
+
+var batches = &powerCycleBatches{
+	batches: make(map[int]*powerCycleBatch),
+}
+
+// join adds a change to the power cycle batch of the given Linode, starting a new batch if needed.
+func (m *powerCycleBatches) join(linodeID int) *powerCycleBatch {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	batch, ok := m.batches[linodeID]
+	if !ok {
+		batch = newPowerCycleBatch()
+		m.batches[linodeID] = batch
+	}
+
+	batch.pending++
+	batch.outstanding.Add(1)
+
+	return batch
+}
+
+// leave removes a change from the power cycle batch of the given Linode and returns whether
+// the caller completes the power cycle of the batch.
+//
+// The last change of a batch that needs a power cycle waits for further changes to join the
+// batch first. A change that joins in the meantime takes over the completion of the batch.
+func (m *powerCycleBatches) leave(ctx context.Context, linodeID int, batch *powerCycleBatch) bool {
+	m.mu.Lock()
+	batch.pending--
+	if batch.pending > 0 {
+		m.mu.Unlock()
+		return false
+	}
+
+	batch.leader++
+	leader := batch.leader
+	m.mu.Unlock()
+
+	if batch.needsPowerCycle() {
+		timer := time.NewTimer(powerCycleSettleDelay)
+		select {
+		case <-timer.C:
+		case <-ctx.Done():
+			timer.Stop()
+		}
+	}
+
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	if batch.pending > 0 || batch.leader != leader {
+		return false
+	}
+
+	if m.batches[linodeID] == batch {
+		delete(m.batches, linodeID)
+	}
+
+	return true
+}
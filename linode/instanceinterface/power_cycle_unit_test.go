@@ -0,0 +1,114 @@
+//go:build unit
+
+package instanceinterface
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPowerCycleBatches(t *testing.T) {
+	ctx := context.Background()
+	powerCycleSettleDelay = 10 * time.Millisecond
+
+	m := &powerCycleBatches{batches: make(map[int]*powerCycleBatch)}
+
+	first := m.join(123)
+	second := m.join(123)
+	other := m.join(456)
+
+	// Concurrent changes to the same Linode share a batch
+	assert.Same(t, first, second)
+	assert.NotSame(t, first, other)
+
+	first.mu.Lock()
+	first.reboot = true
+	first.mu.Unlock()
+
+	assert.False(t, m.leave(ctx, 123, first))
+	assert.True(t, m.leave(ctx, 123, second))
+	assert.True(t, m.leave(ctx, 456, other))
+
+	// A completed batch isn't reused by later changes
+	assert.NotSame(t, first, m.join(123))
+}
+
+func TestPowerCycleBatches_handOver(t *testing.T) {
+	ctx := context.Background()
+	powerCycleSettleDelay = 100 * time.Millisecond
+
+	m := &powerCycleBatches{batches: make(map[int]*powerCycleBatch)}
+
+	first := m.join(123)
+	first.reboot = true
+
+	leader := make(chan bool)
+	go func() {
+		leader <- m.leave(ctx, 123, first)
+	}()
+
+	// A change joining while the last change waits takes over the batch
+	time.Sleep(powerCycleSettleDelay / 2)
+	second := m.join(123)
+	assert.Same(t, first, second)
+
+	assert.True(t, m.leave(ctx, 123, second))
+	assert.False(t, <-leader)
+}
+
+func TestPowerCycleBatches_canceled(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	powerCycleSettleDelay = time.Hour
+
+	m := &powerCycleBatches{batches: make(map[int]*powerCycleBatch)}
+
+	batch := m.join(123)
+	batch.reboot = true
+
+	// The settle delay ends with the context
+	assert.True(t, m.leave(ctx, 123, batch))
+}
+
+func TestPowerCycleBatch_waitForChange(t *testing.T) {
+	ctx := context.Background()
+	m := &powerCycleBatches{batches: make(map[int]*powerCycleBatch)}
+
+	waiting := m.join(123)
+	other := m.join(123)
+
+	waited := make(chan bool)
+	go func() {
+		waiting.mu.Lock()
+		defer waiting.mu.Unlock()
+		defer waiting.finishChange()
+
+		// The other change may still be applied
+		first := waiting.waitForChange(ctx)
+
+		// No other change may be applied anymore
+		waited <- first && !waiting.waitForChange(ctx)
+	}()
+
+	time.Sleep(10 * time.Millisecond)
+
+	other.mu.Lock()
+	other.finishChange()
+	other.mu.Unlock()
+
+	assert.True(t, <-waited)
+}
+
+func TestPowerCycleBatch_affectsRunningConfig(t *testing.T) {
+	batch := &powerCycleBatch{running: true, bootedConfigID: 789}
+
+	assert.True(t, batch.affectsRunningConfig(789))
+	assert.False(t, batch.affectsRunningConfig(790))
+
+	batch.running = false
+	assert.False(t, batch.affectsRunningConfig(789))
+}
@@ -0,0 +1,103 @@
+//go:build integration || instanceinterface
+
+package instanceinterface_test
+
+import (
+	"fmt"
+	"log"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/linode/terraform-provider-linode/v2/linode/acceptance"
+	"github.com/linode/terraform-provider-linode/v2/linode/instanceinterface/tmpl"
+)
+
+const (
+	testPublicResName = "linode_instance_interface.public"
+	testVPCResName    = "linode_instance_interface.vpc"
+)
+
+var testRegion string
+
+func init() {
+	region, err := acceptance.GetRandomRegionWithCaps([]string{"Linodes", "VPCs"}, "core")
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	testRegion = region
+}
+
+func TestAccResourceInstanceInterface_basic(t *testing.T) {
+	t.Parallel()
+
+	label := acctest.RandomWithPrefix("tf-test")
+	rootPass := acctest.RandString(64)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acceptance.PreCheck(t) },
+		ProtoV5ProviderFactories: acceptance.ProtoV5ProviderFactories,
+		CheckDestroy:             acceptance.CheckInstanceDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: tmpl.Basic(t, label, testRegion, rootPass),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet(testPublicResName, "id"),
+					resource.TestCheckResourceAttr(testPublicResName, "purpose", "public"),
+					resource.TestCheckResourceAttr(testPublicResName, "primary", "true"),
+					resource.TestCheckResourceAttr(testPublicResName, "position", "0"),
+					resource.TestCheckResourceAttr(testPublicResName, "active", "true"),
+
+					resource.TestCheckResourceAttr(testVPCResName, "purpose", "vpc"),
+					resource.TestCheckResourceAttr(testVPCResName, "position", "1"),
+					resource.TestCheckResourceAttr(testVPCResName, "ipv4.0.vpc", "10.0.4.250"),
+					resource.TestCheckResourceAttrSet(testVPCResName, "ipv4.0.nat_1_1"),
+					resource.TestCheckResourceAttr(testVPCResName, "ip_ranges.0", "10.0.4.101/32"),
+					resource.TestCheckResourceAttrPair(testVPCResName, "vpc_id", "linode_vpc.foobar", "id"),
+					resource.TestCheckResourceAttr(testVPCResName, "active", "true"),
+				),
+			},
+			// Swapping the interfaces reorders them in place
+			{
+				Config: tmpl.Updates(t, label, testRegion, rootPass),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(testPublicResName, "position", "1"),
+					resource.TestCheckResourceAttr(testPublicResName, "primary", "false"),
+
+					resource.TestCheckResourceAttr(testVPCResName, "position", "0"),
+					resource.TestCheckResourceAttr(testVPCResName, "primary", "true"),
+					resource.TestCheckResourceAttr(testVPCResName, "ipv4.0.vpc", "10.0.4.251"),
+					resource.TestCheckNoResourceAttr(testVPCResName, "ip_ranges.#"),
+				),
+			},
+			{
+				ResourceName:      testVPCResName,
+				ImportState:       true,
+				ImportStateIdFunc: importStateID(testVPCResName),
+				ImportStateVerify: true,
+				ImportStateVerifyIgnore: []string{
+					"ipv4",
+					"timeouts",
+				},
+			},
+		},
+	})
+}
+
+func importStateID(resName string) resource.ImportStateIdFunc {
+	return func(s *terraform.State) (string, error) {
+		rs, ok := s.RootModule().Resources[resName]
+		if !ok {
+			return "", fmt.Errorf("resource not found: %s", resName)
+		}
+
+		return fmt.Sprintf(
+			"%s,%s,%s",
+			rs.Primary.Attributes["linode_id"],
+			rs.Primary.Attributes["config_id"],
+			rs.Primary.ID,
+		), nil
+	}
+}
@@ -0,0 +1,77 @@
+package instancemaintenance
+
+import (
+	"strconv"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/linode/linodego"
+	"github.com/linode/terraform-provider-linode/v2/linode/helper"
+)
+
+type ResourceModel struct {
+	ID                 types.String   `tfsdk:"id"`
+	LinodeID           types.Int64    `tfsdk:"linode_id"`
+	MaintenancePolicy  types.String   `tfsdk:"maintenance_policy"`
+	MigratePending     types.Bool     `tfsdk:"migrate_pending"`
+	MigrationType      types.String   `tfsdk:"migration_type"`
+	PendingMaintenance types.List     `tfsdk:"pending_maintenance"`
+	Timeouts           timeouts.Value `tfsdk:"timeouts"`
+}
+
+type MaintenanceModel struct {
+	Type   types.String `tfsdk:"type"`
+	Status types.String `tfsdk:"status"`
+	Reason types.String `tfsdk:"reason"`
+	When   types.String `tfsdk:"when"`
+}
+
+func (data *ResourceModel) FlattenMaintenance(
+	linodeID int,
+	policy string,
+	maintenances []linodego.AccountMaintenance,
+	preserveKnown bool,
+	diags *diag.Diagnostics,
+) {
+	data.ID = helper.KeepOrUpdateString(data.ID, strconv.Itoa(linodeID), preserveKnown)
+	data.LinodeID = helper.KeepOrUpdateInt64(data.LinodeID, int64(linodeID), preserveKnown)
+
+	// The maintenance policy isn't returned for Linodes that don't support it
+	if policy != "" {
+		data.MaintenancePolicy = helper.KeepOrUpdateString(data.MaintenancePolicy, policy, preserveKnown)
+	} else if data.MaintenancePolicy.IsUnknown() {
+		data.MaintenancePolicy = types.StringNull()
+	}
+
+	maintenanceValues := make([]attr.Value, len(maintenances))
+	for i, maintenance := range maintenances {
+		when := types.StringNull()
+		if maintenance.When != nil {
+			when = types.StringValue(maintenance.When.Format(time.RFC3339))
+		}
+
+		maintenanceValue, newDiags := types.ObjectValue(maintenanceObjectType.AttrTypes, map[string]attr.Value{
+			"type":   types.StringValue(maintenance.Type),
+			"status": types.StringValue(maintenance.Status),
+			"reason": types.StringValue(maintenance.Reason),
+			"when":   when,
+		})
+		diags.Append(newDiags...)
+		if diags.HasError() {
+			return
+		}
+
+		maintenanceValues[i] = maintenanceValue
+	}
+
+	pendingMaintenance, newDiags := types.ListValue(maintenanceObjectType, maintenanceValues)
+	diags.Append(newDiags...)
+	if diags.HasError() {
+		return
+	}
+
+	data.PendingMaintenance = helper.KeepOrUpdateValue(data.PendingMaintenance, pendingMaintenance, preserveKnown)
+}
@@ -0,0 +1,80 @@
+//go:build unit
+
+package instancemaintenance
+
+import (
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/linode/linodego"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestFlattenMaintenance(t *testing.T) {
+	when := time.Date(2024, time.March, 1, 12, 0, 0, 0, time.UTC)
+
+	maintenances := []linodego.AccountMaintenance{
+		{
+			Entity: &linodego.Entity{ID: 123, Type: "linode"},
+			Reason: "Host hardware upgrade",
+			Status: "scheduled",
+			Type:   "migrate",
+			When:   &when,
+		},
+	}
+
+	data := ResourceModel{
+		MaintenancePolicy: types.StringUnknown(),
+	}
+	var diags diag.Diagnostics
+	data.FlattenMaintenance(123, "linode/migrate", maintenances, false, &diags)
+	assert.False(t, diags.HasError())
+
+	assert.Equal(t, types.StringValue("123"), data.ID)
+	assert.Equal(t, types.Int64Value(123), data.LinodeID)
+	assert.Equal(t, types.StringValue("linode/migrate"), data.MaintenancePolicy)
+	assert.Len(t, data.PendingMaintenance.Elements(), 1)
+	assert.Contains(t, data.PendingMaintenance.String(), "Host hardware upgrade")
+	assert.Contains(t, data.PendingMaintenance.String(), "2024-03-01T12:00:00Z")
+
+	// The policy isn't returned for Linodes that don't support it
+	data = ResourceModel{
+		MaintenancePolicy: types.StringUnknown(),
+	}
+	data.FlattenMaintenance(123, "", nil, true, &diags)
+	assert.False(t, diags.HasError())
+
+	assert.True(t, data.MaintenancePolicy.IsNull())
+	assert.Empty(t, data.PendingMaintenance.Elements())
+}
+
+func TestFilterInstanceMaintenance(t *testing.T) {
+	maintenances := []linodego.AccountMaintenance{
+		{Entity: &linodego.Entity{ID: 123, Type: "linode"}, Type: "migrate", Status: "scheduled"},
+		{Entity: &linodego.Entity{ID: 123, Type: "linode"}, Type: "reboot", Status: "completed"},
+		{Entity: &linodego.Entity{ID: 456, Type: "linode"}, Type: "migrate", Status: "scheduled"},
+		{Entity: &linodego.Entity{ID: 123, Type: "volume"}, Type: "migrate", Status: "scheduled"},
+		{Type: "migrate", Status: "scheduled"},
+	}
+
+	result := filterInstanceMaintenance(maintenances, 123)
+
+	assert.Len(t, result, 1)
+	assert.Equal(t, maintenances[0], result[0])
+}
+
+func TestHasPendingMigration(t *testing.T) {
+	assert.True(t, hasPendingMigration([]linodego.AccountMaintenance{
+		{Type: "reboot", Status: "scheduled"},
+		{Type: "live_migration", Status: "pending"},
+	}))
+
+	assert.False(t, hasPendingMigration([]linodego.AccountMaintenance{
+		{Type: "reboot", Status: "scheduled"},
+		{Type: "migrate", Status: "started"},
+	}))
+
+	assert.False(t, hasPendingMigration(nil))
+}
@@ -0,0 +1,321 @@
+package instancemaintenance
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/linode/linodego"
+	"github.com/linode/terraform-provider-linode/v2/linode/helper"
+)
+
+const (
+	DefaultMaintenanceCreateTimeout = 30 * time.Minute
+	DefaultMaintenanceUpdateTimeout = 30 * time.Minute
+)
+
+func NewResource() resource.Resource {
+	return &Resource{
+		BaseResource: helper.NewBaseResource(
+			helper.BaseResourceConfig{
+				Name:   "linode_instance_maintenance",
+				IDType: types.StringType,
+				Schema: &frameworkResourceSchema,
+				TimeoutOpts: &timeouts.Opts{
+					Create: true,
+					Update: true,
+				},
+			},
+		),
+	}
+}
+
+type Resource struct {
+	helper.BaseResource
+}
+
+func (r *Resource) Create(
+	ctx context.Context,
+	req resource.CreateRequest,
+	resp *resource.CreateResponse,
+) {
+	tflog.Debug(ctx, "Create "+r.Config.Name)
+
+	var plan ResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	createTimeout, diags := plan.Timeouts.Create(ctx, DefaultMaintenanceCreateTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, createTimeout)
+	defer cancel()
+
+	timeoutSeconds := helper.FrameworkSafeFloat64ToInt(createTimeout.Seconds(), &resp.Diagnostics)
+	linodeID := helper.FrameworkSafeInt64ToInt(plan.LinodeID.ValueInt64(), &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx = tflog.SetField(ctx, "linode_id", linodeID)
+
+	r.apply(ctx, &plan, nil, linodeID, timeoutSeconds, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *Resource) Read(
+	ctx context.Context,
+	req resource.ReadRequest,
+	resp *resource.ReadResponse,
+) {
+	tflog.Debug(ctx, "Read "+r.Config.Name)
+
+	var state ResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if helper.FrameworkAttemptRemoveResourceForEmptyID(ctx, state.ID, resp) {
+		return
+	}
+
+	ctx = populateLogAttributes(ctx, state)
+
+	linodeID := helper.FrameworkSafeStringToInt(state.ID.ValueString(), &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	client := r.Meta.Client
+
+	policy, err := getMaintenancePolicy(ctx, client, linodeID)
+	if err != nil {
+		if linodego.IsNotFound(err) {
+			resp.Diagnostics.AddWarning(
+				"Linode Instance Not Found",
+				fmt.Sprintf(
+					"Removing maintenance settings of Linode Instance %d from state because it no longer exists",
+					linodeID,
+				),
+			)
+			resp.State.RemoveResource(ctx)
+			return
+		}
+
+		resp.Diagnostics.AddError(
+			fmt.Sprintf("Failed to Get Maintenance Policy of Linode Instance %d", linodeID),
+			err.Error(),
+		)
+		return
+	}
+
+	maintenances, err := listInstanceMaintenance(ctx, client, linodeID)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			fmt.Sprintf("Failed to List Maintenance of Linode Instance %d", linodeID),
+			err.Error(),
+		)
+		return
+	}
+
+	// The arguments that only affect the apply aren't set on import
+	if state.MigratePending.IsNull() {
+		state.MigratePending = types.BoolValue(false)
+	}
+	if state.MigrationType.IsNull() {
+		state.MigrationType = types.StringValue(string(linodego.WarmMigration))
+	}
+
+	state.FlattenMaintenance(linodeID, policy, maintenances, false, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+func (r *Resource) ModifyPlan(
+	ctx context.Context,
+	req resource.ModifyPlanRequest,
+	resp *resource.ModifyPlanResponse,
+) {
+	if req.Plan.Raw.IsNull() || req.State.Raw.IsNull() {
+		return
+	}
+
+	var plan, state ResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var pendingMaintenance []MaintenanceModel
+	if !state.PendingMaintenance.IsNull() {
+		resp.Diagnostics.Append(state.PendingMaintenance.ElementsAs(ctx, &pendingMaintenance, false)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+
+	maintenances := make([]linodego.AccountMaintenance, len(pendingMaintenance))
+	for i, maintenance := range pendingMaintenance {
+		maintenances[i] = linodego.AccountMaintenance{
+			Type:   maintenance.Type.ValueString(),
+			Status: maintenance.Status.ValueString(),
+		}
+	}
+
+	// Pending migrations are initiated by an update, which also refreshes the pending maintenance
+	if plan.MigratePending.ValueBool() && hasPendingMigration(maintenances) {
+		resp.Diagnostics.Append(resp.Plan.SetAttribute(
+			ctx, path.Root("pending_maintenance"), types.ListUnknown(maintenanceObjectType),
+		)...)
+	}
+}
+
+func (r *Resource) Update(
+	ctx context.Context,
+	req resource.UpdateRequest,
+	resp *resource.UpdateResponse,
+) {
+	tflog.Debug(ctx, "Update "+r.Config.Name)
+
+	var plan, state ResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx = populateLogAttributes(ctx, state)
+
+	updateTimeout, diags := plan.Timeouts.Update(ctx, DefaultMaintenanceUpdateTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, updateTimeout)
+	defer cancel()
+
+	timeoutSeconds := helper.FrameworkSafeFloat64ToInt(updateTimeout.Seconds(), &resp.Diagnostics)
+	linodeID := helper.FrameworkSafeInt64ToInt(plan.LinodeID.ValueInt64(), &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	r.apply(ctx, &plan, &state, linodeID, timeoutSeconds, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+// apply sets the maintenance policy of the Linode if it changed and initiates
+// its pending migrations if requested, then refreshes the given plan.
+func (r *Resource) apply(
+	ctx context.Context,
+	plan *ResourceModel,
+	state *ResourceModel,
+	linodeID int,
+	timeoutSeconds int,
+	diags *diag.Diagnostics,
+) {
+	client := r.Meta.Client
+
+	policy := plan.MaintenancePolicy
+	if !policy.IsUnknown() && !policy.IsNull() && (state == nil || !policy.Equal(state.MaintenancePolicy)) {
+		if err := updateMaintenancePolicy(ctx, client, linodeID, policy.ValueString()); err != nil {
+			diags.AddError(
+				fmt.Sprintf("Failed to Update Maintenance Policy of Linode Instance %d", linodeID),
+				err.Error(),
+			)
+			return
+		}
+	}
+
+	maintenances, err := listInstanceMaintenance(ctx, client, linodeID)
+	if err != nil {
+		diags.AddError(
+			fmt.Sprintf("Failed to List Maintenance of Linode Instance %d", linodeID),
+			err.Error(),
+		)
+		return
+	}
+
+	if plan.MigratePending.ValueBool() && hasPendingMigration(maintenances) {
+		tflog.Info(ctx, "Initiating pending host maintenance migration")
+
+		if err := helper.MigrateInstanceSync(ctx, client, linodeID, linodego.InstanceMigrateOptions{
+			Type: linodego.InstanceMigrationType(plan.MigrationType.ValueString()),
+		}, timeoutSeconds); err != nil {
+			diags.AddError(
+				fmt.Sprintf("Failed to Migrate Linode Instance %d", linodeID),
+				err.Error(),
+			)
+			return
+		}
+
+		if maintenances, err = listInstanceMaintenance(ctx, client, linodeID); err != nil {
+			diags.AddError(
+				fmt.Sprintf("Failed to List Maintenance of Linode Instance %d", linodeID),
+				err.Error(),
+			)
+			return
+		}
+	}
+
+	currentPolicy, err := getMaintenancePolicy(ctx, client, linodeID)
+	if err != nil {
+		diags.AddError(
+			fmt.Sprintf("Failed to Get Maintenance Policy of Linode Instance %d", linodeID),
+			err.Error(),
+		)
+		return
+	}
+
+	plan.FlattenMaintenance(linodeID, currentPolicy, maintenances, true, diags)
+}
+
+func (r *Resource) Delete(
+	ctx context.Context,
+	req resource.DeleteRequest,
+	resp *resource.DeleteResponse,
+) {
+	tflog.Debug(ctx, "Delete "+r.Config.Name)
+
+	var state ResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx = populateLogAttributes(ctx, state)
+
+	// Every Linode has a maintenance policy, so the current policy is left as is
+	tflog.Info(ctx, "Removing maintenance settings from state, the maintenance policy is left unchanged")
+}
+
+func populateLogAttributes(ctx context.Context, data ResourceModel) context.Context {
+	return helper.SetLogFieldBulk(ctx, map[string]any{
+		"linode_id": data.ID.ValueString(),
+	})
+}
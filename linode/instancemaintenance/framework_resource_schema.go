@@ -0,0 +1,75 @@
+package instancemaintenance
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/linode/linodego"
+)
+
+var maintenanceObjectType = types.ObjectType{
+	AttrTypes: map[string]attr.Type{
+		"type":   types.StringType,
+		"status": types.StringType,
+		"reason": types.StringType,
+		"when":   types.StringType,
+	},
+}
+
+var frameworkResourceSchema = schema.Schema{
+	Attributes: map[string]schema.Attribute{
+		"id": schema.StringAttribute{
+			Description: "The ID of the Linode.",
+			Computed:    true,
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.UseStateForUnknown(),
+			},
+		},
+		"linode_id": schema.Int64Attribute{
+			Description: "The ID of the Linode to manage the host maintenance of.",
+			Required:    true,
+			PlanModifiers: []planmodifier.Int64{
+				int64planmodifier.RequiresReplace(),
+			},
+		},
+		"maintenance_policy": schema.StringAttribute{
+			Description: "How the Linode is handled during host maintenance. " +
+				"(`linode/migrate`, `linode/power_off_on`)",
+			Optional: true,
+			Computed: true,
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.UseStateForUnknown(),
+			},
+			Validators: []validator.String{
+				stringvalidator.OneOf(policyMigrate, policyPowerOffOn),
+			},
+		},
+		"migrate_pending": schema.BoolAttribute{
+			Description: "Whether pending host maintenance migrations of the Linode are initiated during apply.",
+			Optional:    true,
+			Computed:    true,
+			Default:     booldefault.StaticBool(false),
+		},
+		"migration_type": schema.StringAttribute{
+			Description: "The type of migration used to initiate pending host maintenance migrations.",
+			Optional:    true,
+			Computed:    true,
+			Default:     stringdefault.StaticString(string(linodego.WarmMigration)),
+			Validators: []validator.String{
+				stringvalidator.OneOf(string(linodego.ColdMigration), string(linodego.WarmMigration)),
+			},
+		},
+		"pending_maintenance": schema.ListAttribute{
+			Description: "The host maintenance that is scheduled or in progress for the Linode.",
+			Computed:    true,
+			ElementType: maintenanceObjectType,
+		},
+	},
+}
@@ -0,0 +1,88 @@
+package instancemaintenance
+
+import (
+	"context"
+	"slices"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/linode/linodego"
+)
+
+const (
+	policyMigrate    = "linode/migrate"
+	policyPowerOffOn = "linode/power_off_on"
+)
+
+// The maintenance types that are resolved by migrating the Linode to another host
+var migrationMaintenanceTypes = []string{"migrate", "cold_migration", "live_migration"}
+
+// The statuses of maintenance that hasn't been started yet
+var pendingMaintenanceStatuses = []string{"pending", "scheduled"}
+
+func getMaintenancePolicy(ctx context.Context, client *linodego.Client, linodeID int) (string, error) {
+	tflog.Trace(ctx, "client.GetInstance(...)")
+
+	instance, err := client.GetInstance(ctx, linodeID)
+	if err != nil {
+		return "", err
+	}
+
+	return instance.MaintenancePolicy, nil
+}
+
+func updateMaintenancePolicy(ctx context.Context, client *linodego.Client, linodeID int, policy string) error {
+	updateOpts := linodego.InstanceUpdateOptions{
+		MaintenancePolicy: &policy,
+	}
+
+	tflog.Debug(ctx, "client.UpdateInstance(...)", map[string]any{
+		"options": updateOpts,
+	})
+
+	_, err := client.UpdateInstance(ctx, linodeID, updateOpts)
+
+	return err
+}
+
+// listInstanceMaintenance lists the maintenance of the account that affects
+// the given Linode and hasn't been completed yet.
+func listInstanceMaintenance(
+	ctx context.Context, client *linodego.Client, linodeID int,
+) ([]linodego.AccountMaintenance, error) {
+	tflog.Trace(ctx, "client.ListMaintenances(...)")
+
+	maintenances, err := client.ListMaintenances(ctx, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return filterInstanceMaintenance(maintenances, linodeID), nil
+}
+
+func filterInstanceMaintenance(
+	maintenances []linodego.AccountMaintenance, linodeID int,
+) []linodego.AccountMaintenance {
+	result := make([]linodego.AccountMaintenance, 0)
+
+	for _, maintenance := range maintenances {
+		if maintenance.Entity == nil ||
+			maintenance.Entity.Type != string(linodego.EntityLinode) ||
+			maintenance.Entity.ID != linodeID ||
+			maintenance.Status == "completed" {
+			continue
+		}
+
+		result = append(result, maintenance)
+	}
+
+	return result
+}
+
+// hasPendingMigration returns whether any of the given maintenance is a migration
+// that hasn't been started yet and can be initiated ahead of its schedule.
+func hasPendingMigration(maintenances []linodego.AccountMaintenance) bool {
+	return slices.ContainsFunc(maintenances, func(maintenance linodego.AccountMaintenance) bool {
+		return slices.Contains(migrationMaintenanceTypes, maintenance.Type) &&
+			slices.Contains(pendingMaintenanceStatuses, maintenance.Status)
+	})
+}
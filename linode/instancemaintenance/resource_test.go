@@ -0,0 +1,65 @@
+//go:build integration || instancemaintenance
+
+package instancemaintenance_test
+
+import (
+	"log"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/linode/terraform-provider-linode/v2/linode/acceptance"
+	"github.com/linode/terraform-provider-linode/v2/linode/instancemaintenance/tmpl"
+)
+
+const testMaintenanceResName = "linode_instance_maintenance.foobar"
+
+var testRegion string
+
+func init() {
+	region, err := acceptance.GetRandomRegionWithCaps([]string{"Linodes"}, "core")
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	testRegion = region
+}
+
+func TestAccResourceInstanceMaintenance_basic(t *testing.T) {
+	t.Parallel()
+
+	label := acctest.RandomWithPrefix("tf-test")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acceptance.PreCheck(t) },
+		ProtoV5ProviderFactories: acceptance.ProtoV5ProviderFactories,
+		CheckDestroy:             acceptance.CheckInstanceDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: tmpl.Basic(t, label, testRegion, "linode/power_off_on"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair(testMaintenanceResName, "id", "linode_instance.foobar", "id"),
+					resource.TestCheckResourceAttr(testMaintenanceResName, "maintenance_policy", "linode/power_off_on"),
+					resource.TestCheckResourceAttr(testMaintenanceResName, "migrate_pending", "true"),
+					resource.TestCheckResourceAttr(testMaintenanceResName, "migration_type", "warm"),
+					resource.TestCheckResourceAttrSet(testMaintenanceResName, "pending_maintenance.#"),
+				),
+			},
+			{
+				Config: tmpl.Basic(t, label, testRegion, "linode/migrate"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(testMaintenanceResName, "maintenance_policy", "linode/migrate"),
+				),
+			},
+			{
+				ResourceName:      testMaintenanceResName,
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateVerifyIgnore: []string{
+					"migrate_pending",
+					"timeouts",
+				},
+			},
+		},
+	})
+}
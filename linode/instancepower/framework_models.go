@@ -0,0 +1,40 @@
+package instancepower
+
+import (
+	"strconv"
+
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/linode/linodego"
+	"github.com/linode/terraform-provider-linode/v2/linode/helper"
+)
+
+const (
+	stateRunning = "running"
+	stateOffline = "offline"
+)
+
+type ResourceModel struct {
+	ID            types.String   `tfsdk:"id"`
+	LinodeID      types.Int64    `tfsdk:"linode_id"`
+	State         types.String   `tfsdk:"state"`
+	ConfigID      types.Int64    `tfsdk:"config_id"`
+	RebootTrigger types.Map      `tfsdk:"reboot_trigger"`
+	Status        types.String   `tfsdk:"status"`
+	Timeouts      timeouts.Value `tfsdk:"timeouts"`
+}
+
+func (data *ResourceModel) FlattenInstance(instance *linodego.Instance, preserveKnown bool) {
+	data.ID = helper.KeepOrUpdateString(data.ID, strconv.Itoa(instance.ID), preserveKnown)
+	data.LinodeID = helper.KeepOrUpdateInt64(data.LinodeID, int64(instance.ID), preserveKnown)
+	data.State = helper.KeepOrUpdateString(data.State, flattenPowerState(instance.Status), preserveKnown)
+	data.Status = helper.KeepOrUpdateString(data.Status, string(instance.Status), preserveKnown)
+}
+
+// flattenPowerState returns the power state the Linode is in or transitioning to.
+func flattenPowerState(status linodego.InstanceStatus) string {
+	if helper.IsInstanceInBootedState(status) {
+		return stateRunning
+	}
+	return stateOffline
+}
@@ -0,0 +1,45 @@
+//go:build unit
+
+package instancepower
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/linode/linodego"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestFlattenInstance(t *testing.T) {
+	instance := &linodego.Instance{
+		ID:     123,
+		Status: linodego.InstanceOffline,
+	}
+
+	var data ResourceModel
+	data.FlattenInstance(instance, false)
+
+	assert.Equal(t, types.StringValue("123"), data.ID)
+	assert.Equal(t, types.Int64Value(123), data.LinodeID)
+	assert.Equal(t, types.StringValue("offline"), data.State)
+	assert.Equal(t, types.StringValue("offline"), data.Status)
+
+	// The planned state is kept while the status is refreshed
+	data = ResourceModel{
+		State:  types.StringValue("running"),
+		Status: types.StringUnknown(),
+	}
+	instance.Status = linodego.InstanceBooting
+	data.FlattenInstance(instance, true)
+
+	assert.Equal(t, types.StringValue("running"), data.State)
+	assert.Equal(t, types.StringValue("booting"), data.Status)
+}
+
+func TestFlattenPowerState(t *testing.T) {
+	assert.Equal(t, "running", flattenPowerState(linodego.InstanceRunning))
+	assert.Equal(t, "running", flattenPowerState(linodego.InstanceBooting))
+	assert.Equal(t, "running", flattenPowerState(linodego.InstanceRebooting))
+	assert.Equal(t, "offline", flattenPowerState(linodego.InstanceOffline))
+	assert.Equal(t, "offline", flattenPowerState(linodego.InstanceShuttingDown))
+}
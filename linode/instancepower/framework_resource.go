@@ -0,0 +1,266 @@
+package instancepower
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/linode/linodego"
+	"github.com/linode/terraform-provider-linode/v2/linode/helper"
+)
+
+const (
+	DefaultPowerCreateTimeout = 15 * time.Minute
+	DefaultPowerUpdateTimeout = 15 * time.Minute
+)
+
+func NewResource() resource.Resource {
+	return &Resource{
+		BaseResource: helper.NewBaseResource(
+			helper.BaseResourceConfig{
+				Name:   "linode_instance_power",
+				IDType: types.StringType,
+				Schema: &frameworkResourceSchema,
+				TimeoutOpts: &timeouts.Opts{
+					Create: true,
+					Update: true,
+				},
+			},
+		),
+	}
+}
+
+type Resource struct {
+	helper.BaseResource
+}
+
+func (r *Resource) Create(
+	ctx context.Context,
+	req resource.CreateRequest,
+	resp *resource.CreateResponse,
+) {
+	tflog.Debug(ctx, "Create "+r.Config.Name)
+
+	var plan ResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	createTimeout, diags := plan.Timeouts.Create(ctx, DefaultPowerCreateTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, createTimeout)
+	defer cancel()
+
+	timeoutSeconds := helper.FrameworkSafeFloat64ToInt(createTimeout.Seconds(), &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx = populateLogAttributes(ctx, plan)
+
+	// The reboot trigger only reboots the Linode when it changes after creation
+	r.apply(ctx, &plan, false, timeoutSeconds, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *Resource) Read(
+	ctx context.Context,
+	req resource.ReadRequest,
+	resp *resource.ReadResponse,
+) {
+	tflog.Debug(ctx, "Read "+r.Config.Name)
+
+	var state ResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if helper.FrameworkAttemptRemoveResourceForEmptyID(ctx, state.ID, resp) {
+		return
+	}
+
+	linodeID := helper.FrameworkSafeStringToInt(state.ID.ValueString(), &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx = tflog.SetField(ctx, "linode_id", linodeID)
+
+	client := r.Meta.Client
+
+	tflog.Trace(ctx, "client.GetInstance(...)")
+	instance, err := client.GetInstance(ctx, linodeID)
+	if err != nil {
+		if linodego.IsNotFound(err) {
+			resp.Diagnostics.AddWarning(
+				"Linode Instance Not Found",
+				fmt.Sprintf(
+					"Removing power state of Linode Instance %d from state because it no longer exists",
+					linodeID,
+				),
+			)
+			resp.State.RemoveResource(ctx)
+			return
+		}
+
+		resp.Diagnostics.AddError(
+			fmt.Sprintf("Failed to Get Linode Instance %d", linodeID),
+			err.Error(),
+		)
+		return
+	}
+
+	state.FlattenInstance(instance, false)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+func (r *Resource) Update(
+	ctx context.Context,
+	req resource.UpdateRequest,
+	resp *resource.UpdateResponse,
+) {
+	tflog.Debug(ctx, "Update "+r.Config.Name)
+
+	var plan, state ResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	updateTimeout, diags := plan.Timeouts.Update(ctx, DefaultPowerUpdateTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, updateTimeout)
+	defer cancel()
+
+	timeoutSeconds := helper.FrameworkSafeFloat64ToInt(updateTimeout.Seconds(), &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx = populateLogAttributes(ctx, plan)
+
+	reboot := !plan.RebootTrigger.IsNull() && !plan.RebootTrigger.Equal(state.RebootTrigger)
+
+	r.apply(ctx, &plan, reboot, timeoutSeconds, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *Resource) Delete(
+	ctx context.Context,
+	req resource.DeleteRequest,
+	resp *resource.DeleteResponse,
+) {
+	tflog.Debug(ctx, "Delete "+r.Config.Name)
+
+	var state ResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx = populateLogAttributes(ctx, state)
+
+	tflog.Info(ctx, "Removing power state from state, the Linode is left in its current power state")
+}
+
+// apply brings the Linode into the planned power state, rebooting it if requested
+// and it is already running, then refreshes the given plan.
+func (r *Resource) apply(
+	ctx context.Context,
+	plan *ResourceModel,
+	reboot bool,
+	timeoutSeconds int,
+	diags *diag.Diagnostics,
+) {
+	client := r.Meta.Client
+
+	linodeID := helper.FrameworkSafeInt64ToInt(plan.LinodeID.ValueInt64(), diags)
+	configID := helper.FrameworkSafeInt64ToInt(plan.ConfigID.ValueInt64(), diags)
+	if diags.HasError() {
+		return
+	}
+
+	// Power actions are rejected while the Linode is booting or shutting down
+	status, err := helper.WaitForInstanceNonTransientStatus(ctx, client, linodeID, timeoutSeconds)
+	if err != nil {
+		diags.AddError(
+			fmt.Sprintf("Failed to Wait for Linode Instance %d to Settle", linodeID),
+			err.Error(),
+		)
+		return
+	}
+
+	running := status == linodego.InstanceRunning
+
+	switch plan.State.ValueString() {
+	case stateRunning:
+		if !running {
+			if err := helper.BootInstanceSync(ctx, client, linodeID, configID, timeoutSeconds); err != nil {
+				diags.AddError(fmt.Sprintf("Failed to Boot Linode Instance %d", linodeID), err.Error())
+				return
+			}
+		} else if reboot {
+			diags.Append(helper.FrameworkRebootInstance(ctx, linodeID, client, configID)...)
+			if diags.HasError() {
+				return
+			}
+		}
+
+		if _, err := client.WaitForInstanceStatus(
+			ctx, linodeID, linodego.InstanceRunning, timeoutSeconds,
+		); err != nil {
+			diags.AddError(
+				fmt.Sprintf("Timed-out Waiting for Linode Instance %d to Boot", linodeID),
+				err.Error(),
+			)
+			return
+		}
+	case stateOffline:
+		if running {
+			if err := helper.ShutDownInstanceSync(ctx, client, linodeID, timeoutSeconds); err != nil {
+				diags.AddError(fmt.Sprintf("Failed to Shut Down Linode Instance %d", linodeID), err.Error())
+				return
+			}
+		}
+	}
+
+	tflog.Trace(ctx, "client.GetInstance(...)")
+	instance, err := client.GetInstance(ctx, linodeID)
+	if err != nil {
+		diags.AddError(fmt.Sprintf("Failed to Get Linode Instance %d", linodeID), err.Error())
+		return
+	}
+
+	plan.FlattenInstance(instance, true)
+}
+
+func populateLogAttributes(ctx context.Context, data ResourceModel) context.Context {
+	return helper.SetLogFieldBulk(ctx, map[string]any{
+		"linode_id": data.LinodeID.ValueInt64(),
+	})
+}
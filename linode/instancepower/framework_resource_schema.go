@@ -0,0 +1,54 @@
+package instancepower
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var frameworkResourceSchema = schema.Schema{
+	Attributes: map[string]schema.Attribute{
+		"id": schema.StringAttribute{
+			Description: "The ID of the Linode.",
+			Computed:    true,
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.UseStateForUnknown(),
+			},
+		},
+		"linode_id": schema.Int64Attribute{
+			Description: "The ID of the Linode to manage the power state of.",
+			Required:    true,
+			PlanModifiers: []planmodifier.Int64{
+				int64planmodifier.RequiresReplace(),
+			},
+		},
+		"state": schema.StringAttribute{
+			Description: "The desired power state of the Linode. (`running`, `offline`)",
+			Optional:    true,
+			Computed:    true,
+			Default:     stringdefault.StaticString(stateRunning),
+			Validators: []validator.String{
+				stringvalidator.OneOf(stateRunning, stateOffline),
+			},
+		},
+		"config_id": schema.Int64Attribute{
+			Description: "The ID of the config to boot the Linode into. " +
+				"If not specified, the last booted config is used.",
+			Optional: true,
+		},
+		"reboot_trigger": schema.MapAttribute{
+			Description: "Arbitrary values that reboot the running Linode when they change.",
+			Optional:    true,
+			ElementType: types.StringType,
+		},
+		"status": schema.StringAttribute{
+			Description: "The current status of the Linode.",
+			Computed:    true,
+		},
+	},
+}
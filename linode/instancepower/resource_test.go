@@ -0,0 +1,105 @@
+//go:build integration || instancepower
+
+package instancepower_test
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/linode/linodego"
+	"github.com/linode/terraform-provider-linode/v2/linode/acceptance"
+	"github.com/linode/terraform-provider-linode/v2/linode/helper"
+	"github.com/linode/terraform-provider-linode/v2/linode/instancepower/tmpl"
+)
+
+const testPowerResName = "linode_instance_power.foobar"
+
+var testRegion string
+
+func init() {
+	region, err := acceptance.GetRandomRegionWithCaps([]string{"Linodes"}, "core")
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	testRegion = region
+}
+
+func TestAccResourceInstancePower_basic(t *testing.T) {
+	t.Parallel()
+
+	label := acctest.RandomWithPrefix("tf-test")
+	var instance linodego.Instance
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acceptance.PreCheck(t) },
+		ProtoV5ProviderFactories: acceptance.ProtoV5ProviderFactories,
+		CheckDestroy:             acceptance.CheckInstanceDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: tmpl.Basic(t, label, testRegion, "running", "1"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair(testPowerResName, "id", "linode_instance.foobar", "id"),
+					resource.TestCheckResourceAttr(testPowerResName, "state", "running"),
+					resource.TestCheckResourceAttr(testPowerResName, "status", "running"),
+					acceptance.CheckInstanceExists("linode_instance.foobar", &instance),
+					acceptance.CheckEventAbsent("linode_instance.foobar", linodego.EntityLinode, linodego.ActionLinodeReboot),
+				),
+			},
+			// Changing the trigger reboots the running Linode
+			{
+				Config: tmpl.Basic(t, label, testRegion, "running", "2"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(testPowerResName, "state", "running"),
+					resource.TestCheckResourceAttr(testPowerResName, "status", "running"),
+					resource.TestCheckResourceAttr(testPowerResName, "reboot_trigger.config_version", "2"),
+					checkInstanceRebooted(&instance),
+				),
+			},
+			{
+				Config: tmpl.Basic(t, label, testRegion, "offline", "2"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(testPowerResName, "state", "offline"),
+					resource.TestCheckResourceAttr(testPowerResName, "status", "offline"),
+				),
+			},
+			{
+				ResourceName:      testPowerResName,
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateVerifyIgnore: []string{
+					"reboot_trigger",
+					"timeouts",
+				},
+			},
+		},
+	})
+}
+
+// checkInstanceRebooted checks that the given instance was rebooted since it was created.
+func checkInstanceRebooted(instance *linodego.Instance) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		client := acceptance.TestAccProvider.Meta().(*helper.ProviderMeta).Client
+
+		eventFilter := fmt.Sprintf(
+			`{"entity.type": "linode", "entity.id": %d, "action": "linode_reboot", "created": { "+gte": "%s" }}`,
+			instance.ID, instance.Created.Format("2006-01-02T15:04:05"),
+		)
+
+		events, err := client.ListEvents(context.Background(), &linodego.ListOptions{Filter: eventFilter})
+		if err != nil {
+			return fmt.Errorf("failed to list events of instance %d: %w", instance.ID, err)
+		}
+
+		if len(events) == 0 {
+			return fmt.Errorf("expected instance %d to have been rebooted", instance.ID)
+		}
+
+		return nil
+	}
+}
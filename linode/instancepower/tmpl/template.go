@@ -0,0 +1,24 @@
+package tmpl
+
+import (
+	"testing"
+
+	"github.com/linode/terraform-provider-linode/v2/linode/acceptance"
+)
+
+type TemplateData struct {
+	Label   string
+	Region  string
+	State   string
+	Trigger string
+}
+
+func Basic(t testing.TB, label, region, state, trigger string) string {
+	return acceptance.ExecuteTemplate(t,
+		"instance_power_basic", TemplateData{
+			Label:   label,
+			Region:  region,
+			State:   state,
+			Trigger: trigger,
+		})
+}
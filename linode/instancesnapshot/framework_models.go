@@ -0,0 +1,98 @@
+package instancesnapshot
+
+import (
+	"context"
+	"strconv"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/linode/linodego"
+	"github.com/linode/terraform-provider-linode/v2/linode/helper"
+)
+
+type ResourceModel struct {
+	ID        types.String   `tfsdk:"id"`
+	LinodeID  types.Int64    `tfsdk:"linode_id"`
+	Label     types.String   `tfsdk:"label"`
+	Status    types.String   `tfsdk:"status"`
+	Type      types.String   `tfsdk:"type"`
+	Available types.Bool     `tfsdk:"available"`
+	Configs   types.List     `tfsdk:"configs"`
+	Disks     types.List     `tfsdk:"disks"`
+	Created   types.String   `tfsdk:"created"`
+	Updated   types.String   `tfsdk:"updated"`
+	Finished  types.String   `tfsdk:"finished"`
+	Timeouts  timeouts.Value `tfsdk:"timeouts"`
+}
+
+func (data *ResourceModel) FlattenSnapshot(
+	ctx context.Context,
+	snapshot *linodego.InstanceSnapshot,
+	preserveKnown bool,
+	diags *diag.Diagnostics,
+) {
+	data.ID = helper.KeepOrUpdateString(data.ID, strconv.Itoa(snapshot.ID), preserveKnown)
+	data.Label = helper.KeepOrUpdateString(data.Label, snapshot.Label, preserveKnown)
+	data.Status = helper.KeepOrUpdateString(data.Status, string(snapshot.Status), preserveKnown)
+	data.Type = helper.KeepOrUpdateString(data.Type, snapshot.Type, preserveKnown)
+	data.Available = helper.KeepOrUpdateBool(data.Available, snapshot.Available, preserveKnown)
+	data.Created = helper.KeepOrUpdateValue(data.Created, flattenTime(snapshot.Created), preserveKnown)
+	data.Updated = helper.KeepOrUpdateValue(data.Updated, flattenTime(snapshot.Updated), preserveKnown)
+	data.Finished = helper.KeepOrUpdateValue(data.Finished, flattenTime(snapshot.Finished), preserveKnown)
+
+	configs, newDiags := types.ListValueFrom(ctx, types.StringType, snapshot.Configs)
+	diags.Append(newDiags...)
+	if diags.HasError() {
+		return
+	}
+
+	data.Configs = helper.KeepOrUpdateValue(data.Configs, configs, preserveKnown)
+
+	diskValues := make([]attr.Value, len(snapshot.Disks))
+	for i, disk := range snapshot.Disks {
+		diskValue, newDiags := types.ObjectValue(diskObjectType.AttrTypes, map[string]attr.Value{
+			"label":      types.StringValue(disk.Label),
+			"size":       types.Int64Value(int64(disk.Size)),
+			"filesystem": types.StringValue(disk.Filesystem),
+		})
+		diags.Append(newDiags...)
+		if diags.HasError() {
+			return
+		}
+
+		diskValues[i] = diskValue
+	}
+
+	disks, newDiags := types.ListValue(diskObjectType, diskValues)
+	diags.Append(newDiags...)
+	if diags.HasError() {
+		return
+	}
+
+	data.Disks = helper.KeepOrUpdateValue(data.Disks, disks, preserveKnown)
+}
+
+func flattenTime(t *time.Time) types.String {
+	if t == nil {
+		return types.StringNull()
+	}
+
+	return types.StringValue(t.Format(time.RFC3339))
+}
+
+func (data *ResourceModel) CopyFrom(other ResourceModel, preserveKnown bool) {
+	data.ID = helper.KeepOrUpdateValue(data.ID, other.ID, preserveKnown)
+	data.LinodeID = helper.KeepOrUpdateValue(data.LinodeID, other.LinodeID, preserveKnown)
+	data.Label = helper.KeepOrUpdateValue(data.Label, other.Label, preserveKnown)
+	data.Status = helper.KeepOrUpdateValue(data.Status, other.Status, preserveKnown)
+	data.Type = helper.KeepOrUpdateValue(data.Type, other.Type, preserveKnown)
+	data.Available = helper.KeepOrUpdateValue(data.Available, other.Available, preserveKnown)
+	data.Configs = helper.KeepOrUpdateValue(data.Configs, other.Configs, preserveKnown)
+	data.Disks = helper.KeepOrUpdateValue(data.Disks, other.Disks, preserveKnown)
+	data.Created = helper.KeepOrUpdateValue(data.Created, other.Created, preserveKnown)
+	data.Updated = helper.KeepOrUpdateValue(data.Updated, other.Updated, preserveKnown)
+	data.Finished = helper.KeepOrUpdateValue(data.Finished, other.Finished, preserveKnown)
+}
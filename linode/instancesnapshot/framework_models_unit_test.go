@@ -0,0 +1,53 @@
+//go:build unit
+
+package instancesnapshot
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/linode/linodego"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestFlattenSnapshot(t *testing.T) {
+	ctx := context.Background()
+	created := time.Date(2024, time.March, 1, 12, 0, 0, 0, time.UTC)
+
+	snapshot := &linodego.InstanceSnapshot{
+		ID:        123,
+		Label:     "before-upgrade",
+		Status:    linodego.SnapshotSuccessful,
+		Type:      "snapshot",
+		Created:   &created,
+		Configs:   []string{"My Config"},
+		Available: true,
+		Disks: []*linodego.InstanceSnapshotDisk{
+			{
+				Label:      "boot",
+				Size:       25600,
+				Filesystem: "ext4",
+			},
+		},
+	}
+
+	var data ResourceModel
+	var diags diag.Diagnostics
+	data.FlattenSnapshot(ctx, snapshot, false, &diags)
+	assert.False(t, diags.HasError())
+
+	assert.Equal(t, types.StringValue("123"), data.ID)
+	assert.Equal(t, types.StringValue("before-upgrade"), data.Label)
+	assert.Equal(t, types.StringValue("successful"), data.Status)
+	assert.Equal(t, types.StringValue("snapshot"), data.Type)
+	assert.Equal(t, types.BoolValue(true), data.Available)
+	assert.Equal(t, types.StringValue("2024-03-01T12:00:00Z"), data.Created)
+	assert.True(t, data.Updated.IsNull())
+	assert.True(t, data.Finished.IsNull())
+	assert.Contains(t, data.Configs.String(), "My Config")
+	assert.Len(t, data.Disks.Elements(), 1)
+	assert.Contains(t, data.Disks.String(), "boot")
+}
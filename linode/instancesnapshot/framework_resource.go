@@ -0,0 +1,251 @@
+package instancesnapshot
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/linode/linodego"
+	"github.com/linode/terraform-provider-linode/v2/linode/helper"
+)
+
+const DefaultSnapshotCreateTimeout = 30 * time.Minute
+
+// linodego doesn't define the event action of manual snapshots
+const actionBackupsCreate linodego.EventAction = "backups_create"
+
+func NewResource() resource.Resource {
+	return &Resource{
+		BaseResource: helper.NewBaseResource(
+			helper.BaseResourceConfig{
+				Name:   "linode_instance_snapshot",
+				IDType: types.StringType,
+				Schema: &frameworkResourceSchema,
+				TimeoutOpts: &timeouts.Opts{
+					Create: true,
+				},
+			},
+		),
+	}
+}
+
+type Resource struct {
+	helper.BaseResource
+}
+
+func (r *Resource) Create(
+	ctx context.Context,
+	req resource.CreateRequest,
+	resp *resource.CreateResponse,
+) {
+	tflog.Debug(ctx, "Create "+r.Config.Name)
+
+	var plan ResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	createTimeout, diags := plan.Timeouts.Create(ctx, DefaultSnapshotCreateTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, createTimeout)
+	defer cancel()
+
+	client := r.Meta.Client
+
+	timeoutSeconds := helper.FrameworkSafeFloat64ToInt(createTimeout.Seconds(), &resp.Diagnostics)
+	linodeID := helper.FrameworkSafeInt64ToInt(plan.LinodeID.ValueInt64(), &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx = tflog.SetField(ctx, "linode_id", linodeID)
+
+	p, err := client.NewEventPoller(ctx, linodeID, linodego.EntityLinode, actionBackupsCreate)
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to Poll for Events", err.Error())
+		return
+	}
+
+	tflog.Debug(ctx, "client.CreateInstanceSnapshot(...)", map[string]any{
+		"label": plan.Label.ValueString(),
+	})
+	snapshot, err := client.CreateInstanceSnapshot(ctx, linodeID, plan.Label.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			fmt.Sprintf("Failed to Create Snapshot of Linode Instance %d", linodeID),
+			err.Error(),
+		)
+		return
+	}
+
+	// Add resource to TF states earlier to prevent
+	// dangling resources (resources created but not managed by TF)
+	plan.FlattenSnapshot(ctx, snapshot, true, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+
+	snapshotID := snapshot.ID
+	ctx = tflog.SetField(ctx, "snapshot_id", snapshotID)
+
+	tflog.Debug(ctx, "Waiting for the snapshot to finish")
+	if _, err := p.WaitForFinished(ctx, timeoutSeconds); err != nil {
+		resp.Diagnostics.AddError(
+			fmt.Sprintf("Failed to Wait for Snapshot %d of Linode Instance %d to Finish", snapshotID, linodeID),
+			err.Error(),
+		)
+		return
+	}
+
+	tflog.Trace(ctx, "client.GetInstanceSnapshot(...)")
+	snapshot, err = client.GetInstanceSnapshot(ctx, linodeID, snapshotID)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			fmt.Sprintf("Failed to Get Snapshot %d of Linode Instance %d", snapshotID, linodeID),
+			err.Error(),
+		)
+		return
+	}
+
+	plan.FlattenSnapshot(ctx, snapshot, true, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *Resource) Read(
+	ctx context.Context,
+	req resource.ReadRequest,
+	resp *resource.ReadResponse,
+) {
+	tflog.Debug(ctx, "Read "+r.Config.Name)
+
+	var state ResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if helper.FrameworkAttemptRemoveResourceForEmptyID(ctx, state.ID, resp) {
+		return
+	}
+
+	ctx = populateLogAttributes(ctx, state)
+
+	linodeID := helper.FrameworkSafeInt64ToInt(state.LinodeID.ValueInt64(), &resp.Diagnostics)
+	snapshotID := helper.FrameworkSafeStringToInt(state.ID.ValueString(), &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	client := r.Meta.Client
+
+	tflog.Trace(ctx, "client.GetInstanceSnapshot(...)")
+	snapshot, err := client.GetInstanceSnapshot(ctx, linodeID, snapshotID)
+	if err != nil {
+		if linodego.IsNotFound(err) {
+			resp.Diagnostics.AddWarning(
+				"Snapshot Not Found",
+				fmt.Sprintf(
+					"Removing Snapshot %d of Linode Instance %d from state because it no longer exists. "+
+						"A manual snapshot is replaced when a new one is taken.",
+					snapshotID, linodeID,
+				),
+			)
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError(
+			fmt.Sprintf("Failed to Get Snapshot %d of Linode Instance %d", snapshotID, linodeID),
+			err.Error(),
+		)
+		return
+	}
+
+	state.FlattenSnapshot(ctx, snapshot, false, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+func (r *Resource) Update(
+	ctx context.Context,
+	req resource.UpdateRequest,
+	resp *resource.UpdateResponse,
+) {
+	tflog.Debug(ctx, "Update "+r.Config.Name)
+
+	var plan, state ResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Only the timeouts can be updated in place
+	plan.CopyFrom(state, true)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *Resource) Delete(
+	ctx context.Context,
+	req resource.DeleteRequest,
+	resp *resource.DeleteResponse,
+) {
+	tflog.Debug(ctx, "Delete "+r.Config.Name)
+
+	var state ResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx = populateLogAttributes(ctx, state)
+
+	// Snapshots can't be deleted through the API, they are replaced by the next
+	// manual snapshot or removed when backups are cancelled for the Linode.
+	tflog.Info(ctx, "Snapshots can't be deleted, removing the snapshot from state")
+}
+
+func (r *Resource) ImportState(
+	ctx context.Context,
+	req resource.ImportStateRequest,
+	resp *resource.ImportStateResponse,
+) {
+	tflog.Debug(ctx, "Import "+r.Config.Name)
+	helper.ImportStateWithMultipleIDs(
+		ctx, req, resp,
+		[]helper.ImportableID{
+			{
+				Name:          "linode_id",
+				TypeConverter: helper.IDTypeConverterInt64,
+			},
+			{
+				Name:          "id",
+				TypeConverter: helper.IDTypeConverterString,
+			},
+		},
+	)
+}
+
+func populateLogAttributes(ctx context.Context, data ResourceModel) context.Context {
+	return helper.SetLogFieldBulk(ctx, map[string]any{
+		"linode_id":   data.LinodeID.ValueInt64(),
+		"snapshot_id": data.ID.ValueString(),
+	})
+}
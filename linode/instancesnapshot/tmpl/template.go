@@ -0,0 +1,24 @@
+package tmpl
+
+import (
+	"testing"
+
+	"github.com/linode/terraform-provider-linode/v2/linode/acceptance"
+)
+
+type TemplateData struct {
+	Label         string
+	Region        string
+	RootPass      string
+	SnapshotLabel string
+}
+
+func Basic(t testing.TB, label, region, rootPass, snapshotLabel string) string {
+	return acceptance.ExecuteTemplate(t,
+		"instance_snapshot_basic", TemplateData{
+			Label:         label,
+			Region:        region,
+			RootPass:      rootPass,
+			SnapshotLabel: snapshotLabel,
+		})
+}
@@ -4,10 +4,10 @@ import (
 	"context"
 	"fmt"
 	"reflect"
-	"time"
+	"slices"
+	"strings"
 
 	"github.com/hashicorp/terraform-plugin-log/tflog"
-	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/linode/linodego"
 	"github.com/linode/terraform-provider-linode/v2/linode/helper"
 	"github.com/linode/terraform-provider-linode/v2/linode/lkenodepool"
@@ -15,10 +15,11 @@ import (
 
 type NodePoolSpec struct {
 	ID                int
+	Name              string
 	Tags              []string
 	Type              string
 	Count             int
-	Taints            []map[string]any
+	Taints            []linodego.LKENodePoolTaint
 	Labels            map[string]string
 	AutoScalerEnabled bool
 	AutoScalerMin     int
@@ -27,17 +28,25 @@ type NodePoolSpec struct {
 
 type NodePoolUpdates struct {
 	ToDelete []int
-	ToCreate []linodego.LKENodePoolCreateOptions
+	ToCreate map[string]linodego.LKENodePoolCreateOptions
 	ToUpdate map[int]linodego.LKENodePoolUpdateOptions
+
+	// ToRename maps the new name of a pool to the ID of an existing pool
+	// that is adopted under that name without any API call.
+	ToRename map[string]int
 }
 
+// ReconcileLKENodePoolSpecs computes the API operations needed to go from the
+// old node pool specs to the new ones. Pools are matched by name, so adding,
+// removing or reordering pools never affects any other pool.
 func ReconcileLKENodePoolSpecs(
 	ctx context.Context, oldSpecs []NodePoolSpec, newSpecs []NodePoolSpec,
 ) (NodePoolUpdates, error) {
 	result := NodePoolUpdates{
-		ToCreate: make([]linodego.LKENodePoolCreateOptions, 0),
+		ToCreate: make(map[string]linodego.LKENodePoolCreateOptions),
 		ToUpdate: make(map[int]linodego.LKENodePoolUpdateOptions),
 		ToDelete: make([]int, 0),
+		ToRename: make(map[string]int),
 	}
 
 	createPool := func(spec NodePoolSpec) error {
@@ -46,10 +55,7 @@ func ReconcileLKENodePoolSpecs(
 			Type:   spec.Type,
 			Tags:   spec.Tags,
 			Labels: linodego.LKENodePoolLabels(spec.Labels),
-		}
-
-		if spec.Taints != nil {
-			createOpts.Taints = expandNodePoolTaints(spec.Taints)
+			Taints: spec.Taints,
 		}
 
 		if createOpts.Count == 0 {
@@ -67,7 +73,7 @@ func ReconcileLKENodePoolSpecs(
 			}
 		}
 
-		result.ToCreate = append(result.ToCreate, createOpts)
+		result.ToCreate[spec.Name] = createOpts
 
 		return nil
 	}
@@ -76,33 +82,54 @@ func ReconcileLKENodePoolSpecs(
 		result.ToDelete = append(result.ToDelete, id)
 	}
 
-	// If there are fewer node pools than expected
-	// we can assume the rest have been deleted
-	if len(newSpecs) < len(oldSpecs) {
-		for _, v := range oldSpecs[len(newSpecs):] {
-			deletePool(v.ID)
-		}
+	oldSpecs = sortNodePoolSpecs(oldSpecs)
+	newSpecs = sortNodePoolSpecs(newSpecs)
+
+	oldSpecsByName := make(map[string]NodePoolSpec, len(oldSpecs))
+	for _, spec := range oldSpecs {
+		oldSpecsByName[spec.Name] = spec
 	}
 
-	// If there are more node pools then there were previously
-	// we can assume new ones have been created
-	if len(newSpecs) > len(oldSpecs) {
-		for _, v := range newSpecs[len(oldSpecs):] {
-			if err := createPool(v); err != nil {
-				return result, err
-			}
-		}
+	newNames := make(map[string]bool, len(newSpecs))
+	for _, spec := range newSpecs {
+		newNames[spec.Name] = true
 	}
 
-	maxUpdateIndex := len(oldSpecs)
-	if maxUpdateIndex > len(newSpecs) {
-		maxUpdateIndex = len(newSpecs)
+	// Pools which are no longer declared under their name
+	// may still be adopted by a newly declared pool
+	removedSpecs := make([]NodePoolSpec, 0)
+	for _, spec := range oldSpecs {
+		if !newNames[spec.Name] {
+			removedSpecs = append(removedSpecs, spec)
+		}
 	}
 
-	for i, newSpec := range newSpecs[:maxUpdateIndex] {
-		oldSpec := oldSpecs[i]
+	for _, newSpec := range newSpecs {
+		oldSpec, ok := oldSpecsByName[newSpec.Name]
+		if !ok {
+			// A pool that was only renamed keeps its nodes
+			renamed := slices.IndexFunc(removedSpecs, func(spec NodePoolSpec) bool {
+				return nodePoolSpecsMatch(spec, newSpec)
+			})
+			if renamed >= 0 {
+				tflog.Debug(ctx, "Adopting existing node pool under a new name", map[string]any{
+					"node_pool_id": removedSpecs[renamed].ID,
+					"old_name":     removedSpecs[renamed].Name,
+					"new_name":     newSpec.Name,
+				})
+
+				result.ToRename[newSpec.Name] = removedSpecs[renamed].ID
+				removedSpecs = slices.Delete(removedSpecs, renamed, renamed+1)
+				continue
+			}
+
+			if err := createPool(newSpec); err != nil {
+				return result, err
+			}
+			continue
+		}
 
-		if reflect.DeepEqual(newSpec, oldSpec) {
+		if nodePoolSpecsMatch(oldSpec, newSpec) {
 			continue
 		}
 
@@ -117,18 +144,29 @@ func ReconcileLKENodePoolSpecs(
 			continue
 		}
 
+		tags := newSpec.Tags
+		if tags == nil {
+			tags = []string{}
+		}
+
 		updateOpts := linodego.LKENodePoolUpdateOptions{
 			Count: newSpec.Count,
-			Tags:  &newSpecs[i].Tags,
+			Tags:  &tags,
 		}
 
 		if !helper.CompareSets(helper.TypedSliceToAny(newSpec.Taints), helper.TypedSliceToAny(oldSpec.Taints)) {
-			taints := expandNodePoolTaints(newSpec.Taints)
+			taints := newSpec.Taints
+			if taints == nil {
+				taints = []linodego.LKENodePoolTaint{}
+			}
 			updateOpts.Taints = &taints
 		}
 
-		if !reflect.DeepEqual(newSpec.Labels, oldSpec.Labels) && !(len(newSpec.Labels) == 0 && len(oldSpec.Labels) == 0) {
-			labels := linodego.LKENodePoolLabels(newSpecs[i].Labels)
+		if !nodePoolLabelsEqual(newSpec.Labels, oldSpec.Labels) {
+			labels := linodego.LKENodePoolLabels(newSpec.Labels)
+			if labels == nil {
+				labels = linodego.LKENodePoolLabels{}
+			}
 			updateOpts.Labels = &labels
 		}
 
@@ -147,103 +185,128 @@ func ReconcileLKENodePoolSpecs(
 		result.ToUpdate[oldSpec.ID] = updateOpts
 	}
 
+	for _, spec := range removedSpecs {
+		deletePool(spec.ID)
+	}
+
+	slices.Sort(result.ToDelete)
+
 	return result, nil
 }
 
-func waitForNodesDeleted(
-	ctx context.Context,
-	client linodego.Client,
-	intervalMS int,
-	nodes []linodego.LKENodePoolLinode,
-) error {
-	ticker := time.NewTicker(time.Duration(intervalMS) * time.Millisecond)
-	defer ticker.Stop()
-
-	// Let's track which nodes still haven't been deleted
-	// using a pseudo-set
-	remainingNodes := make(map[int]bool, len(nodes))
-	for _, node := range nodes {
-		remainingNodes[node.InstanceID] = true
-	}
+// nodePoolSpecsMatch returns whether the new spec of a pool can be satisfied by
+// the old one without any change. The count of an autoscaled pool only needs
+// to match when it is explicitly declared.
+func nodePoolSpecsMatch(oldSpec, newSpec NodePoolSpec) bool {
+	countMatches := oldSpec.Count == newSpec.Count ||
+		(newSpec.AutoScalerEnabled && newSpec.Count == 0)
+
+	return countMatches &&
+		oldSpec.Type == newSpec.Type &&
+		oldSpec.AutoScalerEnabled == newSpec.AutoScalerEnabled &&
+		(!newSpec.AutoScalerEnabled ||
+			(oldSpec.AutoScalerMin == newSpec.AutoScalerMin && oldSpec.AutoScalerMax == newSpec.AutoScalerMax)) &&
+		helper.CompareStringSets(oldSpec.Tags, newSpec.Tags) &&
+		helper.CompareSets(helper.TypedSliceToAny(oldSpec.Taints), helper.TypedSliceToAny(newSpec.Taints)) &&
+		nodePoolLabelsEqual(oldSpec.Labels, newSpec.Labels)
+}
 
-	// Filter down to only instance deletion events
-	f := linodego.Filter{
-		OrderBy: "created",
-		Order:   linodego.Descending,
-	}
-	f.AddField(linodego.Eq, "entity.type", linodego.EntityLinode)
-	f.AddField(linodego.Eq, "action", linodego.ActionLinodeDelete)
+func nodePoolLabelsEqual(a, b map[string]string) bool {
+	// Length comparison is for handling the case of nil vs empty map
+	return reflect.DeepEqual(a, b) || (len(a) == 0 && len(b) == 0)
+}
 
-	filterBytes, err := f.MarshalJSON()
-	if err != nil {
-		return fmt.Errorf("failed to marshal filter: %w", err)
-	}
+func sortNodePoolSpecs(specs []NodePoolSpec) []NodePoolSpec {
+	sorted := slices.Clone(specs)
+	slices.SortFunc(sorted, func(a, b NodePoolSpec) int {
+		return strings.Compare(a.Name, b.Name)
+	})
+	return sorted
+}
 
-	listOpts := linodego.ListOptions{
-		Filter:      string(filterBytes),
-		PageOptions: &linodego.PageOptions{Page: 1},
+// nodePoolSpecFromAPI returns the spec of a node pool as reported by the API.
+func nodePoolSpecFromAPI(pool linodego.LKENodePool) NodePoolSpec {
+	return NodePoolSpec{
+		ID:                pool.ID,
+		Tags:              pool.Tags,
+		Type:              pool.Type,
+		Count:             pool.Count,
+		Taints:            pool.Taints,
+		Labels:            pool.Labels,
+		AutoScalerEnabled: pool.Autoscaler.Enabled,
+		AutoScalerMin:     pool.Autoscaler.Min,
+		AutoScalerMax:     pool.Autoscaler.Max,
 	}
+}
 
-	for {
-		select {
-		case <-ticker.C:
-			tflog.Trace(ctx, "client.ListEvents(...)", map[string]any{
-				"options": listOpts,
-			})
+// matchCreatedNodePools pairs the pools created alongside a cluster with the
+// declared specs they were created from, returning the name of each pool by ID.
+// Pools with identical specs are interchangeable, so the first match is used.
+func matchCreatedNodePools(specs []NodePoolSpec, pools []linodego.LKENodePool) map[int]string {
+	result := make(map[int]string, len(pools))
 
-			events, err := client.ListEvents(ctx, &listOpts)
-			if err != nil {
-				return fmt.Errorf("failed to list events: %w", err)
-			}
+	unmatched := slices.Clone(pools)
 
-			for _, event := range events {
-				var instID int
-
-				// Sometimes go will parse entity.id as float,
-				// we should convert accordingly
-				switch event.Entity.ID.(type) {
-				case int:
-					instID = event.Entity.ID.(int)
-				case float64:
-					instID = int(event.Entity.ID.(float64))
-				case float32:
-					instID = int(event.Entity.ID.(float32))
-				default:
-					// This shouldn't happen, but let's handle it gracefully just in case
-					tflog.Trace(ctx, "Invalid entity.id type detected", map[string]any{
-						"value": event.Entity.ID,
-						"type":  fmt.Sprintf("%T", event.Entity.ID),
-					})
-					continue
-				}
-
-				if _, ok := remainingNodes[instID]; ok {
-					delete(remainingNodes, instID)
-					tflog.Trace(ctx, "Node detected as deleted", map[string]any{
-						"instance_id":     instID,
-						"nodes_remaining": len(remainingNodes),
-					})
-				}
-			}
+	for _, spec := range sortNodePoolSpecs(specs) {
+		// Autoscaled pools are created with the minimum count when none is declared
+		createdSpec := spec
+		if createdSpec.Count == 0 {
+			createdSpec.Count = createdSpec.AutoScalerMin
+		}
 
-			// All nodes have been deleted
-			if len(remainingNodes) < 1 {
-				return nil
-			}
-		case <-ctx.Done():
-			return fmt.Errorf("failed to wait for node deletion: %w", ctx.Err())
+		i := slices.IndexFunc(unmatched, func(pool linodego.LKENodePool) bool {
+			return nodePoolSpecsMatch(nodePoolSpecFromAPI(pool), createdSpec)
+		})
+
+		// Fall back to a pool of the same type in case the API
+		// normalized any of the declared attributes
+		if i < 0 {
+			i = slices.IndexFunc(unmatched, func(pool linodego.LKENodePool) bool {
+				return pool.Type == spec.Type
+			})
+		}
+
+		if i < 0 {
+			continue
 		}
+
+		result[unmatched[i].ID] = spec.Name
+		unmatched = slices.Delete(unmatched, i, i+1)
 	}
-}
 
-func recycleLKECluster(ctx context.Context, meta *helper.ProviderMeta, id int, pools []linodego.LKENodePool) error {
-	client := meta.Client
+	return result
+}
 
+func recycleLKECluster(
+	ctx context.Context,
+	client linodego.Client,
+	pollMs int,
+	id int,
+	pools []linodego.LKENodePool,
+	strategy lkenodepool.RecycleStrategy,
+) error {
 	ctx = helper.SetLogFieldBulk(ctx, map[string]any{
-		"cluster_id": id,
-		"pools":      pools,
+		"cluster_id":       id,
+		"pools":            pools,
+		"recycle_strategy": strategy,
 	})
 
+	if strategy.Type == lkenodepool.RecycleStrategyPool || strategy.Type == lkenodepool.RecycleStrategyNode {
+		tflog.Info(ctx, "Recycling LKE cluster one node pool at a time")
+
+		for _, pool := range pools {
+			if err := lkenodepool.RecycleNodePool(
+				ctx, client, pollMs, id, pool, strategy,
+			); err != nil {
+				return fmt.Errorf("failed to recycle LKE Cluster (%d): %w", id, err)
+			}
+		}
+
+		tflog.Debug(ctx, "All node pools have been recycled; recycle operation completed")
+
+		return nil
+	}
+
 	tflog.Info(ctx, "Recycling LKE cluster")
 	tflog.Trace(ctx, "client.RecycleLKEClusterNodes(...)")
 
@@ -262,7 +325,7 @@ func recycleLKECluster(ctx context.Context, meta *helper.ProviderMeta, id int, p
 	})
 
 	// Wait for the old nodes to be deleted
-	if err := waitForNodesDeleted(ctx, client, meta.Config.EventPollMilliseconds, oldNodes); err != nil {
+	if err := lkenodepool.WaitForNodesDeleted(ctx, client, pollMs, oldNodes); err != nil {
 		return fmt.Errorf("failed to wait for old nodes to be recycled: %w", err)
 	}
 
@@ -270,7 +333,7 @@ func recycleLKECluster(ctx context.Context, meta *helper.ProviderMeta, id int, p
 
 	// Wait for all node pools to be ready
 	for _, pool := range pools {
-		if _, err := lkenodepool.WaitForNodePoolReady(ctx, client, meta.Config.EventPollMilliseconds, id, pool.ID); err != nil {
+		if _, err := lkenodepool.WaitForNodePoolReady(ctx, client, pollMs, id, pool.ID); err != nil {
 			return fmt.Errorf("failed to wait for pool %d ready: %w", pool.ID, err)
 		}
 	}
@@ -280,294 +343,6 @@ func recycleLKECluster(ctx context.Context, meta *helper.ProviderMeta, id int, p
 	return nil
 }
 
-// This cannot currently be handled efficiently by a DiffSuppressFunc
-// See: https://github.com/hashicorp/terraform-plugin-sdk/issues/477
-func matchPoolsWithSchema(ctx context.Context, pools []linodego.LKENodePool, declaredPools []interface{}) ([]linodego.LKENodePool, error) {
-	tflog.Info(ctx, "Enter matchPoolsWithSchema helper function")
-	result := make([]linodego.LKENodePool, len(declaredPools))
-
-	// Contains all unpaired pools returned by the API
-	apiPools := make(map[int]linodego.LKENodePool, len(pools))
-	for _, pool := range pools {
-		apiPools[pool.ID] = pool
-	}
-
-	// Tracks which local pools have been processed
-	pairedDeclaredPools := make(map[int]bool)
-
-	// First let's match any pools in state with an ID
-	for i, declaredPool := range declaredPools {
-		declaredPool := declaredPool.(map[string]any)
-
-		poolID, ok := declaredPool["id"].(int)
-		if !ok {
-			return nil, fmt.Errorf("declared pool ID was not of type int")
-		}
-
-		apiPool, ok := apiPools[poolID]
-		if !ok {
-			continue
-		}
-
-		// Pair the found pool with the declared pool
-		result[i] = apiPool
-		delete(apiPools, poolID)
-		pairedDeclaredPools[i] = true
-	}
-
-	// Second, let's match pools that have all matching attributes.
-	// This is necessary because declared pools will not be populated with
-	// an ID on first apply but still have matching node pools.
-	for i, declaredPool := range declaredPools {
-		declaredPool := declaredPool.(map[string]any)
-		declaredAutoscaler := expandLinodeLKEClusterAutoscalerFromPool(declaredPool)
-
-		if _, ok := pairedDeclaredPools[i]; ok {
-			// This apiPool has already been handled in the previous step,
-			// we can skip it
-			continue
-		}
-
-		for _, apiPool := range apiPools {
-			if declaredPool["type"] != apiPool.Type {
-				continue
-			}
-
-			declaredCount := declaredPool["count"].(int)
-			if declaredCount == 0 {
-				if declaredAutoscaler == nil {
-					return nil, fmt.Errorf("autoscaler is null when count is 0. This is always a provider issue")
-				}
-				declaredCount = declaredAutoscaler.Min
-			}
-
-			if declaredCount != apiPool.Count {
-				continue
-			}
-
-			if (declaredAutoscaler != nil && declaredAutoscaler.Enabled) != apiPool.Autoscaler.Enabled {
-				continue
-			}
-
-			// Only compare autoscalers if the declared autoscaler is enabled
-			if declaredAutoscaler != nil && !reflect.DeepEqual(
-				*declaredAutoscaler, apiPool.Autoscaler,
-			) {
-				continue
-			}
-
-			if !helper.CompareStringSets(helper.ExpandStringSet(declaredPool["tags"].(*schema.Set)), apiPool.Tags) {
-				continue
-			}
-
-			declaredTaints := expandNodePoolTaints(helper.ExpandObjectSet(declaredPool["taint"].(*schema.Set)))
-
-			if !helper.CompareSets(helper.TypedSliceToAny(declaredTaints), helper.TypedSliceToAny(apiPool.Taints)) {
-				continue
-			}
-
-			declaredLabels := helper.StringAnyMapToTyped[string](declaredPool["labels"].(map[string]any))
-
-			// - Length comparison is for handling the case of nil vs empty slice
-			// - Converting `apiPool.Labels` back to original (non-alias) type to make `reflect.DeepEqual` to really compare them
-			if !reflect.DeepEqual(declaredLabels, map[string]string(apiPool.Labels)) &&
-				!(len(declaredLabels) == 0 && len(apiPool.Labels) == 0) {
-				continue
-			}
-
-			// Pair the API pool with the declared pool
-			result[i] = apiPool
-			delete(apiPools, apiPool.ID)
-			break
-		}
-	}
-
-	// Append any unresolved pools to the end
-	// These are typically pools planned to be deleted
-	for _, pool := range apiPools {
-		//nolint:makezero
-		result = append(result, pool)
-	}
-
-	return result, nil
-}
-
-func expandLinodeLKEClusterAutoscalerFromPool(pool map[string]interface{}) *linodego.LKENodePoolAutoscaler {
-	scalersSpec, ok := pool["autoscaler"].([]interface{})
-
-	// Return nil if the autoscaler isn't defined
-	if !ok || len(scalersSpec) < 1 {
-		return nil
-	}
-
-	scalerSpec := scalersSpec[0].(map[string]interface{})
-	return &linodego.LKENodePoolAutoscaler{
-		Enabled: true,
-		Min:     scalerSpec["min"].(int),
-		Max:     scalerSpec["max"].(int),
-	}
-}
-
-func expandLinodeLKENodePoolSpecs(pool []interface{}, preserveNoTarget bool) (poolSpecs []NodePoolSpec) {
-	for _, spec := range pool {
-		specMap := spec.(map[string]interface{})
-		autoscaler := expandLinodeLKEClusterAutoscalerFromPool(specMap)
-		if autoscaler == nil {
-			autoscaler = &linodego.LKENodePoolAutoscaler{
-				Enabled: false,
-				Min:     specMap["count"].(int),
-				Max:     specMap["count"].(int),
-			}
-		}
-
-		if !preserveNoTarget && specMap["id"].(int) == 0 {
-			continue
-		}
-
-		poolSpecs = append(poolSpecs, NodePoolSpec{
-			ID:                specMap["id"].(int),
-			Type:              specMap["type"].(string),
-			Tags:              helper.ExpandStringSet(specMap["tags"].(*schema.Set)),
-			Taints:            helper.ExpandObjectSet(specMap["taint"].(*schema.Set)),
-			Labels:            helper.StringAnyMapToTyped[string](specMap["labels"].(map[string]any)),
-			Count:             specMap["count"].(int),
-			AutoScalerEnabled: autoscaler.Enabled,
-			AutoScalerMin:     autoscaler.Min,
-			AutoScalerMax:     autoscaler.Max,
-		})
-	}
-	return
-}
-
-func flattenLKENodePools(pools []linodego.LKENodePool) []map[string]interface{} {
-	flattened := make([]map[string]interface{}, len(pools))
-	for i, pool := range pools {
-
-		nodes := make([]map[string]interface{}, len(pool.Linodes))
-		for i, node := range pool.Linodes {
-			nodes[i] = map[string]interface{}{
-				"id":          node.ID,
-				"instance_id": node.InstanceID,
-				"status":      node.Status,
-			}
-		}
-
-		var autoscaler []map[string]interface{}
-
-		if pool.Autoscaler.Enabled {
-			autoscaler = []map[string]interface{}{
-				{
-					"min": pool.Autoscaler.Min,
-					"max": pool.Autoscaler.Max,
-				},
-			}
-		}
-
-		flattened[i] = map[string]interface{}{
-			"id":              pool.ID,
-			"count":           pool.Count,
-			"type":            pool.Type,
-			"tags":            pool.Tags,
-			"disk_encryption": pool.DiskEncryption,
-			"taint":           flattenNodePoolTaints(pool.Taints),
-			"labels":          pool.Labels,
-			"nodes":           nodes,
-			"autoscaler":      autoscaler,
-		}
-	}
-	return flattened
-}
-
-func flattenNodePoolTaints(taints []linodego.LKENodePoolTaint) []map[string]string {
-	result := make([]map[string]string, len(taints))
-
-	for i, t := range taints {
-		result[i] = map[string]string{
-			"effect": string(t.Effect),
-			"key":    t.Key,
-			"value":  t.Value,
-		}
-	}
-
-	return result
-}
-
-func flattenLKEClusterControlPlane(controlPlane linodego.LKEClusterControlPlane, aclResp *linodego.LKEClusterControlPlaneACLResponse) map[string]interface{} {
-	flattened := make(map[string]any)
-	if aclResp != nil {
-		acl := aclResp.ACL
-
-		flattenedACL := make(map[string]any)
-		flattenedACL["enabled"] = acl.Enabled
-
-		if acl.Addresses != nil {
-			flattenedAddresses := map[string]any{
-				"ipv4": acl.Addresses.IPv4,
-				"ipv6": acl.Addresses.IPv6,
-			}
-			flattenedACL["addresses"] = []map[string]any{flattenedAddresses}
-		}
-
-		flattened["acl"] = []map[string]any{flattenedACL}
-	}
-
-	flattened["high_availability"] = controlPlane.HighAvailability
-
-	return flattened
-}
-
-func expandControlPlaneOptions(controlPlane map[string]interface{}) linodego.LKEClusterControlPlaneOptions {
-	var result linodego.LKEClusterControlPlaneOptions
-
-	if value, ok := controlPlane["high_availability"]; ok {
-		v := value.(bool)
-		result.HighAvailability = &v
-	}
-
-	if value, ok := controlPlane["acl"]; ok {
-		v := value.([]interface{})
-		if len(v) > 0 {
-			result.ACL = expandACLOptions(v[0].(map[string]interface{}))
-		}
-	}
-
-	return result
-}
-
-func expandACLOptions(aclOptions map[string]interface{}) *linodego.LKEClusterControlPlaneACLOptions {
-	var result linodego.LKEClusterControlPlaneACLOptions
-
-	if value, ok := aclOptions["enabled"]; ok {
-		v := value.(bool)
-		result.Enabled = &v
-	}
-
-	if value, ok := aclOptions["addresses"]; ok {
-		v := value.([]interface{})
-		if len(v) > 0 {
-			result.Addresses = expandACLAddressOptions(v[0].(map[string]interface{}))
-		}
-	}
-
-	return &result
-}
-
-func expandACLAddressOptions(addressOptions map[string]interface{}) *linodego.LKEClusterControlPlaneACLAddressesOptions {
-	var result linodego.LKEClusterControlPlaneACLAddressesOptions
-
-	if value, ok := addressOptions["ipv4"]; ok {
-		ipv4 := helper.ExpandStringSet(value.(*schema.Set))
-		result.IPv4 = &ipv4
-	}
-
-	if value, ok := addressOptions["ipv6"]; ok {
-		ipv6 := helper.ExpandStringSet(value.(*schema.Set))
-		result.IPv6 = &ipv6
-	}
-
-	return &result
-}
-
 func filterExternalPools(ctx context.Context, externalPoolTags []string, pools []linodego.LKENodePool) []linodego.LKENodePool {
 	var filteredPools []linodego.LKENodePool
 	if len(externalPoolTags) == 0 {
@@ -601,15 +376,3 @@ func poolHasAnyOfTags(pool linodego.LKENodePool, tagSet map[string]bool) *string
 	}
 	return nil
 }
-
-func expandNodePoolTaints(poolTaints []map[string]any) []linodego.LKENodePoolTaint {
-	taints := make([]linodego.LKENodePoolTaint, len(poolTaints))
-	for i, taint := range poolTaints {
-		taints[i] = linodego.LKENodePoolTaint{
-			Key:    taint["key"].(string),
-			Value:  taint["value"].(string),
-			Effect: linodego.LKENodePoolTaintEffect(taint["effect"].(string)),
-		}
-	}
-	return taints
-}
@@ -18,45 +18,46 @@ func TestReconcileLKENodePoolSpecs(t *testing.T) {
 		newSpecs []lke.NodePoolSpec
 
 		expectedToDelete []int
-		expectedToCreate []linodego.LKENodePoolCreateOptions
+		expectedToCreate map[string]linodego.LKENodePoolCreateOptions
+		expectedToRename map[string]int
 		expectedToUpdate map[int]linodego.LKENodePoolUpdateOptions
 	}{
 		{
 			name: "no change",
 			oldSpecs: []lke.NodePoolSpec{
-				{ID: 123, Type: "g6-standard-1", Count: 2},
+				{ID: 123, Name: "a", Type: "g6-standard-1", Count: 2},
 			},
 			newSpecs: []lke.NodePoolSpec{
-				{ID: 123, Type: "g6-standard-1", Count: 2},
+				{ID: 123, Name: "a", Type: "g6-standard-1", Count: 2},
 			},
 			expectedToUpdate: map[int]linodego.LKENodePoolUpdateOptions{},
-			expectedToCreate: []linodego.LKENodePoolCreateOptions{},
+			expectedToCreate: map[string]linodego.LKENodePoolCreateOptions{},
 			expectedToDelete: []int{},
 		},
 		{
 			name: "upsize a single pool",
 			oldSpecs: []lke.NodePoolSpec{
-				{ID: 123, Type: "g6-standard-1", Count: 2},
+				{ID: 123, Name: "a", Type: "g6-standard-1", Count: 2},
 			},
 			newSpecs: []lke.NodePoolSpec{
-				{ID: 123, Type: "g6-standard-1", Count: 3, Tags: []string{"example"}},
+				{ID: 123, Name: "a", Type: "g6-standard-1", Count: 3, Tags: []string{"example"}},
 			},
 			expectedToUpdate: map[int]linodego.LKENodePoolUpdateOptions{
 				123: {Count: 3, Tags: &[]string{"example"}},
 			},
-			expectedToCreate: []linodego.LKENodePoolCreateOptions{},
+			expectedToCreate: map[string]linodego.LKENodePoolCreateOptions{},
 			expectedToDelete: []int{},
 		},
 		{
 			name: "change single pool type",
 			oldSpecs: []lke.NodePoolSpec{
-				{ID: 123, Type: "g6-standard-1", Count: 2},
+				{ID: 123, Name: "a", Type: "g6-standard-1", Count: 2},
 			},
 			newSpecs: []lke.NodePoolSpec{
-				{ID: 123, Type: "g6-standard-2", Count: 2},
+				{ID: 123, Name: "a", Type: "g6-standard-2", Count: 2},
 			},
-			expectedToCreate: []linodego.LKENodePoolCreateOptions{
-				{Type: "g6-standard-2", Count: 2},
+			expectedToCreate: map[string]linodego.LKENodePoolCreateOptions{
+				"a": {Type: "g6-standard-2", Count: 2},
 			},
 			expectedToDelete: []int{123},
 			expectedToUpdate: map[int]linodego.LKENodePoolUpdateOptions{},
@@ -64,34 +65,34 @@ func TestReconcileLKENodePoolSpecs(t *testing.T) {
 		{
 			name: "reuse cluster for resize",
 			oldSpecs: []lke.NodePoolSpec{
-				{ID: 123, Type: "g6-standard-1", Count: 1},
-				{ID: 124, Type: "g6-standard-1", Count: 10},
+				{ID: 123, Name: "a", Type: "g6-standard-1", Count: 1},
+				{ID: 124, Name: "b", Type: "g6-standard-1", Count: 10},
 			},
 			newSpecs: []lke.NodePoolSpec{
-				{ID: 123, Type: "g6-standard-1", Count: 9, Tags: []string{"example"}},  // bumped from 1 to 9
-				{ID: 124, Type: "g6-standard-2", Count: 10, Tags: []string{"example"}}, // type changed
+				{ID: 123, Name: "a", Type: "g6-standard-1", Count: 9, Tags: []string{"example"}},  // bumped from 1 to 9
+				{ID: 124, Name: "b", Type: "g6-standard-2", Count: 10, Tags: []string{"example"}}, // type changed
 			},
 			expectedToDelete: []int{124},
 			expectedToUpdate: map[int]linodego.LKENodePoolUpdateOptions{
 				123: {Count: 9, Tags: &[]string{"example"}},
 			},
-			expectedToCreate: []linodego.LKENodePoolCreateOptions{
-				{Type: "g6-standard-2", Count: 10, Tags: []string{"example"}},
+			expectedToCreate: map[string]linodego.LKENodePoolCreateOptions{
+				"b": {Type: "g6-standard-2", Count: 10, Tags: []string{"example"}},
 			},
 		},
 		{
 			name: "competing resizes",
 			oldSpecs: []lke.NodePoolSpec{
-				{ID: 123, Type: "g6-standard-3", Count: 3},
-				{ID: 124, Type: "g6-standard-3", Count: 7},
-				{ID: 126, Type: "g6-standard-3", Count: 4},
-				{ID: 127, Type: "g6-standard-3", Count: 2},
+				{ID: 123, Name: "a", Type: "g6-standard-3", Count: 3},
+				{ID: 124, Name: "b", Type: "g6-standard-3", Count: 7},
+				{ID: 126, Name: "c", Type: "g6-standard-3", Count: 4},
+				{ID: 127, Name: "d", Type: "g6-standard-3", Count: 2},
 			},
 			newSpecs: []lke.NodePoolSpec{
-				{ID: 123, Type: "g6-standard-3", Count: 2, Tags: []string{"example"}},
-				{ID: 124, Type: "g6-standard-3", Count: 9, Tags: []string{"example"}},
-				{ID: 126, Type: "g6-standard-3", Count: 8, Tags: []string{"example"}},
-				{ID: 127, Type: "g6-standard-3", Count: 2, Tags: []string{"example"}},
+				{ID: 123, Name: "a", Type: "g6-standard-3", Count: 2, Tags: []string{"example"}},
+				{ID: 124, Name: "b", Type: "g6-standard-3", Count: 9, Tags: []string{"example"}},
+				{ID: 126, Name: "c", Type: "g6-standard-3", Count: 8, Tags: []string{"example"}},
+				{ID: 127, Name: "d", Type: "g6-standard-3", Count: 2, Tags: []string{"example"}},
 			},
 			expectedToUpdate: map[int]linodego.LKENodePoolUpdateOptions{
 				123: {Count: 2, Tags: &[]string{"example"}},
@@ -100,52 +101,112 @@ func TestReconcileLKENodePoolSpecs(t *testing.T) {
 				127: {Count: 2, Tags: &[]string{"example"}},
 			},
 			expectedToDelete: []int{},
-			expectedToCreate: []linodego.LKENodePoolCreateOptions{},
+			expectedToCreate: map[string]linodego.LKENodePoolCreateOptions{},
 		},
 		{
 			name: "scaler",
 			oldSpecs: []lke.NodePoolSpec{
-				{ID: 123, Type: "g6-standard-3", Count: 3},
+				{ID: 123, Name: "a", Type: "g6-standard-3", Count: 3},
 			},
 			newSpecs: []lke.NodePoolSpec{
-				{ID: 123, Type: "g6-standard-3", Count: 3, AutoScalerEnabled: true, AutoScalerMin: 3, AutoScalerMax: 7, Tags: []string{"example"}},
+				{ID: 123, Name: "a", Type: "g6-standard-3", Count: 3, AutoScalerEnabled: true, AutoScalerMin: 3, AutoScalerMax: 7, Tags: []string{"example"}},
 			},
 			expectedToUpdate: map[int]linodego.LKENodePoolUpdateOptions{
 				123: {Count: 3, Autoscaler: &linodego.LKENodePoolAutoscaler{Enabled: true, Min: 3, Max: 7}, Tags: &[]string{"example"}},
 			},
 			expectedToDelete: []int{},
-			expectedToCreate: []linodego.LKENodePoolCreateOptions{},
+			expectedToCreate: map[string]linodego.LKENodePoolCreateOptions{},
 		},
 		{
 			name: "scaler drop",
 			oldSpecs: []lke.NodePoolSpec{
-				{ID: 123, Type: "g6-standard-3", Count: 3, AutoScalerEnabled: true, AutoScalerMin: 3, AutoScalerMax: 7},
+				{ID: 123, Name: "a", Type: "g6-standard-3", Count: 3, AutoScalerEnabled: true, AutoScalerMin: 3, AutoScalerMax: 7},
 			},
 			newSpecs: []lke.NodePoolSpec{
-				{ID: 123, Type: "g6-standard-3", Count: 3, AutoScalerEnabled: false, Tags: []string{"example"}},
+				{ID: 123, Name: "a", Type: "g6-standard-3", Count: 3, AutoScalerEnabled: false, Tags: []string{"example"}},
 			},
 			expectedToUpdate: map[int]linodego.LKENodePoolUpdateOptions{
 				123: {Count: 3, Autoscaler: &linodego.LKENodePoolAutoscaler{Enabled: false, Min: 0, Max: 0}, Tags: &[]string{"example"}},
 			},
 			expectedToDelete: []int{},
-			expectedToCreate: []linodego.LKENodePoolCreateOptions{},
+			expectedToCreate: map[string]linodego.LKENodePoolCreateOptions{},
 		},
 		{
 			name: "scaler update",
 			oldSpecs: []lke.NodePoolSpec{
-				{ID: 123, Type: "g6-standard-3", Count: 3, AutoScalerEnabled: true, AutoScalerMin: 3, AutoScalerMax: 7},
+				{ID: 123, Name: "a", Type: "g6-standard-3", Count: 3, AutoScalerEnabled: true, AutoScalerMin: 3, AutoScalerMax: 7},
 			},
 			newSpecs: []lke.NodePoolSpec{
-				{ID: 123, Type: "g6-standard-3", Count: 3, AutoScalerEnabled: true, AutoScalerMin: 5, AutoScalerMax: 10, Tags: []string{"example"}},
+				{ID: 123, Name: "a", Type: "g6-standard-3", Count: 3, AutoScalerEnabled: true, AutoScalerMin: 5, AutoScalerMax: 10, Tags: []string{"example"}},
 			},
 			expectedToUpdate: map[int]linodego.LKENodePoolUpdateOptions{
 				123: {Count: 3, Autoscaler: &linodego.LKENodePoolAutoscaler{Enabled: true, Min: 5, Max: 10}, Tags: &[]string{"example"}},
 			},
 			expectedToDelete: []int{},
-			expectedToCreate: []linodego.LKENodePoolCreateOptions{},
+			expectedToCreate: map[string]linodego.LKENodePoolCreateOptions{},
+		},
+		{
+			name: "remove a pool in the middle",
+			oldSpecs: []lke.NodePoolSpec{
+				{ID: 123, Name: "a", Type: "g6-standard-1", Count: 1},
+				{ID: 124, Name: "b", Type: "g6-standard-2", Count: 2},
+				{ID: 126, Name: "c", Type: "g6-standard-3", Count: 3},
+			},
+			newSpecs: []lke.NodePoolSpec{
+				{Name: "a", Type: "g6-standard-1", Count: 1},
+				{Name: "c", Type: "g6-standard-3", Count: 3},
+			},
+			expectedToUpdate: map[int]linodego.LKENodePoolUpdateOptions{},
+			expectedToCreate: map[string]linodego.LKENodePoolCreateOptions{},
+			expectedToDelete: []int{124},
+		},
+		{
+			name: "reorder pools",
+			oldSpecs: []lke.NodePoolSpec{
+				{ID: 123, Name: "a", Type: "g6-standard-1", Count: 1},
+				{ID: 124, Name: "b", Type: "g6-standard-2", Count: 2},
+			},
+			newSpecs: []lke.NodePoolSpec{
+				{Name: "b", Type: "g6-standard-2", Count: 2},
+				{Name: "a", Type: "g6-standard-1", Count: 1},
+			},
+			expectedToUpdate: map[int]linodego.LKENodePoolUpdateOptions{},
+			expectedToCreate: map[string]linodego.LKENodePoolCreateOptions{},
+			expectedToDelete: []int{},
+		},
+		{
+			name: "rename an unchanged pool",
+			oldSpecs: []lke.NodePoolSpec{
+				{ID: 123, Name: "pool-123", Type: "g6-standard-1", Count: 2, Tags: []string{"example"}},
+			},
+			newSpecs: []lke.NodePoolSpec{
+				{Name: "workers", Type: "g6-standard-1", Count: 2, Tags: []string{"example"}},
+			},
+			expectedToUpdate: map[int]linodego.LKENodePoolUpdateOptions{},
+			expectedToCreate: map[string]linodego.LKENodePoolCreateOptions{},
+			expectedToRename: map[string]int{"workers": 123},
+			expectedToDelete: []int{},
+		},
+		{
+			name: "rename a changed pool",
+			oldSpecs: []lke.NodePoolSpec{
+				{ID: 123, Name: "pool-123", Type: "g6-standard-1", Count: 2},
+			},
+			newSpecs: []lke.NodePoolSpec{
+				{Name: "workers", Type: "g6-standard-1", Count: 3},
+			},
+			expectedToUpdate: map[int]linodego.LKENodePoolUpdateOptions{},
+			expectedToCreate: map[string]linodego.LKENodePoolCreateOptions{
+				"workers": {Type: "g6-standard-1", Count: 3},
+			},
+			expectedToDelete: []int{123},
 		},
 	} {
 		t.Run(tc.name, func(t *testing.T) {
+			if tc.expectedToRename == nil {
+				tc.expectedToRename = map[string]int{}
+			}
+
 			updates, err := lke.ReconcileLKENodePoolSpecs(context.Background(), tc.oldSpecs, tc.newSpecs)
 			if err != nil {
 				t.Fatal(err)
@@ -160,6 +221,9 @@ func TestReconcileLKENodePoolSpecs(t *testing.T) {
 			if !reflect.DeepEqual(tc.expectedToDelete, updates.ToDelete) {
 				t.Errorf("expected to delete:\n%#v\ngot:\n%#v", tc.expectedToDelete, updates.ToDelete)
 			}
+			if !reflect.DeepEqual(tc.expectedToRename, updates.ToRename) {
+				t.Errorf("expected to rename:\n%#v\ngot:\n%#v", tc.expectedToRename, updates.ToRename)
+			}
 		})
 	}
 }
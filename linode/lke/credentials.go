@@ -0,0 +1,106 @@
+package lke
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/linode/linodego"
+	"github.com/linode/linodego/k8s"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// rotateLKEClusterCredentials regenerates the given credentials of the cluster
+// and waits until the regenerated kubeconfig can be used to access the
+// Kubernetes API server.
+func rotateLKEClusterCredentials(
+	ctx context.Context,
+	client linodego.Client,
+	pollMs int,
+	clusterID int,
+	opts linodego.LKEClusterRegenerateOptions,
+) error {
+	if !opts.KubeConfig && !opts.ServiceToken {
+		return nil
+	}
+
+	previous, err := client.GetLKEClusterKubeconfig(ctx, clusterID)
+	if err != nil {
+		return fmt.Errorf("failed to get kubeconfig for LKE Cluster %d: %w", clusterID, err)
+	}
+
+	tflog.Debug(ctx, "client.RegenerateLKECluster(...)", map[string]any{
+		"options": opts,
+	})
+
+	if _, err := client.RegenerateLKECluster(ctx, clusterID, opts); err != nil {
+		return fmt.Errorf("failed to regenerate credentials for LKE Cluster %d: %w", clusterID, err)
+	}
+
+	// The kubeconfig embeds the service account token, so it is replaced
+	// whenever either credential is regenerated.
+	return waitForLKEClusterCredentials(ctx, client, pollMs, clusterID, previous.KubeConfig)
+}
+
+// waitForLKEClusterCredentials polls the kubeconfig of the cluster until it
+// differs from the given previous kubeconfig and is accepted by the
+// Kubernetes API server.
+func waitForLKEClusterCredentials(
+	ctx context.Context,
+	client linodego.Client,
+	pollMs int,
+	clusterID int,
+	previousKubeconfig string,
+) error {
+	ticker := time.NewTicker(time.Duration(pollMs) * time.Millisecond)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ticker.C:
+			// The kubeconfig may be unavailable while it is being regenerated
+			kubeconfig, err := client.GetLKEClusterKubeconfig(ctx, clusterID)
+			if err != nil {
+				tflog.Trace(ctx, "Kubeconfig not yet available", map[string]any{
+					"error": err.Error(),
+				})
+				continue
+			}
+
+			if kubeconfig.KubeConfig == previousKubeconfig {
+				tflog.Trace(ctx, "Kubeconfig not yet regenerated")
+				continue
+			}
+
+			if err := checkLKEClusterKubeconfig(ctx, kubeconfig); err != nil {
+				tflog.Trace(ctx, "Kubeconfig not yet accepted by the API server", map[string]any{
+					"error": err.Error(),
+				})
+				continue
+			}
+
+			tflog.Debug(ctx, "Regenerated credentials are ready")
+
+			return nil
+
+		case <-ctx.Done():
+			return fmt.Errorf("failed to wait for LKE Cluster %d credentials to be ready: %w", clusterID, ctx.Err())
+		}
+	}
+}
+
+// checkLKEClusterKubeconfig makes an authenticated request to the Kubernetes
+// API server with the given kubeconfig.
+func checkLKEClusterKubeconfig(ctx context.Context, kubeconfig *linodego.LKEClusterKubeconfig) error {
+	clientset, err := k8s.BuildClientsetFromConfig(kubeconfig, nil)
+	if err != nil {
+		return err
+	}
+
+	if _, err := clientset.CoreV1().Namespaces().List(ctx, metav1.ListOptions{Limit: 1}); err != nil {
+		return fmt.Errorf("failed to list namespaces: %w", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,65 @@
+package lke
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/linode/terraform-provider-linode/v2/linode/helper"
+)
+
+func NewEphemeralResource() ephemeral.EphemeralResource {
+	return &EphemeralResource{
+		BaseEphemeralResource: helper.NewBaseEphemeralResource(
+			helper.BaseEphemeralResourceConfig{
+				Name:   "linode_lke_kubeconfig",
+				Schema: &frameworkEphemeralResourceSchema,
+			},
+		),
+	}
+}
+
+type EphemeralResource struct {
+	helper.BaseEphemeralResource
+}
+
+func (r *EphemeralResource) Open(
+	ctx context.Context,
+	req ephemeral.OpenRequest,
+	resp *ephemeral.OpenResponse,
+) {
+	tflog.Debug(ctx, "Open ephemeral.linode_lke_kubeconfig")
+
+	var data KubeconfigEphemeralModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	clusterID := helper.FrameworkSafeInt64ToInt(data.ClusterID.ValueInt64(), &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx = tflog.SetField(ctx, "cluster_id", clusterID)
+
+	tflog.Trace(ctx, "client.GetLKEClusterKubeconfig(...)")
+
+	kubeconfig, err := r.Meta.Client.GetLKEClusterKubeconfig(ctx, clusterID)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			fmt.Sprintf("Failed to get kubeconfig for LKE cluster %d", clusterID),
+			err.Error(),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(data.FlattenKubeconfig(kubeconfig.KubeConfig)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.Result.Set(ctx, &data)...)
+}
@@ -0,0 +1,32 @@
+package lke
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral/schema"
+)
+
+var frameworkEphemeralResourceSchema = schema.Schema{
+	Attributes: map[string]schema.Attribute{
+		"cluster_id": schema.Int64Attribute{
+			Description: "The ID of the LKE cluster to retrieve the kubeconfig of.",
+			Required:    true,
+		},
+		"kubeconfig": schema.StringAttribute{
+			Description: "The Base64-encoded kubeconfig for the cluster.",
+			Computed:    true,
+			Sensitive:   true,
+		},
+		"host": schema.StringAttribute{
+			Description: "The URL of the cluster's Kubernetes API server.",
+			Computed:    true,
+		},
+		"cluster_ca_certificate": schema.StringAttribute{
+			Description: "The PEM-encoded CA certificate of the cluster.",
+			Computed:    true,
+		},
+		"token": schema.StringAttribute{
+			Description: "The token used to authenticate against the cluster's Kubernetes API server.",
+			Computed:    true,
+			Sensitive:   true,
+		},
+	},
+}
@@ -235,3 +235,34 @@ func parseControlPlane(
 
 	return cp, nil
 }
+
+// KubeconfigEphemeralModel describes the Terraform data model to match the
+// linode_lke_kubeconfig ephemeral resource schema.
+type KubeconfigEphemeralModel struct {
+	ClusterID            types.Int64  `tfsdk:"cluster_id"`
+	Kubeconfig           types.String `tfsdk:"kubeconfig"`
+	Host                 types.String `tfsdk:"host"`
+	ClusterCACertificate types.String `tfsdk:"cluster_ca_certificate"`
+	Token                types.String `tfsdk:"token"`
+}
+
+func (m *KubeconfigEphemeralModel) FlattenKubeconfig(encoded string) (d diag.Diagnostics) {
+	raw, err := helper.DecodeKubeconfig(encoded)
+	if err != nil {
+		d.AddError("Failed to decode kubeconfig", err.Error())
+		return
+	}
+
+	kubeconfig, err := helper.ParseKubeconfig(raw)
+	if err != nil {
+		d.AddError("Failed to parse kubeconfig", err.Error())
+		return
+	}
+
+	m.Kubeconfig = types.StringValue(encoded)
+	m.Host = types.StringValue(kubeconfig.Host)
+	m.ClusterCACertificate = types.StringValue(kubeconfig.ClusterCACertificate)
+	m.Token = types.StringValue(kubeconfig.Token)
+
+	return
+}
@@ -0,0 +1,763 @@
+package lke
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/linode/linodego"
+	k8scondition "github.com/linode/linodego/k8s/pkg/condition"
+	"github.com/linode/terraform-provider-linode/v2/linode/helper"
+	"github.com/linode/terraform-provider-linode/v2/linode/lkenodepool"
+)
+
+const (
+	createLKETimeout = 35 * time.Minute
+	updateLKETimeout = 40 * time.Minute
+	deleteLKETimeout = 15 * time.Minute
+)
+
+func NewResource() resource.Resource {
+	return &Resource{
+		BaseResource: helper.NewBaseResource(
+			helper.BaseResourceConfig{
+				Name:   "linode_lke_cluster",
+				IDType: types.StringType,
+				Schema: &frameworkResourceSchema,
+				TimeoutOpts: &timeouts.Opts{
+					Create: true,
+					Update: true,
+					Delete: true,
+				},
+			},
+		),
+	}
+}
+
+type Resource struct {
+	helper.BaseResource
+}
+
+func (r *Resource) UpgradeState(context.Context) map[int64]resource.StateUpgrader {
+	return map[int64]resource.StateUpgrader{
+		0: {
+			PriorSchema:   &frameworkResourceSchemaV0,
+			StateUpgrader: upgradeLKEClusterStateV0toV1,
+		},
+	}
+}
+
+func upgradeLKEClusterStateV0toV1(
+	ctx context.Context, req resource.UpgradeStateRequest, resp *resource.UpgradeStateResponse,
+) {
+	var stateV0 ResourceModelV0
+	var stateV1 ResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &stateV0)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(stateV1.UpgradeFromV0(ctx, stateV0)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &stateV1)...)
+}
+
+func (r *Resource) ValidateConfig(
+	ctx context.Context,
+	req resource.ValidateConfigRequest,
+	resp *resource.ValidateConfigResponse,
+) {
+	var pools types.Set
+	var credentialRotation []CredentialRotationModel
+
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("credential_rotation"), &credentialRotation)...)
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("pool"), &pools)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	for _, rotation := range credentialRotation {
+		if rotation.Kubeconfig.Equal(types.BoolValue(false)) && rotation.ServiceToken.Equal(types.BoolValue(false)) {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("credential_rotation"),
+				"Invalid Credential Rotation",
+				"At least one of `kubeconfig` or `service_token` must be rotated",
+			)
+		}
+	}
+
+	if pools.IsNull() || pools.IsUnknown() {
+		return
+	}
+
+	names := make(map[string]bool)
+
+	for _, element := range pools.Elements() {
+		object := element.(types.Object)
+		if object.IsUnknown() {
+			continue
+		}
+
+		var pool NodePoolModel
+		resp.Diagnostics.Append(object.As(ctx, &pool, basetypes.ObjectAsOptions{})...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+
+		poolPath := path.Root("pool").AtSetValue(object)
+
+		if !pool.Name.IsNull() && !pool.Name.IsUnknown() {
+			name := pool.Name.ValueString()
+
+			if names[name] {
+				resp.Diagnostics.AddAttributeError(
+					poolPath.AtName("name"),
+					"Duplicate Node Pool Name",
+					fmt.Sprintf("pool %q: `name` must be unique within the cluster", name),
+				)
+			}
+
+			names[name] = true
+		}
+
+		// If the user hasn't defined a count but has defined an autoscaler,
+		// we can assume they're deferring the count to the autoscaler.
+		if pool.Count.IsNull() && len(pool.Autoscaler) < 1 {
+			resp.Diagnostics.AddAttributeError(
+				poolPath.AtName("count"),
+				"Missing Node Pool Count",
+				fmt.Sprintf("pool %q: `count` must be defined when no autoscaler is defined", pool.Name.ValueString()),
+			)
+		}
+	}
+}
+
+func (r *Resource) ModifyPlan(
+	ctx context.Context,
+	req resource.ModifyPlanRequest,
+	resp *resource.ModifyPlanResponse,
+) {
+	// Nothing to plan when the resource is being created or destroyed
+	if req.Plan.Raw.IsNull() || req.State.Raw.IsNull() {
+		return
+	}
+
+	var plan, state ResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if plan.PlanNodePoolNames(ctx, state, &resp.Diagnostics) {
+		resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("pool"), plan.Pool)...)
+	}
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	plan.PlanNodePools(ctx, state, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("node_pools"), plan.NodePools)...)
+
+	// Both credentials are part of the kubeconfig
+	if plan.ShouldRotateCredentials(state) {
+		resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("kubeconfig"), types.StringUnknown())...)
+	}
+}
+
+func (r *Resource) Create(
+	ctx context.Context,
+	req resource.CreateRequest,
+	resp *resource.CreateResponse,
+) {
+	tflog.Debug(ctx, "Create "+r.Config.Name)
+
+	var plan ResourceModel
+	client := r.Meta.Client
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	createTimeout, diags := plan.Timeouts.Create(ctx, createLKETimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, createTimeout)
+	defer cancel()
+
+	createOpts := plan.GetCreateOptions(ctx, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Debug(ctx, "client.CreateLKECluster(...)", map[string]any{
+		"options": createOpts,
+	})
+
+	cluster, err := client.CreateLKECluster(ctx, createOpts)
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to Create LKE Cluster", err.Error())
+		return
+	}
+
+	// Set the ID right after creation to prevent
+	// a resource leak when the waiting fails
+	resp.Diagnostics.Append(
+		resp.State.SetAttribute(ctx, path.Root("id"), types.StringValue(strconv.Itoa(cluster.ID)))...,
+	)
+
+	ctx = tflog.SetField(ctx, "cluster_id", cluster.ID)
+	tflog.Debug(ctx, "Waiting for a single LKE cluster node to be ready")
+
+	// Sometimes the K8S API will raise an EOF error if polling immediately after
+	// a cluster is created. We should retry accordingly.
+	// NOTE: This routine has a short retry period because we want to raise
+	// and meaningful errors quickly.
+	err = retry.RetryContext(ctx, time.Second*25, func() *retry.RetryError {
+		tflog.Trace(ctx, "client.WaitForLKEClusterCondition(...)", map[string]any{
+			"condition": "ClusterHasReadyNode",
+		})
+
+		err := client.WaitForLKEClusterConditions(ctx, cluster.ID, linodego.LKEClusterPollOptions{
+			TimeoutSeconds: 15 * 60,
+		}, k8scondition.ClusterHasReadyNode)
+		if err != nil {
+			return retry.RetryableError(err)
+		}
+
+		return nil
+	})
+	if err != nil {
+		resp.Diagnostics.AddError(
+			fmt.Sprintf("Failed to wait for LKE Cluster %d to have a ready node", cluster.ID),
+			err.Error(),
+		)
+		return
+	}
+
+	tflog.Trace(ctx, "client.ListLKENodePools(...)")
+
+	pools, err := client.ListLKENodePools(ctx, cluster.ID, nil)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			fmt.Sprintf("Failed to get pools for LKE Cluster %d", cluster.ID),
+			err.Error(),
+		)
+		return
+	}
+
+	poolNames := matchCreatedNodePools(plan.NodePoolSpecs(ctx, nil, &resp.Diagnostics), pools)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	plan.NameNodePools(poolNames)
+
+	r.waitForNodePoolsKubernetesReady(ctx, plan, cluster.ID, poolNames, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	r.refresh(ctx, &plan, cluster.ID, poolNames, true, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *Resource) Read(
+	ctx context.Context,
+	req resource.ReadRequest,
+	resp *resource.ReadResponse,
+) {
+	tflog.Debug(ctx, "Read "+r.Config.Name)
+
+	var state ResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if helper.FrameworkAttemptRemoveResourceForEmptyID(ctx, state.ID, resp) {
+		return
+	}
+
+	ctx = populateLogAttributes(ctx, state)
+
+	id := helper.FrameworkSafeStringToInt(state.ID.ValueString(), &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	poolNames := make(map[int]string)
+	for name, poolID := range state.NodePoolIDs(ctx, &resp.Diagnostics) {
+		poolNames[poolID] = name
+	}
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !r.refresh(ctx, &state, id, poolNames, false, &resp.Diagnostics) {
+		if !resp.Diagnostics.HasError() {
+			resp.Diagnostics.AddWarning(
+				"LKE Cluster Not Found",
+				fmt.Sprintf("Removing LKE Cluster %d from state because it no longer exists", id),
+			)
+			resp.State.RemoveResource(ctx)
+		}
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+func (r *Resource) Update(
+	ctx context.Context,
+	req resource.UpdateRequest,
+	resp *resource.UpdateResponse,
+) {
+	tflog.Debug(ctx, "Update "+r.Config.Name)
+
+	var plan, state ResourceModel
+	client := r.Meta.Client
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx = populateLogAttributes(ctx, state)
+
+	updateTimeout, diags := plan.Timeouts.Update(ctx, updateLKETimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, updateTimeout)
+	defer cancel()
+
+	id := helper.FrameworkSafeStringToInt(state.ID.ValueString(), &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	updateOpts, shouldUpdate := plan.GetUpdateOptions(ctx, state, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if shouldUpdate {
+		tflog.Debug(ctx, "client.UpdateLKECluster(...)", map[string]any{
+			"options": updateOpts,
+		})
+
+		if _, err := client.UpdateLKECluster(ctx, id, updateOpts); err != nil {
+			resp.Diagnostics.AddError(fmt.Sprintf("Failed to Update LKE Cluster %d", id), err.Error())
+			return
+		}
+	}
+
+	if !plan.K8sVersion.Equal(state.K8sVersion) {
+		tflog.Trace(ctx, "client.ListLKENodePools(...)")
+
+		pools, err := client.ListLKENodePools(ctx, id, nil)
+		if err != nil {
+			resp.Diagnostics.AddError(
+				fmt.Sprintf("Failed to get pools for LKE Cluster %d", id),
+				err.Error(),
+			)
+			return
+		}
+
+		tflog.Debug(ctx, "Implicitly recycling LKE cluster to apply Kubernetes version upgrade")
+
+		if err := recycleLKECluster(
+			ctx,
+			*client,
+			int(r.Meta.Config.EventPollMilliseconds.ValueInt64()),
+			id,
+			pools,
+			plan.GetRecycleStrategy(),
+		); err != nil {
+			resp.Diagnostics.AddError(fmt.Sprintf("Failed to Recycle LKE Cluster %d", id), err.Error())
+			return
+		}
+	}
+
+	poolIDs := r.updateNodePools(ctx, plan, state, id, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	poolNames := make(map[int]string, len(poolIDs))
+	for name, poolID := range poolIDs {
+		poolNames[poolID] = name
+	}
+
+	plan.NameNodePools(poolNames)
+
+	r.waitForNodePoolsKubernetesReady(ctx, plan, id, poolNames, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if plan.ShouldRotateCredentials(state) {
+		tflog.Debug(ctx, "Rotating LKE cluster credentials")
+
+		if err := rotateLKEClusterCredentials(
+			ctx,
+			*client,
+			int(r.Meta.Config.EventPollMilliseconds.ValueInt64()),
+			id,
+			plan.GetRegenerateOptions(),
+		); err != nil {
+			resp.Diagnostics.AddError(fmt.Sprintf("Failed to Rotate LKE Cluster %d Credentials", id), err.Error())
+			return
+		}
+	}
+
+	r.refresh(ctx, &plan, id, poolNames, true, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+// updateNodePools reconciles the node pools of the cluster with the declared
+// ones, returning the ID of every declared pool by name.
+func (r *Resource) updateNodePools(
+	ctx context.Context,
+	plan, state ResourceModel,
+	id int,
+	diags *diag.Diagnostics,
+) map[string]int {
+	client := r.Meta.Client
+
+	poolIDs := state.NodePoolIDs(ctx, diags)
+	if diags.HasError() {
+		return nil
+	}
+
+	updates, err := ReconcileLKENodePoolSpecs(
+		ctx,
+		state.NodePoolSpecs(ctx, poolIDs, diags),
+		plan.NodePoolSpecs(ctx, nil, diags),
+	)
+	if err != nil {
+		diags.AddError("Failed to reconcile LKE cluster node pools", err.Error())
+		return nil
+	}
+
+	if diags.HasError() {
+		return nil
+	}
+
+	tflog.Trace(ctx, "Reconciled LKE cluster node pool updates", map[string]any{
+		"updates": updates,
+	})
+
+	updatedIDs := []int{}
+
+	for poolID, updateOpts := range updates.ToUpdate {
+		tflog.Debug(ctx, "client.UpdateLKENodePool(...)", map[string]any{
+			"node_pool_id": poolID,
+			"options":      updateOpts,
+		})
+
+		if _, err := client.UpdateLKENodePool(ctx, id, poolID, updateOpts); err != nil {
+			diags.AddError(fmt.Sprintf("Failed to Update LKE Cluster %d Pool %d", id, poolID), err.Error())
+			return nil
+		}
+
+		updatedIDs = append(updatedIDs, poolID)
+	}
+
+	for name, poolID := range updates.ToRename {
+		poolIDs[name] = poolID
+	}
+
+	for name, createOpts := range updates.ToCreate {
+		tflog.Debug(ctx, "client.CreateLKENodePool(...)", map[string]any{
+			"name":    name,
+			"options": createOpts,
+		})
+
+		pool, err := client.CreateLKENodePool(ctx, id, createOpts)
+		if err != nil {
+			diags.AddError(fmt.Sprintf("Failed to Create LKE Cluster %d Pool %q", id, name), err.Error())
+			return nil
+		}
+
+		poolIDs[name] = pool.ID
+		updatedIDs = append(updatedIDs, pool.ID)
+	}
+
+	for _, poolID := range updates.ToDelete {
+		tflog.Debug(ctx, "client.DeleteLKENodePool(...)", map[string]any{
+			"node_pool_id": poolID,
+		})
+
+		if err := client.DeleteLKENodePool(ctx, id, poolID); err != nil {
+			diags.AddError(fmt.Sprintf("Failed to Delete LKE Cluster %d Pool %d", id, poolID), err.Error())
+			return nil
+		}
+	}
+
+	tflog.Debug(ctx, "Waiting for all updated node pools to be ready")
+
+	for _, poolID := range updatedIDs {
+		tflog.Trace(ctx, "Waiting for node pool to be ready", map[string]any{
+			"node_pool_id": poolID,
+		})
+
+		if _, err := lkenodepool.WaitForNodePoolReady(
+			ctx,
+			*client,
+			int(r.Meta.Config.LKENodeReadyPollMilliseconds.ValueInt64()),
+			id,
+			poolID,
+		); err != nil {
+			diags.AddError(fmt.Sprintf("Failed to wait for LKE Cluster %d Pool %d ready", id, poolID), err.Error())
+			return nil
+		}
+	}
+
+	// Only keep the pools that are still declared
+	result := make(map[string]int, len(plan.Pool))
+	for i := range plan.Pool {
+		name := plan.nodePoolName(i)
+		if poolID, ok := poolIDs[name]; ok {
+			result[name] = poolID
+		}
+	}
+
+	return result
+}
+
+// waitForNodePoolsKubernetesReady waits for the nodes of every declared pool with
+// wait_for_kubernetes_ready set to be ready in Kubernetes.
+func (r *Resource) waitForNodePoolsKubernetesReady(
+	ctx context.Context,
+	plan ResourceModel,
+	id int,
+	poolNames map[int]string,
+	diags *diag.Diagnostics,
+) {
+	client := r.Meta.Client
+
+	waitPools := make(map[string]bool)
+	for _, pool := range plan.Pool {
+		if pool.WaitForKubernetesReady.ValueBool() {
+			waitPools[pool.Name.ValueString()] = true
+		}
+	}
+
+	for poolID, name := range poolNames {
+		if !waitPools[name] {
+			continue
+		}
+
+		tflog.Debug(ctx, "Waiting for node pool nodes to be ready in Kubernetes", map[string]any{
+			"node_pool_id": poolID,
+		})
+
+		// The nodes of the pool must be known before they can be found in Kubernetes
+		pool, err := lkenodepool.WaitForNodePoolReady(
+			ctx,
+			*client,
+			int(r.Meta.Config.LKENodeReadyPollMilliseconds.ValueInt64()),
+			id,
+			poolID,
+		)
+		if err != nil {
+			diags.AddError(fmt.Sprintf("Failed to wait for LKE Cluster %d Pool %d ready", id, poolID), err.Error())
+			return
+		}
+
+		if err := lkenodepool.WaitForNodePoolKubernetesReady(ctx, *client, id, pool); err != nil {
+			diags.AddError(
+				fmt.Sprintf("Failed to wait for LKE Cluster %d Pool %d ready in Kubernetes", id, poolID),
+				err.Error(),
+			)
+			return
+		}
+	}
+}
+
+func (r *Resource) Delete(
+	ctx context.Context,
+	req resource.DeleteRequest,
+	resp *resource.DeleteResponse,
+) {
+	tflog.Debug(ctx, "Delete "+r.Config.Name)
+
+	var state ResourceModel
+	client := r.Meta.Client
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx = populateLogAttributes(ctx, state)
+
+	deleteTimeout, diags := state.Timeouts.Delete(ctx, deleteLKETimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, deleteTimeout)
+	defer cancel()
+
+	id := helper.FrameworkSafeStringToInt(state.ID.ValueString(), &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Debug(ctx, "client.DeleteLKECluster(...)")
+
+	if err := client.DeleteLKECluster(ctx, id); err != nil {
+		if linodego.IsNotFound(err) {
+			return
+		}
+
+		resp.Diagnostics.AddError(fmt.Sprintf("Failed to Delete LKE Cluster %d", id), err.Error())
+		return
+	}
+
+	timeoutSeconds := helper.FrameworkSafeFloat64ToInt(deleteTimeout.Seconds(), &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Debug(ctx, "Deleted LKE cluster, waiting for all nodes deleted...")
+	tflog.Trace(ctx, "client.WaitForLKEClusterStatus(...)", map[string]any{
+		"status":  "not_ready",
+		"timeout": timeoutSeconds,
+	})
+
+	if _, err := client.WaitForLKEClusterStatus(ctx, id, "not_ready", timeoutSeconds); err != nil {
+		// If we're getting a 404, it's safe to say the cluster has been
+		// deleted.
+		if linodego.IsNotFound(err) {
+			return
+		}
+
+		resp.Diagnostics.AddError(fmt.Sprintf("Failed to wait for LKE Cluster %d to be deleted", id), err.Error())
+	}
+}
+
+// refresh fetches the cluster and everything derived from it into the given
+// data, returning false if the cluster no longer exists.
+func (r *Resource) refresh(
+	ctx context.Context,
+	data *ResourceModel,
+	id int,
+	poolNames map[int]string,
+	preserveKnown bool,
+	diags *diag.Diagnostics,
+) bool {
+	client := r.Meta.Client
+
+	cluster, err := client.GetLKECluster(ctx, id)
+	if err != nil {
+		if linodego.IsNotFound(err) {
+			return false
+		}
+
+		diags.AddError(fmt.Sprintf("Failed to get LKE Cluster %d", id), err.Error())
+		return false
+	}
+
+	tflog.Trace(ctx, "client.ListLKENodePools(...)")
+
+	pools, err := client.ListLKENodePools(ctx, id, nil)
+	if err != nil {
+		diags.AddError(fmt.Sprintf("Failed to get pools for LKE Cluster %d", id), err.Error())
+		return false
+	}
+
+	if !data.ExternalPoolTags.IsNull() && !data.ExternalPoolTags.IsUnknown() && len(pools) > 0 {
+		var externalPoolTags []string
+		diags.Append(data.ExternalPoolTags.ElementsAs(ctx, &externalPoolTags, false)...)
+		if diags.HasError() {
+			return false
+		}
+
+		pools = filterExternalPools(ctx, externalPoolTags, pools)
+	}
+
+	kubeconfig, err := client.GetLKEClusterKubeconfig(ctx, id)
+	if err != nil {
+		diags.AddError(fmt.Sprintf("Failed to get kubeconfig for LKE Cluster %d", id), err.Error())
+		return false
+	}
+
+	tflog.Trace(ctx, "client.ListLKEClusterAPIEndpoints(...)")
+
+	endpoints, err := client.ListLKEClusterAPIEndpoints(ctx, id, nil)
+	if err != nil {
+		diags.AddError(fmt.Sprintf("Failed to get API endpoints for LKE Cluster %d", id), err.Error())
+		return false
+	}
+
+	acl, err := client.GetLKEClusterControlPlaneACL(ctx, id)
+	if err != nil {
+		if lerr, ok := err.(*linodego.Error); ok &&
+			(lerr.Code == 404 ||
+				(lerr.Code == 400 && strings.Contains(lerr.Message, "Cluster does not support Control Plane ACL"))) {
+			// The customer doesn't have access to LKE ACL or the cluster does not have a Gateway. Nothing to do here.
+		} else {
+			diags.AddError(fmt.Sprintf("Failed to get control plane ACL for LKE Cluster %d", id), err.Error())
+			return false
+		}
+	}
+
+	dashboard, err := client.GetLKEClusterDashboard(ctx, id)
+	if err != nil {
+		diags.AddError(fmt.Sprintf("Failed to get dashboard URL for LKE Cluster %d", id), err.Error())
+		return false
+	}
+
+	data.FlattenCluster(
+		ctx, cluster, pools, poolNames, kubeconfig, endpoints, acl, dashboard, preserveKnown, diags,
+	)
+
+	return !diags.HasError()
+}
+
+func populateLogAttributes(ctx context.Context, data ResourceModel) context.Context {
+	return helper.SetLogFieldBulk(ctx, map[string]any{
+		"cluster_id": data.ID.ValueString(),
+	})
+}
@@ -0,0 +1,927 @@
+package lke
+
+import (
+	"context"
+	"fmt"
+	"maps"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+	"github.com/linode/linodego"
+	"github.com/linode/terraform-provider-linode/v2/linode/helper"
+	"github.com/linode/terraform-provider-linode/v2/linode/lkenodepool"
+)
+
+// ResourceModel describes the Terraform resource data model to match the
+// resource schema.
+type ResourceModel struct {
+	ID                types.String `tfsdk:"id"`
+	Label             types.String `tfsdk:"label"`
+	K8sVersion        types.String `tfsdk:"k8s_version"`
+	Tags              types.Set    `tfsdk:"tags"`
+	ExternalPoolTags  types.Set    `tfsdk:"external_pool_tags"`
+	Region            types.String `tfsdk:"region"`
+	APIEndpoints      types.List   `tfsdk:"api_endpoints"`
+	Kubeconfig        types.String `tfsdk:"kubeconfig"`
+	DashboardURL      types.String `tfsdk:"dashboard_url"`
+	Status            types.String `tfsdk:"status"`
+	Tier              types.String `tfsdk:"tier"`
+	NodePools         types.Map    `tfsdk:"node_pools"`
+	RotateCredentials types.Map    `tfsdk:"rotate_credentials"`
+
+	Pool               []NodePoolModel           `tfsdk:"pool"`
+	RecycleStrategy    []RecycleStrategyModel    `tfsdk:"recycle_strategy"`
+	CredentialRotation []CredentialRotationModel `tfsdk:"credential_rotation"`
+	ControlPlane       []LKEControlPlane         `tfsdk:"control_plane"`
+
+	Timeouts timeouts.Value `tfsdk:"timeouts"`
+}
+
+// NodePoolModel describes a node pool as declared in the pool block.
+type NodePoolModel struct {
+	Name       types.String                          `tfsdk:"name"`
+	Type       types.String                          `tfsdk:"type"`
+	Count      types.Int64                           `tfsdk:"count"`
+	Tags       types.Set                             `tfsdk:"tags"`
+	Labels     types.Map                             `tfsdk:"labels"`
+	Taints     []lkenodepool.NodePoolTaintModel      `tfsdk:"taint"`
+	Autoscaler []lkenodepool.NodePoolAutoscalerModel `tfsdk:"autoscaler"`
+
+	WaitForKubernetesReady types.Bool `tfsdk:"wait_for_kubernetes_ready"`
+}
+
+// NodePoolStateModel describes a node pool as reported by the API
+// in the node_pools attribute.
+type NodePoolStateModel struct {
+	ID             types.Int64       `tfsdk:"id"`
+	Count          types.Int64       `tfsdk:"count"`
+	Type           types.String      `tfsdk:"type"`
+	DiskEncryption types.String      `tfsdk:"disk_encryption"`
+	Nodes          []LKENodePoolNode `tfsdk:"nodes"`
+}
+
+type RecycleStrategyModel struct {
+	Type           types.String `tfsdk:"type"`
+	MaxUnavailable types.Int64  `tfsdk:"max_unavailable"`
+}
+
+type CredentialRotationModel struct {
+	Kubeconfig   types.Bool `tfsdk:"kubeconfig"`
+	ServiceToken types.Bool `tfsdk:"service_token"`
+}
+
+// ResourceModelV0 describes the state written by the SDKv2 implementation of
+// this resource.
+type ResourceModelV0 struct {
+	ID               types.String `tfsdk:"id"`
+	Label            types.String `tfsdk:"label"`
+	K8sVersion       types.String `tfsdk:"k8s_version"`
+	Tags             types.Set    `tfsdk:"tags"`
+	ExternalPoolTags types.Set    `tfsdk:"external_pool_tags"`
+	Region           types.String `tfsdk:"region"`
+	APIEndpoints     types.List   `tfsdk:"api_endpoints"`
+	Kubeconfig       types.String `tfsdk:"kubeconfig"`
+	DashboardURL     types.String `tfsdk:"dashboard_url"`
+	Status           types.String `tfsdk:"status"`
+	Tier             types.String `tfsdk:"tier"`
+
+	Pool            []NodePoolModelV0      `tfsdk:"pool"`
+	RecycleStrategy []RecycleStrategyModel `tfsdk:"recycle_strategy"`
+	ControlPlane    []LKEControlPlane      `tfsdk:"control_plane"`
+
+	Timeouts timeouts.Value `tfsdk:"timeouts"`
+}
+
+type NodePoolModelV0 struct {
+	ID             types.Int64                           `tfsdk:"id"`
+	Count          types.Int64                           `tfsdk:"count"`
+	Type           types.String                          `tfsdk:"type"`
+	Labels         types.Map                             `tfsdk:"labels"`
+	Tags           types.Set                             `tfsdk:"tags"`
+	DiskEncryption types.String                          `tfsdk:"disk_encryption"`
+	Nodes          types.List                            `tfsdk:"nodes"`
+	Taints         []lkenodepool.NodePoolTaintModel      `tfsdk:"taint"`
+	Autoscaler     []lkenodepool.NodePoolAutoscalerModel `tfsdk:"autoscaler"`
+}
+
+// defaultNodePoolName returns the name given to node pools that are
+// not declared under a name, e.g. after an import.
+func defaultNodePoolName(id int) string {
+	return fmt.Sprintf("pool-%d", id)
+}
+
+// nodePoolName returns the name of the i-th declared pool. Pools that aren't
+// named yet are only named after their ID once they are created, so they are
+// identified by their position until then.
+func (data *ResourceModel) nodePoolName(i int) string {
+	name := data.Pool[i].Name
+	if name.IsNull() || name.IsUnknown() {
+		return fmt.Sprintf("<unnamed pool %d>", i)
+	}
+
+	return name.ValueString()
+}
+
+func (data *ResourceModel) FlattenCluster(
+	ctx context.Context,
+	cluster *linodego.LKECluster,
+	pools []linodego.LKENodePool,
+	poolNames map[int]string,
+	kubeconfig *linodego.LKEClusterKubeconfig,
+	endpoints []linodego.LKEClusterAPIEndpoint,
+	acl *linodego.LKEClusterControlPlaneACLResponse,
+	dashboard *linodego.LKEClusterDashboard,
+	preserveKnown bool,
+	diags *diag.Diagnostics,
+) {
+	// Only the ID is known when the resource is being imported
+	importing := data.Label.IsNull()
+
+	data.ID = helper.KeepOrUpdateString(data.ID, strconv.Itoa(cluster.ID), preserveKnown)
+	data.Label = helper.KeepOrUpdateString(data.Label, cluster.Label, preserveKnown)
+	data.K8sVersion = helper.KeepOrUpdateString(data.K8sVersion, cluster.K8sVersion, preserveKnown)
+	data.Region = helper.KeepOrUpdateString(data.Region, cluster.Region, preserveKnown)
+	data.Status = helper.KeepOrUpdateString(data.Status, string(cluster.Status), preserveKnown)
+	data.Tier = helper.KeepOrUpdateString(data.Tier, cluster.Tier, preserveKnown)
+	data.Kubeconfig = helper.KeepOrUpdateString(data.Kubeconfig, kubeconfig.KubeConfig, preserveKnown)
+	data.DashboardURL = helper.KeepOrUpdateString(data.DashboardURL, dashboard.URL, preserveKnown)
+
+	data.Tags = helper.KeepOrUpdateStringSet(data.Tags, cluster.Tags, preserveKnown, diags)
+	if diags.HasError() {
+		return
+	}
+
+	apiEndpoints := make([]string, len(endpoints))
+	for i, endpoint := range endpoints {
+		apiEndpoints[i] = endpoint.Endpoint
+	}
+
+	endpointsList, newDiags := types.ListValueFrom(ctx, types.StringType, apiEndpoints)
+	diags.Append(newDiags...)
+	if diags.HasError() {
+		return
+	}
+
+	data.APIEndpoints = helper.KeepOrUpdateValue(data.APIEndpoints, endpointsList, preserveKnown)
+
+	data.flattenNodePools(ctx, pools, poolNames, preserveKnown, diags)
+	if diags.HasError() {
+		return
+	}
+
+	data.flattenControlPlane(ctx, cluster.ControlPlane, acl, importing, preserveKnown, diags)
+}
+
+func (data *ResourceModel) flattenNodePools(
+	ctx context.Context,
+	pools []linodego.LKENodePool,
+	poolNames map[int]string,
+	preserveKnown bool,
+	diags *diag.Diagnostics,
+) {
+	declaredPools := make(map[string]NodePoolModel, len(data.Pool))
+	for _, pool := range data.Pool {
+		declaredPools[pool.Name.ValueString()] = pool
+	}
+
+	knownNodePools := make(map[string]attr.Value)
+	if !data.NodePools.IsNull() && !data.NodePools.IsUnknown() {
+		knownNodePools = data.NodePools.Elements()
+	}
+
+	resultPools := make([]NodePoolModel, 0, len(pools))
+	nodePools := make(map[string]attr.Value, len(pools))
+
+	for _, pool := range pools {
+		name, ok := poolNames[pool.ID]
+		if !ok {
+			name = defaultNodePoolName(pool.ID)
+		}
+
+		declared, declaredOK := declaredPools[name]
+		resultPools = append(resultPools, flattenNodePool(ctx, name, declared, declaredOK, pool, diags))
+
+		nodePool := flattenNodePoolState(ctx, pool, diags)
+		if diags.HasError() {
+			return
+		}
+
+		if known, ok := knownNodePools[name]; ok {
+			nodePool = helper.KeepOrUpdateValue(known.(types.Object), nodePool, preserveKnown)
+		}
+
+		nodePools[name] = nodePool
+	}
+
+	// The declared pools can't be computed, so they are only refreshed on read
+	if !preserveKnown {
+		data.Pool = resultPools
+	}
+
+	nodePoolsMap, newDiags := types.MapValue(nodePoolObjectType, nodePools)
+	diags.Append(newDiags...)
+
+	data.NodePools = nodePoolsMap
+}
+
+// flattenNodePool returns the declared form of a pool reported by the API,
+// keeping declared values that are equivalent to the reported ones.
+func flattenNodePool(
+	ctx context.Context,
+	name string,
+	declared NodePoolModel,
+	declaredOK bool,
+	pool linodego.LKENodePool,
+	diags *diag.Diagnostics,
+) NodePoolModel {
+	result := NodePoolModel{
+		Name:  types.StringValue(name),
+		Type:  types.StringValue(pool.Type),
+		Count: types.Int64Value(int64(pool.Count)),
+
+		// wait_for_kubernetes_ready is not returned by the API
+		WaitForKubernetesReady: declared.WaitForKubernetesReady,
+	}
+
+	if !declaredOK {
+		result.WaitForKubernetesReady = types.BoolNull()
+	}
+
+	// The count of autoscaled pools drifts on its own
+	if declaredOK && pool.Autoscaler.Enabled {
+		result.Count = declared.Count
+	}
+
+	result.Tags = types.SetNull(types.StringType)
+	switch {
+	case stringSetEqualFold(ctx, declared.Tags, pool.Tags, diags):
+		result.Tags = declared.Tags
+	case len(pool.Tags) > 0 || (declaredOK && !declared.Tags.IsNull()):
+		tags, newDiags := types.SetValueFrom(ctx, types.StringType, pool.Tags)
+		diags.Append(newDiags...)
+		result.Tags = tags
+	}
+
+	result.Labels = types.MapNull(types.StringType)
+	if len(pool.Labels) > 0 || (declaredOK && !declared.Labels.IsNull()) {
+		labels, newDiags := types.MapValueFrom(ctx, types.StringType, pool.Labels)
+		diags.Append(newDiags...)
+		result.Labels = labels
+	}
+
+	if len(pool.Taints) > 0 {
+		result.Taints = make([]lkenodepool.NodePoolTaintModel, len(pool.Taints))
+		for i, taint := range pool.Taints {
+			result.Taints[i].FlattenLKENodePoolTaint(taint, false)
+		}
+	}
+
+	if pool.Autoscaler.Enabled {
+		result.Autoscaler = []lkenodepool.NodePoolAutoscalerModel{
+			{
+				Min: types.Int64Value(int64(pool.Autoscaler.Min)),
+				Max: types.Int64Value(int64(pool.Autoscaler.Max)),
+			},
+		}
+	}
+
+	return result
+}
+
+func flattenNodePoolState(ctx context.Context, pool linodego.LKENodePool, diags *diag.Diagnostics) types.Object {
+	state := NodePoolStateModel{
+		ID:             types.Int64Value(int64(pool.ID)),
+		Count:          types.Int64Value(int64(pool.Count)),
+		Type:           types.StringValue(pool.Type),
+		DiskEncryption: types.StringValue(string(pool.DiskEncryption)),
+		Nodes:          make([]LKENodePoolNode, len(pool.Linodes)),
+	}
+
+	for i, node := range pool.Linodes {
+		state.Nodes[i] = LKENodePoolNode{
+			ID:         types.StringValue(node.ID),
+			InstanceID: types.Int64Value(int64(node.InstanceID)),
+			Status:     types.StringValue(string(node.Status)),
+		}
+	}
+
+	result, newDiags := types.ObjectValueFrom(ctx, nodePoolObjectType.AttrTypes, state)
+	diags.Append(newDiags...)
+
+	return result
+}
+
+// stringSetEqualFold returns whether a known set holds the given strings,
+// ignoring case.
+func stringSetEqualFold(ctx context.Context, set types.Set, values []string, diags *diag.Diagnostics) bool {
+	if set.IsNull() || set.IsUnknown() {
+		return false
+	}
+
+	var elements []string
+	diags.Append(set.ElementsAs(ctx, &elements, false)...)
+
+	toLower := func(s []string) []string {
+		result := make([]string, len(s))
+		for i, v := range s {
+			result[i] = strings.ToLower(v)
+		}
+		return result
+	}
+
+	return helper.CompareStringSets(toLower(elements), toLower(values))
+}
+
+// controlPlaneCustomized returns whether the control plane of a cluster differs
+// from the defaults, in which case it is tracked even when it isn't declared yet.
+func controlPlaneCustomized(
+	controlPlane linodego.LKEClusterControlPlane,
+	acl *linodego.LKEClusterControlPlaneACLResponse,
+) bool {
+	return controlPlane.HighAvailability || (acl != nil && acl.ACL.Enabled)
+}
+
+func (data *ResourceModel) flattenControlPlane(
+	ctx context.Context,
+	controlPlane linodego.LKEClusterControlPlane,
+	aclResp *linodego.LKEClusterControlPlaneACLResponse,
+	importing bool,
+	preserveKnown bool,
+	diags *diag.Diagnostics,
+) {
+	// The control_plane block can't be computed, so it is only tracked once declared
+	if len(data.ControlPlane) < 1 {
+		if !importing || !controlPlaneCustomized(controlPlane, aclResp) {
+			return
+		}
+
+		data.ControlPlane = []LKEControlPlane{{}}
+
+		if aclResp != nil && aclResp.ACL.Enabled {
+			data.ControlPlane[0].ACL = []LKEControlPlaneACL{{}}
+
+			if aclResp.ACL.Addresses != nil {
+				data.ControlPlane[0].ACL[0].Addresses = []LKEControlPlaneACLAddresses{{}}
+			}
+		}
+	}
+
+	cp := &data.ControlPlane[0]
+	cp.HighAvailability = helper.KeepOrUpdateBool(cp.HighAvailability, controlPlane.HighAvailability, preserveKnown)
+
+	if len(cp.ACL) < 1 {
+		return
+	}
+
+	// The ACL is reported as disabled when the account doesn't have access to it
+	var acl linodego.LKEClusterControlPlaneACL
+	if aclResp != nil {
+		acl = aclResp.ACL
+	}
+
+	cpACL := &cp.ACL[0]
+	cpACL.Enabled = helper.KeepOrUpdateBool(cpACL.Enabled, acl.Enabled, preserveKnown)
+
+	if len(cpACL.Addresses) < 1 {
+		return
+	}
+
+	var addresses linodego.LKEClusterControlPlaneACLAddresses
+	if acl.Addresses != nil {
+		addresses = *acl.Addresses
+	}
+
+	cpAddresses := &cpACL.Addresses[0]
+	cpAddresses.IPv4 = helper.KeepOrUpdateStringSet(cpAddresses.IPv4, addresses.IPv4, preserveKnown, diags)
+	cpAddresses.IPv6 = helper.KeepOrUpdateStringSet(cpAddresses.IPv6, addresses.IPv6, preserveKnown, diags)
+}
+
+// NodePoolIDs returns the ID of every known node pool by name.
+func (data *ResourceModel) NodePoolIDs(ctx context.Context, diags *diag.Diagnostics) map[string]int {
+	result := make(map[string]int)
+
+	if data.NodePools.IsNull() || data.NodePools.IsUnknown() {
+		return result
+	}
+
+	for name, value := range data.NodePools.Elements() {
+		object := value.(types.Object)
+		if object.IsNull() || object.IsUnknown() {
+			continue
+		}
+
+		var nodePool NodePoolStateModel
+		diags.Append(object.As(ctx, &nodePool, basetypes.ObjectAsOptions{})...)
+		if diags.HasError() {
+			return nil
+		}
+
+		result[name] = helper.FrameworkSafeInt64ToInt(nodePool.ID.ValueInt64(), diags)
+	}
+
+	return result
+}
+
+// NodePoolSpecs returns the spec of every declared node pool, using the
+// given IDs of the pools that already exist.
+func (data *ResourceModel) NodePoolSpecs(
+	ctx context.Context, ids map[string]int, diags *diag.Diagnostics,
+) []NodePoolSpec {
+	result := make([]NodePoolSpec, 0, len(data.Pool))
+
+	for i, pool := range data.Pool {
+		name := data.nodePoolName(i)
+
+		spec := NodePoolSpec{
+			ID:     ids[name],
+			Name:   name,
+			Type:   pool.Type.ValueString(),
+			Count:  helper.FrameworkSafeInt64ToInt(pool.Count.ValueInt64(), diags),
+			Tags:   make([]string, 0),
+			Labels: make(map[string]string),
+		}
+
+		if !pool.Tags.IsNull() && !pool.Tags.IsUnknown() {
+			diags.Append(pool.Tags.ElementsAs(ctx, &spec.Tags, false)...)
+		}
+
+		if !pool.Labels.IsNull() && !pool.Labels.IsUnknown() {
+			diags.Append(pool.Labels.ElementsAs(ctx, &spec.Labels, false)...)
+		}
+
+		for _, taint := range pool.Taints {
+			spec.Taints = append(spec.Taints, linodego.LKENodePoolTaint{
+				Key:    taint.Key.ValueString(),
+				Value:  taint.Value.ValueString(),
+				Effect: linodego.LKENodePoolTaintEffect(taint.Effect.ValueString()),
+			})
+		}
+
+		if len(pool.Autoscaler) > 0 {
+			spec.AutoScalerEnabled = true
+			spec.AutoScalerMin = helper.FrameworkSafeInt64ToInt(pool.Autoscaler[0].Min.ValueInt64(), diags)
+			spec.AutoScalerMax = helper.FrameworkSafeInt64ToInt(pool.Autoscaler[0].Max.ValueInt64(), diags)
+		}
+
+		if diags.HasError() {
+			return nil
+		}
+
+		result = append(result, spec)
+	}
+
+	return result
+}
+
+func (data *ResourceModel) GetCreateOptions(
+	ctx context.Context, diags *diag.Diagnostics,
+) linodego.LKEClusterCreateOptions {
+	createOpts := linodego.LKEClusterCreateOptions{
+		Label:      data.Label.ValueString(),
+		Region:     data.Region.ValueString(),
+		K8sVersion: data.K8sVersion.ValueString(),
+		Tier:       data.Tier.ValueString(),
+	}
+
+	if !data.Tags.IsNull() && !data.Tags.IsUnknown() {
+		diags.Append(data.Tags.ElementsAs(ctx, &createOpts.Tags, false)...)
+	}
+
+	if len(data.ControlPlane) > 0 {
+		controlPlane := data.ControlPlane[0].getControlPlaneOptions(ctx, diags)
+		createOpts.ControlPlane = &controlPlane
+	}
+
+	updates, err := ReconcileLKENodePoolSpecs(ctx, nil, data.NodePoolSpecs(ctx, nil, diags))
+	if err != nil {
+		diags.AddError("Failed to expand LKE cluster node pools", err.Error())
+		return createOpts
+	}
+
+	for _, name := range slices.Sorted(maps.Keys(updates.ToCreate)) {
+		createOpts.NodePools = append(createOpts.NodePools, updates.ToCreate[name])
+	}
+
+	return createOpts
+}
+
+// GetUpdateOptions returns the options to update the cluster from the given
+// state, and whether any of them changed.
+func (data *ResourceModel) GetUpdateOptions(
+	ctx context.Context, state ResourceModel, diags *diag.Diagnostics,
+) (linodego.LKEClusterUpdateOptions, bool) {
+	var updateOpts linodego.LKEClusterUpdateOptions
+	shouldUpdate := false
+
+	if !data.Label.Equal(state.Label) {
+		updateOpts.Label = data.Label.ValueString()
+		shouldUpdate = true
+	}
+
+	if !data.K8sVersion.Equal(state.K8sVersion) {
+		updateOpts.K8sVersion = data.K8sVersion.ValueString()
+		shouldUpdate = true
+	}
+
+	if !data.Tags.Equal(state.Tags) {
+		tags := make([]string, 0)
+		diags.Append(data.Tags.ElementsAs(ctx, &tags, false)...)
+		updateOpts.Tags = &tags
+		shouldUpdate = true
+	}
+
+	if len(data.ControlPlane) > 0 && !controlPlanesEqual(data.ControlPlane, state.ControlPlane) {
+		controlPlane := data.ControlPlane[0].getControlPlaneOptions(ctx, diags)
+		updateOpts.ControlPlane = &controlPlane
+		shouldUpdate = true
+	}
+
+	return updateOpts, shouldUpdate
+}
+
+func controlPlanesEqual(a, b []LKEControlPlane) bool {
+	return slices.EqualFunc(a, b, func(a, b LKEControlPlane) bool {
+		return a.HighAvailability.Equal(b.HighAvailability) &&
+			slices.EqualFunc(a.ACL, b.ACL, func(a, b LKEControlPlaneACL) bool {
+				return a.Enabled.Equal(b.Enabled) &&
+					slices.EqualFunc(a.Addresses, b.Addresses, func(a, b LKEControlPlaneACLAddresses) bool {
+						return a.IPv4.Equal(b.IPv4) && a.IPv6.Equal(b.IPv6)
+					})
+			})
+	})
+}
+
+func (cp *LKEControlPlane) getControlPlaneOptions(
+	ctx context.Context, diags *diag.Diagnostics,
+) linodego.LKEClusterControlPlaneOptions {
+	var result linodego.LKEClusterControlPlaneOptions
+
+	if !cp.HighAvailability.IsNull() && !cp.HighAvailability.IsUnknown() {
+		result.HighAvailability = cp.HighAvailability.ValueBoolPointer()
+	}
+
+	if len(cp.ACL) < 1 {
+		return result
+	}
+
+	acl := cp.ACL[0]
+	result.ACL = &linodego.LKEClusterControlPlaneACLOptions{}
+
+	if !acl.Enabled.IsNull() && !acl.Enabled.IsUnknown() {
+		result.ACL.Enabled = acl.Enabled.ValueBoolPointer()
+	}
+
+	if len(acl.Addresses) < 1 {
+		return result
+	}
+
+	addresses := acl.Addresses[0]
+	result.ACL.Addresses = &linodego.LKEClusterControlPlaneACLAddressesOptions{}
+
+	if !addresses.IPv4.IsNull() && !addresses.IPv4.IsUnknown() {
+		ipv4 := make([]string, 0)
+		diags.Append(addresses.IPv4.ElementsAs(ctx, &ipv4, false)...)
+		result.ACL.Addresses.IPv4 = &ipv4
+	}
+
+	if !addresses.IPv6.IsNull() && !addresses.IPv6.IsUnknown() {
+		ipv6 := make([]string, 0)
+		diags.Append(addresses.IPv6.ElementsAs(ctx, &ipv6, false)...)
+		result.ACL.Addresses.IPv6 = &ipv6
+	}
+
+	return result
+}
+
+func (data *ResourceModel) GetRecycleStrategy() lkenodepool.RecycleStrategy {
+	result := lkenodepool.RecycleStrategy{
+		Type:           lkenodepool.RecycleStrategyCluster,
+		MaxUnavailable: lkenodepool.DefaultRecycleMaxUnavailable,
+	}
+
+	if len(data.RecycleStrategy) < 1 {
+		return result
+	}
+
+	strategy := data.RecycleStrategy[0]
+
+	if v := strategy.Type.ValueString(); v != "" {
+		result.Type = v
+	}
+
+	if v := int(strategy.MaxUnavailable.ValueInt64()); v > 0 {
+		result.MaxUnavailable = v
+	}
+
+	return result
+}
+
+// ShouldRotateCredentials returns whether rotate_credentials changed since the
+// given state, in which case the credentials of the cluster are regenerated.
+func (data *ResourceModel) ShouldRotateCredentials(state ResourceModel) bool {
+	return !data.RotateCredentials.IsNull() && !data.RotateCredentials.Equal(state.RotateCredentials)
+}
+
+func (data *ResourceModel) GetRegenerateOptions() linodego.LKEClusterRegenerateOptions {
+	result := linodego.LKEClusterRegenerateOptions{
+		KubeConfig:   true,
+		ServiceToken: true,
+	}
+
+	if len(data.CredentialRotation) < 1 {
+		return result
+	}
+
+	rotation := data.CredentialRotation[0]
+
+	if !rotation.Kubeconfig.IsNull() && !rotation.Kubeconfig.IsUnknown() {
+		result.KubeConfig = rotation.Kubeconfig.ValueBool()
+	}
+
+	if !rotation.ServiceToken.IsNull() && !rotation.ServiceToken.IsUnknown() {
+		result.ServiceToken = rotation.ServiceToken.ValueBool()
+	}
+
+	return result
+}
+
+// PlanNodePoolNames names the declared pools without a name after a pool of the
+// same type in the state that isn't declared under another name, preferring
+// pools with an unchanged spec. The remaining pools are created and named after
+// their ID. It returns whether any pool was named.
+func (data *ResourceModel) PlanNodePoolNames(ctx context.Context, state ResourceModel, diags *diag.Diagnostics) bool {
+	declaredNames := make(map[string]bool, len(data.Pool))
+	unnamed := make([]int, 0)
+
+	for i, pool := range data.Pool {
+		if pool.Name.IsNull() || pool.Name.IsUnknown() {
+			if !pool.Type.IsUnknown() {
+				unnamed = append(unnamed, i)
+			}
+			continue
+		}
+
+		declaredNames[pool.Name.ValueString()] = true
+	}
+
+	if len(unnamed) < 1 {
+		return false
+	}
+
+	ids := state.NodePoolIDs(ctx, diags)
+	oldSpecs := state.NodePoolSpecs(ctx, ids, diags)
+	newSpecs := data.NodePoolSpecs(ctx, nil, diags)
+	if diags.HasError() {
+		return false
+	}
+
+	candidates := slices.DeleteFunc(oldSpecs, func(spec NodePoolSpec) bool {
+		return declaredNames[spec.Name]
+	})
+
+	named := false
+
+	for _, matches := range []func(oldSpec, newSpec NodePoolSpec) bool{
+		nodePoolSpecsMatch,
+		func(oldSpec, newSpec NodePoolSpec) bool {
+			return oldSpec.Type == newSpec.Type
+		},
+	} {
+		unnamed = slices.DeleteFunc(unnamed, func(i int) bool {
+			c := slices.IndexFunc(candidates, func(spec NodePoolSpec) bool {
+				return matches(spec, newSpecs[i])
+			})
+			if c < 0 {
+				return false
+			}
+
+			data.Pool[i].Name = types.StringValue(candidates[c].Name)
+			candidates = slices.Delete(candidates, c, c+1)
+			named = true
+
+			return true
+		})
+	}
+
+	return named
+}
+
+// NameNodePools names the declared pools without a name after the ID of the
+// pool created for them, updating the given names of the pools by ID.
+func (data *ResourceModel) NameNodePools(poolNames map[int]string) {
+	ids := make(map[string]int, len(poolNames))
+	for id, name := range poolNames {
+		ids[name] = id
+	}
+
+	for i := range data.Pool {
+		pool := &data.Pool[i]
+		if !pool.Name.IsNull() && !pool.Name.IsUnknown() {
+			continue
+		}
+
+		id, ok := ids[data.nodePoolName(i)]
+		if !ok {
+			pool.Name = types.StringNull()
+			continue
+		}
+
+		pool.Name = types.StringValue(defaultNodePoolName(id))
+		poolNames[id] = pool.Name.ValueString()
+	}
+}
+
+// PlanNodePools sets the planned node_pools of the cluster from its state,
+// leaving only the pools that are going to be created, updated or recycled
+// unknown so that the plan shows exactly which pools change.
+func (data *ResourceModel) PlanNodePools(ctx context.Context, state ResourceModel, diags *diag.Diagnostics) {
+	data.NodePools = types.MapUnknown(nodePoolObjectType)
+
+	// Every node is replaced when the Kubernetes version is upgraded
+	if !data.K8sVersion.Equal(state.K8sVersion) || state.NodePools.IsNull() || state.NodePools.IsUnknown() {
+		return
+	}
+
+	for _, pool := range data.Pool {
+		if pool.hasUnknowns() {
+			return
+		}
+	}
+
+	ids := state.NodePoolIDs(ctx, diags)
+	if diags.HasError() {
+		return
+	}
+
+	updates, err := ReconcileLKENodePoolSpecs(
+		ctx,
+		state.NodePoolSpecs(ctx, ids, diags),
+		data.NodePoolSpecs(ctx, nil, diags),
+	)
+	if err != nil || diags.HasError() {
+		return
+	}
+
+	knownNodePools := state.NodePools.Elements()
+
+	namesByID := make(map[int]string, len(ids))
+	for name, id := range ids {
+		namesByID[id] = name
+	}
+
+	nodePools := make(map[string]attr.Value, len(data.Pool))
+
+	for _, pool := range data.Pool {
+		name := pool.Name.ValueString()
+		nodePools[name] = types.ObjectUnknown(nodePoolObjectType.AttrTypes)
+
+		if id, ok := updates.ToRename[name]; ok {
+			nodePools[name] = knownNodePools[namesByID[id]]
+			continue
+		}
+
+		id, ok := ids[name]
+		if !ok {
+			continue
+		}
+
+		if _, ok := updates.ToCreate[name]; ok {
+			continue
+		}
+
+		if _, ok := updates.ToUpdate[id]; ok {
+			continue
+		}
+
+		nodePools[name] = knownNodePools[name]
+	}
+
+	nodePoolsMap, newDiags := types.MapValue(nodePoolObjectType, nodePools)
+	diags.Append(newDiags...)
+
+	data.NodePools = nodePoolsMap
+}
+
+func (pool *NodePoolModel) hasUnknowns() bool {
+	if pool.Name.IsUnknown() || pool.Type.IsUnknown() || pool.Count.IsUnknown() ||
+		pool.Tags.IsUnknown() || pool.Labels.IsUnknown() {
+		return true
+	}
+
+	for _, taint := range pool.Taints {
+		if taint.Key.IsUnknown() || taint.Value.IsUnknown() || taint.Effect.IsUnknown() {
+			return true
+		}
+	}
+
+	for _, autoscaler := range pool.Autoscaler {
+		if autoscaler.Min.IsUnknown() || autoscaler.Max.IsUnknown() {
+			return true
+		}
+	}
+
+	return false
+}
+
+// UpgradeFromV0 upgrades the state written by the SDKv2 implementation of this resource.
+//
+// Node pools were tracked as a list matched by position, so every pool is given a
+// default name, which matches pools that are still declared without a name. Pools
+// that are declared under another name with the same spec are adopted under that
+// name on the next apply.
+func (data *ResourceModel) UpgradeFromV0(ctx context.Context, v0 ResourceModelV0) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	data.ID = v0.ID
+	data.Label = v0.Label
+	data.K8sVersion = v0.K8sVersion
+	data.Tags = v0.Tags
+	data.ExternalPoolTags = v0.ExternalPoolTags
+	data.Region = v0.Region
+	data.APIEndpoints = v0.APIEndpoints
+	data.Kubeconfig = v0.Kubeconfig
+	data.DashboardURL = v0.DashboardURL
+	data.Status = v0.Status
+	data.Tier = v0.Tier
+	data.RecycleStrategy = v0.RecycleStrategy
+	data.RotateCredentials = types.MapNull(types.StringType)
+	data.Timeouts = v0.Timeouts
+
+	if data.Tags.IsNull() {
+		data.Tags = types.SetValueMust(types.StringType, []attr.Value{})
+	}
+
+	data.Pool = make([]NodePoolModel, len(v0.Pool))
+	nodePools := make(map[string]attr.Value, len(v0.Pool))
+
+	for i, pool := range v0.Pool {
+		name := defaultNodePoolName(int(pool.ID.ValueInt64()))
+
+		data.Pool[i] = NodePoolModel{
+			Name:       types.StringValue(name),
+			Type:       pool.Type,
+			Count:      pool.Count,
+			Tags:       pool.Tags,
+			Labels:     pool.Labels,
+			Taints:     pool.Taints,
+			Autoscaler: pool.Autoscaler,
+
+			WaitForKubernetesReady: types.BoolNull(),
+		}
+
+		// Empty collections were stored for attributes that weren't declared
+		if len(pool.Tags.Elements()) == 0 {
+			data.Pool[i].Tags = types.SetNull(types.StringType)
+		}
+
+		if len(pool.Labels.Elements()) == 0 {
+			data.Pool[i].Labels = types.MapNull(types.StringType)
+		}
+
+		nodes := make([]LKENodePoolNode, 0)
+		if !pool.Nodes.IsNull() && !pool.Nodes.IsUnknown() {
+			diags.Append(pool.Nodes.ElementsAs(ctx, &nodes, false)...)
+		}
+
+		nodePool, newDiags := types.ObjectValueFrom(ctx, nodePoolObjectType.AttrTypes, NodePoolStateModel{
+			ID:             pool.ID,
+			Count:          pool.Count,
+			Type:           pool.Type,
+			DiskEncryption: pool.DiskEncryption,
+			Nodes:          nodes,
+		})
+		diags.Append(newDiags...)
+		if diags.HasError() {
+			return diags
+		}
+
+		nodePools[name] = nodePool
+	}
+
+	nodePoolsMap, newDiags := types.MapValue(nodePoolObjectType, nodePools)
+	diags.Append(newDiags...)
+	data.NodePools = nodePoolsMap
+
+	// The control plane was tracked regardless of whether it was declared,
+	// so it is only kept when it differs from the defaults.
+	for _, cp := range v0.ControlPlane {
+		aclEnabled := len(cp.ACL) > 0 && cp.ACL[0].Enabled.ValueBool()
+		if !cp.HighAvailability.ValueBool() && !aclEnabled {
+			continue
+		}
+
+		if !aclEnabled {
+			cp.ACL = nil
+		}
+
+		data.ControlPlane = []LKEControlPlane{cp}
+	}
+
+	return diags
+}
@@ -0,0 +1,402 @@
+//go:build unit
+
+package lke
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/linode/linodego"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func testNodePoolState(t *testing.T, id int, poolType string, count int) types.Object {
+	t.Helper()
+
+	var diags diag.Diagnostics
+	result := flattenNodePoolState(context.Background(), linodego.LKENodePool{
+		ID:    id,
+		Type:  poolType,
+		Count: count,
+	}, &diags)
+	require.False(t, diags.HasError())
+
+	return result
+}
+
+func testResourceModel(t *testing.T) ResourceModel {
+	t.Helper()
+
+	return ResourceModel{
+		Label:      types.StringValue("test-cluster"),
+		K8sVersion: types.StringValue("1.31"),
+		Tags:       types.SetValueMust(types.StringType, []attr.Value{types.StringValue("test")}),
+		Pool: []NodePoolModel{
+			{
+				Name:   types.StringValue("default"),
+				Type:   types.StringValue("g6-standard-1"),
+				Count:  types.Int64Value(3),
+				Tags:   types.SetNull(types.StringType),
+				Labels: types.MapNull(types.StringType),
+			},
+			{
+				Name:   types.StringValue("workers"),
+				Type:   types.StringValue("g6-standard-2"),
+				Count:  types.Int64Value(1),
+				Tags:   types.SetNull(types.StringType),
+				Labels: types.MapNull(types.StringType),
+			},
+		},
+		NodePools: types.MapValueMust(nodePoolObjectType, map[string]attr.Value{
+			"default": testNodePoolState(t, 123, "g6-standard-1", 3),
+			"workers": testNodePoolState(t, 124, "g6-standard-2", 1),
+		}),
+	}
+}
+
+func TestGetUpdateOptions(t *testing.T) {
+	ctx := context.Background()
+
+	state := testResourceModel(t)
+
+	plan := testResourceModel(t)
+	plan.Label = types.StringValue("test-cluster-renamed")
+
+	var diags diag.Diagnostics
+	updateOpts, shouldUpdate := plan.GetUpdateOptions(ctx, state, &diags)
+	require.False(t, diags.HasError())
+
+	assert.True(t, shouldUpdate)
+	assert.Equal(t, "test-cluster-renamed", updateOpts.Label)
+
+	// Unchanged attributes shouldn't be sent to the API
+	assert.Empty(t, updateOpts.K8sVersion)
+	assert.Nil(t, updateOpts.Tags)
+	assert.Nil(t, updateOpts.ControlPlane)
+
+	_, shouldUpdate = state.GetUpdateOptions(ctx, state, &diags)
+	require.False(t, diags.HasError())
+
+	assert.False(t, shouldUpdate)
+}
+
+func TestPlanNodePools(t *testing.T) {
+	ctx := context.Background()
+
+	state := testResourceModel(t)
+
+	plan := testResourceModel(t)
+	plan.Pool[1].Count = types.Int64Value(2)
+	plan.Pool = append(plan.Pool, NodePoolModel{
+		Name:   types.StringValue("extra"),
+		Type:   types.StringValue("g6-standard-1"),
+		Count:  types.Int64Value(1),
+		Tags:   types.SetNull(types.StringType),
+		Labels: types.MapNull(types.StringType),
+	})
+
+	var diags diag.Diagnostics
+	plan.PlanNodePools(ctx, state, &diags)
+	require.False(t, diags.HasError())
+
+	nodePools := plan.NodePools.Elements()
+	assert.Len(t, nodePools, 3)
+
+	// Only the pools that change should be unknown
+	assert.True(t, nodePools["default"].Equal(state.NodePools.Elements()["default"]))
+	assert.True(t, nodePools["workers"].IsUnknown())
+	assert.True(t, nodePools["extra"].IsUnknown())
+}
+
+func TestPlanNodePools_rename(t *testing.T) {
+	ctx := context.Background()
+
+	state := testResourceModel(t)
+
+	plan := testResourceModel(t)
+	plan.Pool[1].Name = types.StringValue("renamed")
+
+	var diags diag.Diagnostics
+	plan.PlanNodePools(ctx, state, &diags)
+	require.False(t, diags.HasError())
+
+	nodePools := plan.NodePools.Elements()
+	assert.Len(t, nodePools, 2)
+	assert.True(t, nodePools["renamed"].Equal(state.NodePools.Elements()["workers"]))
+}
+
+func TestPlanNodePools_k8sUpgrade(t *testing.T) {
+	ctx := context.Background()
+
+	state := testResourceModel(t)
+
+	plan := testResourceModel(t)
+	plan.K8sVersion = types.StringValue("1.32")
+
+	var diags diag.Diagnostics
+	plan.PlanNodePools(ctx, state, &diags)
+	require.False(t, diags.HasError())
+
+	assert.True(t, plan.NodePools.IsUnknown())
+}
+
+func TestPlanNodePoolNames(t *testing.T) {
+	ctx := context.Background()
+
+	state := testResourceModel(t)
+
+	plan := testResourceModel(t)
+	plan.Pool[0].Name = types.StringUnknown()
+	plan.Pool[1].Name = types.StringUnknown()
+	plan.Pool[1].Count = types.Int64Value(2)
+	plan.Pool = append(plan.Pool, NodePoolModel{
+		Name:   types.StringUnknown(),
+		Type:   types.StringValue("g6-standard-1"),
+		Count:  types.Int64Value(3),
+		Tags:   types.SetNull(types.StringType),
+		Labels: types.MapNull(types.StringType),
+	})
+
+	var diags diag.Diagnostics
+	assert.True(t, plan.PlanNodePoolNames(ctx, state, &diags))
+	require.False(t, diags.HasError())
+
+	// Unnamed pools keep the name of a matching pool, or of a pool of the same type
+	assert.Equal(t, "default", plan.Pool[0].Name.ValueString())
+	assert.Equal(t, "workers", plan.Pool[1].Name.ValueString())
+	assert.True(t, plan.Pool[2].Name.IsUnknown())
+
+	assert.False(t, state.PlanNodePoolNames(ctx, state, &diags))
+	require.False(t, diags.HasError())
+}
+
+func TestNameNodePools(t *testing.T) {
+	plan := testResourceModel(t)
+	plan.Pool[1].Name = types.StringUnknown()
+
+	poolNames := map[int]string{
+		123: "default",
+		125: plan.nodePoolName(1),
+	}
+
+	plan.NameNodePools(poolNames)
+
+	assert.Equal(t, "default", plan.Pool[0].Name.ValueString())
+	assert.Equal(t, "pool-125", plan.Pool[1].Name.ValueString())
+	assert.Equal(t, map[int]string{123: "default", 125: "pool-125"}, poolNames)
+}
+
+func TestFlattenCluster_import(t *testing.T) {
+	ctx := context.Background()
+
+	cluster := &linodego.LKECluster{
+		ID:         456,
+		Label:      "test-cluster",
+		Region:     "us-mia",
+		K8sVersion: "1.31",
+		Tier:       "standard",
+		Tags:       []string{"test"},
+	}
+
+	pools := []linodego.LKENodePool{
+		{ID: 123, Type: "g6-standard-1", Count: 3, Tags: []string{}},
+		{
+			ID:         124,
+			Type:       "g6-standard-2",
+			Count:      2,
+			Tags:       []string{"workers"},
+			Autoscaler: linodego.LKENodePoolAutoscaler{Enabled: true, Min: 1, Max: 5},
+		},
+	}
+
+	acl := &linodego.LKEClusterControlPlaneACLResponse{
+		ACL: linodego.LKEClusterControlPlaneACL{Enabled: true},
+	}
+
+	data := ResourceModel{
+		ID:               types.StringValue("456"),
+		Label:            types.StringNull(),
+		Tags:             types.SetNull(types.StringType),
+		ExternalPoolTags: types.SetNull(types.StringType),
+		NodePools:        types.MapNull(nodePoolObjectType),
+	}
+
+	var diags diag.Diagnostics
+	data.FlattenCluster(
+		ctx,
+		cluster,
+		pools,
+		nil,
+		&linodego.LKEClusterKubeconfig{KubeConfig: "kubeconfig"},
+		[]linodego.LKEClusterAPIEndpoint{{Endpoint: "https://example.com:443"}},
+		acl,
+		&linodego.LKEClusterDashboard{URL: "https://example.com"},
+		false,
+		&diags,
+	)
+	require.False(t, diags.HasError())
+
+	assert.Equal(t, "test-cluster", data.Label.ValueString())
+	assert.Equal(t, "standard", data.Tier.ValueString())
+
+	// Pools without a known name are given their default name
+	require.Len(t, data.Pool, 2)
+	assert.Equal(t, "pool-123", data.Pool[0].Name.ValueString())
+	assert.True(t, data.Pool[0].Tags.IsNull())
+	assert.True(t, data.Pool[0].WaitForKubernetesReady.IsNull())
+	assert.Equal(t, "pool-124", data.Pool[1].Name.ValueString())
+	assert.Len(t, data.Pool[1].Tags.Elements(), 1)
+	require.Len(t, data.Pool[1].Autoscaler, 1)
+	assert.Equal(t, int64(5), data.Pool[1].Autoscaler[0].Max.ValueInt64())
+
+	nodePools := data.NodePools.Elements()
+	assert.Contains(t, nodePools, "pool-123")
+	assert.Contains(t, nodePools, "pool-124")
+
+	// The customized control plane is tracked after an import
+	require.Len(t, data.ControlPlane, 1)
+	assert.False(t, data.ControlPlane[0].HighAvailability.ValueBool())
+	require.Len(t, data.ControlPlane[0].ACL, 1)
+	assert.True(t, data.ControlPlane[0].ACL[0].Enabled.ValueBool())
+}
+
+func TestFlattenNodePool_waitForKubernetesReady(t *testing.T) {
+	ctx := context.Background()
+
+	declared := NodePoolModel{
+		Name:                   types.StringValue("default"),
+		Tags:                   types.SetNull(types.StringType),
+		Labels:                 types.MapNull(types.StringType),
+		WaitForKubernetesReady: types.BoolValue(true),
+	}
+
+	pool := linodego.LKENodePool{ID: 123, Type: "g6-standard-1", Count: 3}
+
+	var diags diag.Diagnostics
+
+	// The declared value is kept since it isn't returned by the API
+	result := flattenNodePool(ctx, "default", declared, true, pool, &diags)
+	require.False(t, diags.HasError())
+	assert.True(t, result.WaitForKubernetesReady.ValueBool())
+
+	result = flattenNodePool(ctx, "pool-123", NodePoolModel{}, false, pool, &diags)
+	require.False(t, diags.HasError())
+	assert.True(t, result.WaitForKubernetesReady.IsNull())
+}
+
+func TestMatchCreatedNodePools(t *testing.T) {
+	specs := []NodePoolSpec{
+		{Name: "workers", Type: "g6-standard-1", Count: 2, Tags: []string{"workers"}},
+		{Name: "default", Type: "g6-standard-1", Count: 2},
+		{Name: "scaled", Type: "g6-standard-2", AutoScalerEnabled: true, AutoScalerMin: 1, AutoScalerMax: 3},
+	}
+
+	pools := []linodego.LKENodePool{
+		{ID: 1, Type: "g6-standard-1", Count: 2},
+		{ID: 2, Type: "g6-standard-2", Count: 1, Autoscaler: linodego.LKENodePoolAutoscaler{Enabled: true, Min: 1, Max: 3}},
+		{ID: 3, Type: "g6-standard-1", Count: 2, Tags: []string{"workers"}},
+	}
+
+	assert.Equal(t, map[int]string{
+		1: "default",
+		2: "scaled",
+		3: "workers",
+	}, matchCreatedNodePools(specs, pools))
+}
+
+func TestUpgradeFromV0(t *testing.T) {
+	ctx := context.Background()
+
+	v0 := ResourceModelV0{
+		ID:         types.StringValue("456"),
+		Label:      types.StringValue("test-cluster"),
+		K8sVersion: types.StringValue("1.31"),
+		Tags:       types.SetNull(types.StringType),
+		Pool: []NodePoolModelV0{
+			{
+				ID:             types.Int64Value(123),
+				Count:          types.Int64Value(3),
+				Type:           types.StringValue("g6-standard-1"),
+				Labels:         types.MapValueMust(types.StringType, map[string]attr.Value{}),
+				Tags:           types.SetValueMust(types.StringType, []attr.Value{}),
+				DiskEncryption: types.StringValue("enabled"),
+				Nodes:          types.ListNull(nodeObjectType),
+			},
+		},
+		ControlPlane: []LKEControlPlane{
+			{
+				HighAvailability: types.BoolValue(false),
+				ACL: []LKEControlPlaneACL{
+					{Enabled: types.BoolValue(false)},
+				},
+			},
+		},
+	}
+
+	var data ResourceModel
+	diags := data.UpgradeFromV0(ctx, v0)
+	require.False(t, diags.HasError())
+
+	assert.Equal(t, "456", data.ID.ValueString())
+	assert.False(t, data.Tags.IsNull())
+
+	require.Len(t, data.Pool, 1)
+	assert.Equal(t, "pool-123", data.Pool[0].Name.ValueString())
+	assert.True(t, data.Pool[0].Tags.IsNull())
+	assert.True(t, data.Pool[0].Labels.IsNull())
+	assert.True(t, data.Pool[0].WaitForKubernetesReady.IsNull())
+	assert.True(t, data.RotateCredentials.IsNull())
+
+	assert.Equal(t, map[string]int{"pool-123": 123}, data.NodePoolIDs(ctx, &diags))
+	assert.False(t, diags.HasError())
+
+	// A default control plane isn't tracked unless it is declared
+	assert.Empty(t, data.ControlPlane)
+}
+
+func TestShouldRotateCredentials(t *testing.T) {
+	trigger := func(version string) types.Map {
+		return types.MapValueMust(types.StringType, map[string]attr.Value{
+			"version": types.StringValue(version),
+		})
+	}
+
+	state := testResourceModel(t)
+	state.RotateCredentials = trigger("1")
+
+	plan := testResourceModel(t)
+	plan.RotateCredentials = trigger("1")
+	assert.False(t, plan.ShouldRotateCredentials(state))
+
+	plan.RotateCredentials = trigger("2")
+	assert.True(t, plan.ShouldRotateCredentials(state))
+
+	// Removing the trigger doesn't rotate the credentials
+	plan.RotateCredentials = types.MapNull(types.StringType)
+	assert.False(t, plan.ShouldRotateCredentials(state))
+}
+
+func TestGetRegenerateOptions(t *testing.T) {
+	data := testResourceModel(t)
+
+	assert.Equal(t, linodego.LKEClusterRegenerateOptions{
+		KubeConfig:   true,
+		ServiceToken: true,
+	}, data.GetRegenerateOptions())
+
+	data.CredentialRotation = []CredentialRotationModel{
+		{
+			Kubeconfig:   types.BoolValue(false),
+			ServiceToken: types.BoolValue(true),
+		},
+	}
+
+	assert.Equal(t, linodego.LKEClusterRegenerateOptions{
+		KubeConfig:   false,
+		ServiceToken: true,
+	}, data.GetRegenerateOptions())
+}
@@ -0,0 +1,534 @@
+package lke
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64default"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/listplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/setplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/linode/linodego"
+	"github.com/linode/terraform-provider-linode/v2/linode/helper"
+	linodeplanmodifiers "github.com/linode/terraform-provider-linode/v2/linode/helper/planmodifiers"
+	"github.com/linode/terraform-provider-linode/v2/linode/lkenodepool"
+)
+
+var nodeObjectType = types.ObjectType{
+	AttrTypes: map[string]attr.Type{
+		"id":          types.StringType,
+		"instance_id": types.Int64Type,
+		"status":      types.StringType,
+	},
+}
+
+var nodePoolObjectType = types.ObjectType{
+	AttrTypes: map[string]attr.Type{
+		"id":              types.Int64Type,
+		"count":           types.Int64Type,
+		"type":            types.StringType,
+		"disk_encryption": types.StringType,
+		"nodes":           types.ListType{ElemType: nodeObjectType},
+	},
+}
+
+var frameworkResourceSchema = schema.Schema{
+	Version: 1,
+	Attributes: map[string]schema.Attribute{
+		"id": schema.StringAttribute{
+			Description: "The ID of the cluster.",
+			Computed:    true,
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.UseStateForUnknown(),
+			},
+		},
+		"label": schema.StringAttribute{
+			Description: "The unique label for the cluster.",
+			Required:    true,
+		},
+		"k8s_version": schema.StringAttribute{
+			Description: "The desired Kubernetes version for this Kubernetes cluster in the format of <major>.<minor>. " +
+				"The latest supported patch version will be deployed.",
+			Required: true,
+		},
+		"tags": schema.SetAttribute{
+			Description: "An array of tags applied to this object. Tags are for organizational purposes only.",
+			ElementType: types.StringType,
+			Optional:    true,
+			Computed:    true,
+			Default:     helper.EmptySetDefault(types.StringType),
+			PlanModifiers: []planmodifier.Set{
+				linodeplanmodifiers.CaseInsensitiveSet(),
+			},
+		},
+		"external_pool_tags": schema.SetAttribute{
+			Description: "An array of tags indicating that node pools having those tags are defined with a separate " +
+				"nodepool resource, rather than inside the current cluster resource.",
+			ElementType: types.StringType,
+			Optional:    true,
+		},
+		"region": schema.StringAttribute{
+			Description: "This cluster's location.",
+			Required:    true,
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.RequiresReplace(),
+			},
+		},
+		"api_endpoints": schema.ListAttribute{
+			Description: "The API endpoints for the cluster.",
+			ElementType: types.StringType,
+			Computed:    true,
+			PlanModifiers: []planmodifier.List{
+				listplanmodifier.UseStateForUnknown(),
+			},
+		},
+		"kubeconfig": schema.StringAttribute{
+			Description: "The Base64-encoded Kubeconfig for the cluster.",
+			Computed:    true,
+			Sensitive:   true,
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.UseStateForUnknown(),
+			},
+		},
+		"dashboard_url": schema.StringAttribute{
+			Description: "The dashboard URL of the cluster.",
+			Computed:    true,
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.UseStateForUnknown(),
+			},
+		},
+		"status": schema.StringAttribute{
+			Description: "The status of the cluster.",
+			Computed:    true,
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.UseStateForUnknown(),
+			},
+		},
+		"tier": schema.StringAttribute{
+			Description: "The desired Kubernetes tier.",
+			Optional:    true,
+			Computed:    true,
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.RequiresReplaceIfConfigured(),
+				stringplanmodifier.UseStateForUnknown(),
+			},
+		},
+		"node_pools": schema.MapAttribute{
+			Description: "The node pools of the cluster as reported by the API, keyed by the name of each pool.",
+			ElementType: nodePoolObjectType,
+			Computed:    true,
+		},
+		"rotate_credentials": schema.MapAttribute{
+			Description: "Arbitrary values that regenerate the credentials of the cluster when they change.",
+			Optional:    true,
+			ElementType: types.StringType,
+		},
+	},
+	Blocks: map[string]schema.Block{
+		"pool": schema.SetNestedBlock{
+			Description: "A node pool in the cluster.",
+			Validators: []validator.Set{
+				setvalidator.IsRequired(),
+				setvalidator.SizeAtLeast(1),
+			},
+			NestedObject: schema.NestedBlockObject{
+				Attributes: map[string]schema.Attribute{
+					"name": schema.StringAttribute{
+						Description: "The name of the Node Pool, which must be unique within the cluster. " +
+							"Node pools are matched with the API by name, so renaming a pool replaces it " +
+							"unless its spec is otherwise unchanged. Defaults to `pool-<id>` once the pool is created.",
+						Optional: true,
+						Computed: true,
+						Validators: []validator.String{
+							stringvalidator.LengthAtLeast(1),
+						},
+					},
+					"type": schema.StringAttribute{
+						Description: "A Linode Type for all of the nodes in the Node Pool.",
+						Required:    true,
+					},
+					"count": schema.Int64Attribute{
+						Description: "The number of nodes in the Node Pool.",
+						Optional:    true,
+						Validators: []validator.Int64{
+							int64validator.AtLeast(1),
+						},
+					},
+					"tags": schema.SetAttribute{
+						Description: "A set of tags applied to this node pool.",
+						ElementType: types.StringType,
+						Optional:    true,
+					},
+					"labels": schema.MapAttribute{
+						Description: "Key-value pairs added as labels to nodes in the node pool. " +
+							"Labels help classify your nodes and to easily select subsets of objects.",
+						ElementType: types.StringType,
+						Optional:    true,
+					},
+					"wait_for_kubernetes_ready": schema.BoolAttribute{
+						Description: "Whether to wait for every node of the pool to be ready in Kubernetes " +
+							"when the cluster is created or updated.",
+						Optional: true,
+					},
+				},
+				Blocks: map[string]schema.Block{
+					"taint": schema.SetNestedBlock{
+						Description: "Kubernetes taints to add to node pool nodes. Taints help control how " +
+							"pods are scheduled onto nodes, specifically allowing them to repel certain pods.",
+						NestedObject: schema.NestedBlockObject{
+							Attributes: map[string]schema.Attribute{
+								"effect": schema.StringAttribute{
+									Description: "The Kubernetes taint effect.",
+									Required:    true,
+									Validators: []validator.String{
+										stringvalidator.OneOf(
+											string(linodego.LKENodePoolTaintEffectNoExecute),
+											string(linodego.LKENodePoolTaintEffectNoSchedule),
+											string(linodego.LKENodePoolTaintEffectPreferNoSchedule),
+										),
+									},
+								},
+								"key": schema.StringAttribute{
+									Description: "The Kubernetes taint key.",
+									Required:    true,
+									Validators: []validator.String{
+										stringvalidator.LengthAtLeast(1),
+									},
+								},
+								"value": schema.StringAttribute{
+									Description: "The Kubernetes taint value.",
+									Required:    true,
+									Validators: []validator.String{
+										stringvalidator.LengthAtLeast(1),
+									},
+								},
+							},
+						},
+					},
+					"autoscaler": schema.ListNestedBlock{
+						Description: "When specified, the number of nodes autoscales within " +
+							"the defined minimum and maximum values.",
+						Validators: []validator.List{
+							listvalidator.SizeAtMost(1),
+						},
+						NestedObject: schema.NestedBlockObject{
+							Attributes: map[string]schema.Attribute{
+								"min": schema.Int64Attribute{
+									Description: "The minimum number of nodes to autoscale to.",
+									Required:    true,
+								},
+								"max": schema.Int64Attribute{
+									Description: "The maximum number of nodes to autoscale to.",
+									Required:    true,
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+		"recycle_strategy": schema.ListNestedBlock{
+			Description: "Controls how nodes are recycled when the cluster's Kubernetes version is upgraded. " +
+				"Only affects the Terraform-driven recycle; it is not sent to the API.",
+			Validators: []validator.List{
+				listvalidator.SizeAtMost(1),
+			},
+			NestedObject: schema.NestedBlockObject{
+				Attributes: map[string]schema.Attribute{
+					"type": schema.StringAttribute{
+						Description: "The unit recycled at once: `cluster` recycles every node together, " +
+							"`pool` recycles one node pool at a time and `node` recycles max_unavailable nodes at a time.",
+						Optional: true,
+						Computed: true,
+						Default:  stringdefault.StaticString(lkenodepool.RecycleStrategyCluster),
+						Validators: []validator.String{
+							stringvalidator.OneOf(
+								lkenodepool.RecycleStrategyCluster,
+								lkenodepool.RecycleStrategyPool,
+								lkenodepool.RecycleStrategyNode,
+							),
+						},
+					},
+					"max_unavailable": schema.Int64Attribute{
+						Description: "The maximum number of nodes per pool recycled at once when type is `node`.",
+						Optional:    true,
+						Computed:    true,
+						Default:     int64default.StaticInt64(lkenodepool.DefaultRecycleMaxUnavailable),
+						Validators: []validator.Int64{
+							int64validator.AtLeast(1),
+						},
+					},
+				},
+			},
+		},
+		"credential_rotation": schema.ListNestedBlock{
+			Description: "Controls which credentials are regenerated when rotate_credentials changes.",
+			Validators: []validator.List{
+				listvalidator.SizeAtMost(1),
+			},
+			NestedObject: schema.NestedBlockObject{
+				Attributes: map[string]schema.Attribute{
+					"kubeconfig": schema.BoolAttribute{
+						Description: "Whether the kubeconfig of the cluster is regenerated.",
+						Optional:    true,
+						Computed:    true,
+						Default:     booldefault.StaticBool(true),
+					},
+					"service_token": schema.BoolAttribute{
+						Description: "Whether the service account token of the cluster is regenerated.",
+						Optional:    true,
+						Computed:    true,
+						Default:     booldefault.StaticBool(true),
+					},
+				},
+			},
+		},
+		"control_plane": schema.ListNestedBlock{
+			Description: "Defines settings for the Kubernetes Control Plane.",
+			Validators: []validator.List{
+				listvalidator.SizeAtMost(1),
+			},
+			NestedObject: schema.NestedBlockObject{
+				Attributes: map[string]schema.Attribute{
+					"high_availability": schema.BoolAttribute{
+						Description: "Defines whether High Availability is enabled for the Control Plane Components of the cluster.",
+						Optional:    true,
+						Computed:    true,
+						PlanModifiers: []planmodifier.Bool{
+							boolplanmodifier.UseStateForUnknown(),
+						},
+					},
+				},
+				Blocks: map[string]schema.Block{
+					"acl": schema.ListNestedBlock{
+						Description: "Defines the ACL configuration for an LKE cluster's control plane.",
+						Validators: []validator.List{
+							listvalidator.SizeAtMost(1),
+						},
+						NestedObject: schema.NestedBlockObject{
+							Attributes: map[string]schema.Attribute{
+								"enabled": schema.BoolAttribute{
+									Description: "Defines default policy. A value of true results in a default policy of DENY. " +
+										"A value of false results in default policy of ALLOW, and has the same effect as " +
+										"delete the ACL configuration.",
+									Optional: true,
+									Computed: true,
+									PlanModifiers: []planmodifier.Bool{
+										boolplanmodifier.UseStateForUnknown(),
+									},
+								},
+							},
+							Blocks: map[string]schema.Block{
+								"addresses": schema.ListNestedBlock{
+									Description: "A list of ip addresses to allow.",
+									Validators: []validator.List{
+										listvalidator.SizeAtMost(1),
+									},
+									NestedObject: schema.NestedBlockObject{
+										Attributes: map[string]schema.Attribute{
+											"ipv4": schema.SetAttribute{
+												Description: "A set of individual ipv4 addresses or CIDRs to ALLOW.",
+												ElementType: types.StringType,
+												Optional:    true,
+												Computed:    true,
+												PlanModifiers: []planmodifier.Set{
+													setplanmodifier.UseStateForUnknown(),
+												},
+											},
+											"ipv6": schema.SetAttribute{
+												Description: "A set of individual ipv6 addresses or CIDRs to ALLOW.",
+												ElementType: types.StringType,
+												Optional:    true,
+												Computed:    true,
+												PlanModifiers: []planmodifier.Set{
+													setplanmodifier.UseStateForUnknown(),
+												},
+											},
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	},
+}
+
+// frameworkResourceSchemaV0 describes the state written by the SDKv2 implementation
+// of this resource, which tracked node pools as a list matched by position.
+var frameworkResourceSchemaV0 = schema.Schema{
+	Version: 0,
+	Attributes: map[string]schema.Attribute{
+		"id": schema.StringAttribute{
+			Computed: true,
+		},
+		"label": schema.StringAttribute{
+			Required: true,
+		},
+		"k8s_version": schema.StringAttribute{
+			Required: true,
+		},
+		"tags": schema.SetAttribute{
+			ElementType: types.StringType,
+			Optional:    true,
+			Computed:    true,
+		},
+		"external_pool_tags": schema.SetAttribute{
+			ElementType: types.StringType,
+			Optional:    true,
+		},
+		"region": schema.StringAttribute{
+			Required: true,
+		},
+		"api_endpoints": schema.ListAttribute{
+			ElementType: types.StringType,
+			Computed:    true,
+		},
+		"kubeconfig": schema.StringAttribute{
+			Computed:  true,
+			Sensitive: true,
+		},
+		"dashboard_url": schema.StringAttribute{
+			Computed: true,
+		},
+		"status": schema.StringAttribute{
+			Computed: true,
+		},
+		"tier": schema.StringAttribute{
+			Optional: true,
+			Computed: true,
+		},
+	},
+	Blocks: map[string]schema.Block{
+		"pool": schema.ListNestedBlock{
+			NestedObject: schema.NestedBlockObject{
+				Attributes: map[string]schema.Attribute{
+					"id": schema.Int64Attribute{
+						Computed: true,
+					},
+					"count": schema.Int64Attribute{
+						Optional: true,
+						Computed: true,
+					},
+					"type": schema.StringAttribute{
+						Required: true,
+					},
+					"labels": schema.MapAttribute{
+						ElementType: types.StringType,
+						Optional:    true,
+					},
+					"tags": schema.SetAttribute{
+						ElementType: types.StringType,
+						Optional:    true,
+					},
+					"disk_encryption": schema.StringAttribute{
+						Computed: true,
+					},
+					"nodes": schema.ListAttribute{
+						ElementType: nodeObjectType,
+						Computed:    true,
+					},
+				},
+				Blocks: map[string]schema.Block{
+					"taint": schema.SetNestedBlock{
+						NestedObject: schema.NestedBlockObject{
+							Attributes: map[string]schema.Attribute{
+								"effect": schema.StringAttribute{
+									Required: true,
+								},
+								"key": schema.StringAttribute{
+									Required: true,
+								},
+								"value": schema.StringAttribute{
+									Required: true,
+								},
+							},
+						},
+					},
+					"autoscaler": schema.ListNestedBlock{
+						NestedObject: schema.NestedBlockObject{
+							Attributes: map[string]schema.Attribute{
+								"min": schema.Int64Attribute{
+									Required: true,
+								},
+								"max": schema.Int64Attribute{
+									Required: true,
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+		"recycle_strategy": schema.ListNestedBlock{
+			NestedObject: schema.NestedBlockObject{
+				Attributes: map[string]schema.Attribute{
+					"type": schema.StringAttribute{
+						Optional: true,
+					},
+					"max_unavailable": schema.Int64Attribute{
+						Optional: true,
+					},
+				},
+			},
+		},
+		"control_plane": schema.ListNestedBlock{
+			NestedObject: schema.NestedBlockObject{
+				Attributes: map[string]schema.Attribute{
+					"high_availability": schema.BoolAttribute{
+						Optional: true,
+						Computed: true,
+					},
+				},
+				Blocks: map[string]schema.Block{
+					"acl": schema.ListNestedBlock{
+						NestedObject: schema.NestedBlockObject{
+							Attributes: map[string]schema.Attribute{
+								"enabled": schema.BoolAttribute{
+									Optional: true,
+									Computed: true,
+								},
+							},
+							Blocks: map[string]schema.Block{
+								"addresses": schema.ListNestedBlock{
+									NestedObject: schema.NestedBlockObject{
+										Attributes: map[string]schema.Attribute{
+											"ipv4": schema.SetAttribute{
+												ElementType: types.StringType,
+												Optional:    true,
+												Computed:    true,
+											},
+											"ipv6": schema.SetAttribute{
+												ElementType: types.StringType,
+												Optional:    true,
+												Computed:    true,
+											},
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+		"timeouts": timeouts.Block(
+			context.Background(),
+			timeouts.Opts{Create: true, Update: true, Delete: true},
+		),
+	},
+}
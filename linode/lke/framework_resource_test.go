@@ -4,7 +4,6 @@ package lke_test
 
 import (
 	"context"
-	"encoding/json"
 	"fmt"
 	"log"
 	"regexp"
@@ -197,16 +196,16 @@ func TestAccResourceLKECluster_basic_smoke(t *testing.T) {
 						resource.TestCheckResourceAttr(resourceClusterName, "tier", "standard"),
 						resource.TestCheckResourceAttr(resourceClusterName, "tags.#", "1"),
 						resource.TestCheckResourceAttr(resourceClusterName, "pool.#", "1"),
+						resource.TestCheckResourceAttr(resourceClusterName, "pool.0.name", "default"),
 						resource.TestCheckResourceAttr(resourceClusterName, "pool.0.type", "g6-standard-1"),
 						resource.TestCheckResourceAttr(resourceClusterName, "pool.0.count", "3"),
-						resource.TestCheckResourceAttrSet(resourceClusterName, "pool.0.disk_encryption"),
-						resource.TestCheckResourceAttr(resourceClusterName, "pool.0.nodes.#", "3"),
+						resource.TestCheckResourceAttrSet(resourceClusterName, "node_pools.default.disk_encryption"),
+						resource.TestCheckResourceAttr(resourceClusterName, "node_pools.default.nodes.#", "3"),
 						resource.TestCheckResourceAttr(resourceClusterName, "pool.0.tags.#", "1"),
 						resource.TestCheckResourceAttr(resourceClusterName, "pool.0.tags.0", "test"),
-						resource.TestCheckResourceAttr(resourceClusterName, "control_plane.#", "1"),
-						resource.TestCheckResourceAttr(resourceClusterName, "control_plane.0.high_availability", "false"),
+						resource.TestCheckResourceAttr(resourceClusterName, "control_plane.#", "0"),
 						resource.TestCheckResourceAttrSet(resourceClusterName, "id"),
-						resource.TestCheckResourceAttrSet(resourceClusterName, "pool.0.id"),
+						resource.TestCheckResourceAttrSet(resourceClusterName, "node_pools.default.id"),
 						resource.TestCheckResourceAttrSet(resourceClusterName, "kubeconfig"),
 						resource.TestCheckResourceAttrSet(resourceClusterName, "dashboard_url"),
 
@@ -268,40 +267,13 @@ func TestAccResourceLKECluster_k8sUpgrade(t *testing.T) {
 func TestAccResourceLKECluster_basicUpdates(t *testing.T) {
 	t.Parallel()
 
-	provider, providerMap := acceptance.CreateTestProvider()
-
-	// We want to ensure that non-updated values are excluded from update requests
-	acceptance.ModifyProviderMeta(provider,
-		func(ctx context.Context, config *helper.ProviderMeta) error {
-			config.Client.OnBeforeRequest(func(request *linodego.Request) error {
-				if request.Method != "PUT" {
-					return nil
-				}
-
-				var opts linodego.LKEClusterUpdateOptions
-
-				if err := json.Unmarshal([]byte(request.Body.(string)), &opts); err != nil {
-					t.Fatal(err)
-				}
-
-				if opts.K8sVersion != "" {
-					t.Fatalf(
-						"expected k8s version to be excluded from update request, got %s",
-						opts.K8sVersion)
-				}
-
-				return nil
-			})
-
-			return nil
-		})
-
 	acceptance.RunTestWithRetries(t, 2, func(t *acceptance.WrappedT) {
 		clusterName := acctest.RandomWithPrefix("tf_test")
 		newClusterName := acctest.RandomWithPrefix("tf_test")
 		resource.Test(t, resource.TestCase{
-			PreCheck:  func() { acceptance.PreCheck(t) },
-			Providers: providerMap,
+			PreCheck:                 func() { acceptance.PreCheck(t) },
+			ProtoV5ProviderFactories: acceptance.ProtoV5ProviderFactories,
+			CheckDestroy:             acceptance.CheckLKEClusterDestroy,
 			Steps: []resource.TestStep{
 				{
 					Config: tmpl.Basic(t, clusterName, k8sVersionLatest, testRegion),
@@ -354,10 +326,13 @@ func TestAccResourceLKECluster_poolUpdates(t *testing.T) {
 					Config: tmpl.ComplexPools(t, newClusterName, k8sVersionLatest, testRegion),
 					Check: resource.ComposeTestCheckFunc(
 						resource.TestCheckResourceAttr(resourceClusterName, "label", newClusterName),
-						resource.TestCheckResourceAttr(resourceClusterName, "pool.0.count", "2"),
-						resource.TestCheckResourceAttr(resourceClusterName, "pool.1.count", "1"),
-						resource.TestCheckResourceAttr(resourceClusterName, "pool.2.count", "2"),
-						resource.TestCheckResourceAttr(resourceClusterName, "pool.3.count", "2"),
+						resource.TestCheckResourceAttr(resourceClusterName, "pool.#", "4"),
+						resource.TestCheckResourceAttr(resourceClusterName, "node_pools.%", "4"),
+						resource.TestCheckResourceAttr(resourceClusterName, "node_pools.default.type", "g6-standard-2"),
+						resource.TestCheckResourceAttr(resourceClusterName, "node_pools.default.count", "2"),
+						resource.TestCheckResourceAttr(resourceClusterName, "node_pools.small.count", "1"),
+						resource.TestCheckResourceAttr(resourceClusterName, "node_pools.medium.count", "2"),
+						resource.TestCheckResourceAttr(resourceClusterName, "node_pools.large.count", "2"),
 						resource.TestCheckResourceAttr(resourceClusterName, "status", "ready"),
 					),
 				},
@@ -375,6 +350,118 @@ func TestAccResourceLKECluster_poolUpdates(t *testing.T) {
 	})
 }
 
+func TestAccResourceLKECluster_removeMiddlePool(t *testing.T) {
+	t.Parallel()
+
+	poolIDs := make(map[string]string)
+
+	acceptance.RunTestWithRetries(t, 2, func(t *acceptance.WrappedT) {
+		clusterName := acctest.RandomWithPrefix("tf_test")
+		resource.Test(t, resource.TestCase{
+			PreCheck:                 func() { acceptance.PreCheck(t) },
+			ProtoV5ProviderFactories: acceptance.ProtoV5ProviderFactories,
+			CheckDestroy:             acceptance.CheckLKEClusterDestroy,
+			Steps: []resource.TestStep{
+				{
+					Config: tmpl.ComplexPools(t, clusterName, k8sVersionLatest, testRegion),
+					Check: resource.ComposeTestCheckFunc(
+						resource.TestCheckResourceAttr(resourceClusterName, "node_pools.%", "4"),
+						checkNodePoolIDs(poolIDs, false, "default", "medium", "large"),
+					),
+				},
+				{
+					// Removing a pool and reordering the others should
+					// only delete the removed pool
+					Config: tmpl.ComplexPoolsRemoved(t, clusterName, k8sVersionLatest, testRegion),
+					Check: resource.ComposeTestCheckFunc(
+						resource.TestCheckResourceAttr(resourceClusterName, "pool.#", "3"),
+						resource.TestCheckResourceAttr(resourceClusterName, "node_pools.%", "3"),
+						resource.TestCheckNoResourceAttr(resourceClusterName, "node_pools.small.id"),
+						checkNodePoolIDs(poolIDs, true, "default", "medium", "large"),
+					),
+				},
+			},
+		})
+	})
+}
+
+func TestAccResourceLKECluster_unnamedPools(t *testing.T) {
+	t.Parallel()
+
+	acceptance.RunTestWithRetries(t, 2, func(t *acceptance.WrappedT) {
+		clusterName := acctest.RandomWithPrefix("tf_test")
+		resource.Test(t, resource.TestCase{
+			PreCheck:                 func() { acceptance.PreCheck(t) },
+			ProtoV5ProviderFactories: acceptance.ProtoV5ProviderFactories,
+			CheckDestroy:             acceptance.CheckLKEClusterDestroy,
+			Steps: []resource.TestStep{
+				{
+					Config: tmpl.UnnamedPools(t, clusterName, k8sVersionLatest, testRegion),
+					Check: resource.ComposeTestCheckFunc(
+						resource.TestCheckResourceAttr(resourceClusterName, "pool.#", "2"),
+						resource.TestCheckResourceAttr(resourceClusterName, "node_pools.%", "2"),
+						resource.TestMatchResourceAttr(resourceClusterName, "pool.0.name", regexp.MustCompile(`^pool-\d+$`)),
+						resource.TestMatchResourceAttr(resourceClusterName, "pool.1.name", regexp.MustCompile(`^pool-\d+$`)),
+					),
+				},
+			},
+		})
+	})
+}
+
+func TestAccResourceLKECluster_upgradeFromSDKv2(t *testing.T) {
+	t.Parallel()
+
+	acceptance.RunTestWithRetries(t, 2, func(t *acceptance.WrappedT) {
+		clusterName := acctest.RandomWithPrefix("tf_test")
+		config := tmpl.UnnamedPools(t, clusterName, k8sVersionLatest, testRegion)
+
+		resource.Test(t, resource.TestCase{
+			PreCheck:     func() { acceptance.PreCheck(t) },
+			CheckDestroy: acceptance.CheckLKEClusterDestroy,
+			Steps: []resource.TestStep{
+				{
+					ExternalProviders: acceptance.SDKv2ExternalProviders,
+					Config:            config,
+					Check:             resource.TestCheckResourceAttrSet(resourceClusterName, "id"),
+				},
+				// Pools declared without a name must keep their upgraded pool-<id> names
+				{
+					ProtoV5ProviderFactories: acceptance.ProtoV5ProviderFactories,
+					Config:                   config,
+					PlanOnly:                 true,
+				},
+			},
+		})
+	})
+}
+
+// checkNodePoolIDs records the IDs of the given node pools, or verifies
+// that they haven't changed since they were recorded.
+func checkNodePoolIDs(ids map[string]string, verify bool, names ...string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[resourceClusterName]
+		if !ok {
+			return fmt.Errorf("could not find resource %s", resourceClusterName)
+		}
+
+		for _, name := range names {
+			id := rs.Primary.Attributes[fmt.Sprintf("node_pools.%s.id", name)]
+
+			if !verify {
+				ids[name] = id
+				continue
+			}
+
+			if ids[name] != id {
+				return fmt.Errorf("expected node pool %q to keep ID %s, got %s", name, ids[name], id)
+			}
+		}
+
+		return nil
+	}
+}
+
 func TestAccResourceLKECluster_removeUnmanagedPool(t *testing.T) {
 	t.Parallel()
 
@@ -423,6 +510,77 @@ func TestAccResourceLKECluster_removeUnmanagedPool(t *testing.T) {
 	})
 }
 
+func TestAccResourceLKECluster_rotateCredentials(t *testing.T) {
+	t.Parallel()
+
+	var kubeconfig string
+
+	acceptance.RunTestWithRetries(t, 2, func(t *acceptance.WrappedT) {
+		clusterName := acctest.RandomWithPrefix("tf_test")
+		resource.Test(t, resource.TestCase{
+			PreCheck:                 func() { acceptance.PreCheck(t) },
+			ProtoV5ProviderFactories: acceptance.ProtoV5ProviderFactories,
+			CheckDestroy:             acceptance.CheckLKEClusterDestroy,
+			Steps: []resource.TestStep{
+				{
+					Config: tmpl.RotateCredentials(t, clusterName, k8sVersionLatest, testRegion, "1"),
+					Check: resource.ComposeTestCheckFunc(
+						resource.TestCheckResourceAttr(resourceClusterName, "rotate_credentials.version", "1"),
+						resource.TestCheckResourceAttrWith(resourceClusterName, "kubeconfig", func(value string) error {
+							kubeconfig = value
+							return nil
+						}),
+					),
+				},
+				{
+					Config: tmpl.RotateCredentials(t, clusterName, k8sVersionLatest, testRegion, "2"),
+					Check: resource.ComposeTestCheckFunc(
+						resource.TestCheckResourceAttr(resourceClusterName, "rotate_credentials.version", "2"),
+						resource.TestCheckResourceAttrWith(resourceClusterName, "kubeconfig", func(value string) error {
+							if value == kubeconfig {
+								return fmt.Errorf("expected kubeconfig to be regenerated")
+							}
+							return nil
+						}),
+					),
+				},
+			},
+		})
+	})
+}
+
+func TestAccResourceLKECluster_waitForKubernetesReady(t *testing.T) {
+	t.Parallel()
+
+	acceptance.RunTestWithRetries(t, 2, func(t *acceptance.WrappedT) {
+		clusterName := acctest.RandomWithPrefix("tf_test")
+		resource.Test(t, resource.TestCase{
+			PreCheck:                 func() { acceptance.PreCheck(t) },
+			ProtoV5ProviderFactories: acceptance.ProtoV5ProviderFactories,
+			CheckDestroy:             acceptance.CheckLKEClusterDestroy,
+			Steps: []resource.TestStep{
+				{
+					Config: tmpl.WaitForKubernetesReady(t, clusterName, k8sVersionLatest, testRegion, 1),
+					Check: resource.ComposeTestCheckFunc(
+						resource.TestCheckResourceAttr(resourceClusterName, "pool.0.wait_for_kubernetes_ready", "true"),
+						resource.TestCheckResourceAttr(resourceClusterName, "node_pools.default.nodes.#", "1"),
+						resource.TestCheckResourceAttr(resourceClusterName, "node_pools.default.nodes.0.status", "ready"),
+					),
+				},
+				{
+					Config: tmpl.WaitForKubernetesReady(t, clusterName, k8sVersionLatest, testRegion, 2),
+					Check: resource.ComposeTestCheckFunc(
+						resource.TestCheckResourceAttr(resourceClusterName, "pool.0.count", "2"),
+						resource.TestCheckResourceAttr(resourceClusterName, "node_pools.default.nodes.#", "2"),
+						resource.TestCheckResourceAttr(resourceClusterName, "node_pools.default.nodes.0.status", "ready"),
+						resource.TestCheckResourceAttr(resourceClusterName, "node_pools.default.nodes.1.status", "ready"),
+					),
+				},
+			},
+		})
+	})
+}
+
 func TestAccResourceLKECluster_autoScaler(t *testing.T) {
 	t.Parallel()
 
@@ -473,14 +631,21 @@ func TestAccResourceLKECluster_autoScaler(t *testing.T) {
 					Check: resource.ComposeTestCheckFunc(
 						resource.TestCheckResourceAttr(resourceClusterName, "label", clusterName),
 						resource.TestCheckResourceAttr(resourceClusterName, "pool.#", "2"),
-						resource.TestCheckResourceAttr(resourceClusterName, "pool.0.count", "5"),
-						resource.TestCheckResourceAttr(resourceClusterName, "pool.0.autoscaler.#", "1"),
-						resource.TestCheckResourceAttr(resourceClusterName, "pool.0.autoscaler.0.min", "3"),
-						resource.TestCheckResourceAttr(resourceClusterName, "pool.0.autoscaler.0.max", "8"),
-						resource.TestCheckResourceAttr(resourceClusterName, "pool.1.count", "3"),
-						resource.TestCheckResourceAttr(resourceClusterName, "pool.1.autoscaler.#", "1"),
-						resource.TestCheckResourceAttr(resourceClusterName, "pool.1.autoscaler.0.min", "1"),
-						resource.TestCheckResourceAttr(resourceClusterName, "pool.1.autoscaler.0.max", "8"),
+						resource.TestCheckTypeSetElemNestedAttrs(resourceClusterName, "pool.*", map[string]string{
+							"name":             "default",
+							"count":            "5",
+							"autoscaler.#":     "1",
+							"autoscaler.0.min": "3",
+							"autoscaler.0.max": "8",
+						}),
+						resource.TestCheckTypeSetElemNestedAttrs(resourceClusterName, "pool.*", map[string]string{
+							"name":             "secondary",
+							"count":            "3",
+							"autoscaler.#":     "1",
+							"autoscaler.0.min": "1",
+							"autoscaler.0.max": "8",
+						}),
+						resource.TestCheckResourceAttr(resourceClusterName, "node_pools.%", "2"),
 						resource.TestCheckResourceAttr(resourceClusterName, "status", "ready"),
 					),
 				},
@@ -627,7 +792,7 @@ func TestAccResourceLKEClusterNodePoolTaintsLabels(t *testing.T) {
 						map[string]string{"foo": "bar"},
 					),
 					Check: resource.ComposeTestCheckFunc(
-						resource.TestCheckResourceAttr(resourceClusterName, "pool.0.nodes.#", "1"),
+						resource.TestCheckResourceAttr(resourceClusterName, "node_pools.default.nodes.#", "1"),
 						resource.TestCheckResourceAttr(resourceClusterName, "pool.0.taint.#", "1"),
 						resource.TestCheckResourceAttr(resourceClusterName, "pool.0.taint.0.effect", "PreferNoSchedule"),
 						resource.TestCheckResourceAttr(resourceClusterName, "pool.0.taint.0.key", "foo"),
@@ -648,7 +813,7 @@ func TestAccResourceLKEClusterNodePoolTaintsLabels(t *testing.T) {
 						map[string]string{"baz": "qux"},
 					),
 					Check: resource.ComposeTestCheckFunc(
-						resource.TestCheckResourceAttr(resourceClusterName, "pool.0.nodes.#", "1"),
+						resource.TestCheckResourceAttr(resourceClusterName, "node_pools.default.nodes.#", "1"),
 						resource.TestCheckResourceAttr(resourceClusterName, "pool.0.taint.#", "1"),
 						resource.TestCheckResourceAttr(resourceClusterName, "pool.0.taint.0.effect", "PreferNoSchedule"),
 						resource.TestCheckResourceAttr(resourceClusterName, "pool.0.taint.0.key", "baz"),
@@ -660,7 +825,7 @@ func TestAccResourceLKEClusterNodePoolTaintsLabels(t *testing.T) {
 					// remove taints and labels
 					Config: tmpl.DataTaintsLabels(t, clusterName, k8sVersionLatest, testRegion, nil, nil),
 					Check: resource.ComposeTestCheckFunc(
-						resource.TestCheckResourceAttr(resourceClusterName, "pool.0.nodes.#", "1"),
+						resource.TestCheckResourceAttr(resourceClusterName, "node_pools.default.nodes.#", "1"),
 						resource.TestCheckResourceAttr(resourceClusterName, "pool.0.taint.#", "0"),
 						resource.TestCheckResourceAttr(resourceClusterName, "pool.0.labels.#", "0"),
 					),
@@ -678,7 +843,7 @@ func TestAccResourceLKEClusterNodePoolTaintsLabels(t *testing.T) {
 						map[string]string{"foo": "bar"},
 					),
 					Check: resource.ComposeTestCheckFunc(
-						resource.TestCheckResourceAttr(resourceClusterName, "pool.0.nodes.#", "1"),
+						resource.TestCheckResourceAttr(resourceClusterName, "node_pools.default.nodes.#", "1"),
 						resource.TestCheckResourceAttr(resourceClusterName, "pool.0.taint.#", "1"),
 						resource.TestCheckResourceAttr(resourceClusterName, "pool.0.taint.0.effect", "PreferNoSchedule"),
 						resource.TestCheckResourceAttr(resourceClusterName, "pool.0.taint.0.key", "foo"),
@@ -687,10 +852,11 @@ func TestAccResourceLKEClusterNodePoolTaintsLabels(t *testing.T) {
 					),
 				},
 				{
-					ResourceName:            resourceClusterName,
-					ImportState:             true,
-					ImportStateVerify:       true,
-					ImportStateVerifyIgnore: []string{"pool.0.nodes.0.status"},
+					ResourceName:      resourceClusterName,
+					ImportState:       true,
+					ImportStateVerify: true,
+					// Imported node pools are named after their IDs
+					ImportStateVerifyIgnore: []string{"pool", "node_pools"},
 				},
 			},
 		})
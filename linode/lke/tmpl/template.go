@@ -13,15 +13,17 @@ type TaintData struct {
 }
 
 type TemplateData struct {
-	Label            string
-	K8sVersion       string
-	HighAvailability bool
-	Region           string
-	ACLEnabled       bool
-	IPv4             string
-	IPv6             string
-	Taints           []TaintData
-	Labels           map[string]string
+	Label             string
+	K8sVersion        string
+	HighAvailability  bool
+	Region            string
+	ACLEnabled        bool
+	IPv4              string
+	IPv6              string
+	Taints            []TaintData
+	Labels            map[string]string
+	RotateCredentials string
+	PoolCount         int
 }
 
 func Basic(t testing.TB, name, version, region string) string {
@@ -43,11 +45,45 @@ func ManyPools(t testing.TB, name, k8sVersion, region string) string {
 		})
 }
 
+func UnnamedPools(t testing.TB, name, k8sVersion, region string) string {
+	return acceptance.ExecuteTemplate(t,
+		"lke_cluster_unnamed_pools", TemplateData{
+			Label:      name,
+			K8sVersion: k8sVersion,
+			Region:     region,
+		})
+}
+
 func ComplexPools(t testing.TB, name, version, region string) string {
 	return acceptance.ExecuteTemplate(t,
 		"lke_cluster_complex_pools", TemplateData{Label: name, K8sVersion: version, Region: region})
 }
 
+func ComplexPoolsRemoved(t testing.TB, name, version, region string) string {
+	return acceptance.ExecuteTemplate(t,
+		"lke_cluster_complex_pools_removed", TemplateData{Label: name, K8sVersion: version, Region: region})
+}
+
+func RotateCredentials(t testing.TB, name, version, region, trigger string) string {
+	return acceptance.ExecuteTemplate(t,
+		"lke_cluster_rotate_credentials", TemplateData{
+			Label:             name,
+			K8sVersion:        version,
+			Region:            region,
+			RotateCredentials: trigger,
+		})
+}
+
+func WaitForKubernetesReady(t testing.TB, name, version, region string, poolCount int) string {
+	return acceptance.ExecuteTemplate(t,
+		"lke_cluster_wait_for_kubernetes_ready", TemplateData{
+			Label:      name,
+			K8sVersion: version,
+			Region:     region,
+			PoolCount:  poolCount,
+		})
+}
+
 func Autoscaler(t testing.TB, name, version, region string) string {
 	return acceptance.ExecuteTemplate(t,
 		"lke_cluster_autoscaler", TemplateData{Label: name, K8sVersion: version, Region: region})
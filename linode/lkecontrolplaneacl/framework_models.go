@@ -0,0 +1,128 @@
+package lkecontrolplaneacl
+
+import (
+	"context"
+	"net/netip"
+	"strconv"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/linode/linodego"
+	"github.com/linode/terraform-provider-linode/v2/linode/helper"
+)
+
+type ResourceModel struct {
+	ID        types.String `tfsdk:"id"`
+	ClusterID types.Int64  `tfsdk:"cluster_id"`
+	Enabled   types.Bool   `tfsdk:"enabled"`
+	IPv4      types.Set    `tfsdk:"ipv4"`
+	IPv6      types.Set    `tfsdk:"ipv6"`
+}
+
+func (data *ResourceModel) FlattenACL(
+	ctx context.Context,
+	clusterID int,
+	acl linodego.LKEClusterControlPlaneACL,
+	preserveKnown bool,
+	diags *diag.Diagnostics,
+) {
+	data.ID = helper.KeepOrUpdateString(data.ID, strconv.Itoa(clusterID), preserveKnown)
+	data.ClusterID = helper.KeepOrUpdateInt64(data.ClusterID, int64(clusterID), preserveKnown)
+	data.Enabled = helper.KeepOrUpdateBool(data.Enabled, acl.Enabled, preserveKnown)
+
+	ipv4 := make([]string, 0)
+	ipv6 := make([]string, 0)
+
+	if acl.Addresses != nil {
+		ipv4 = append(ipv4, acl.Addresses.IPv4...)
+		ipv6 = append(ipv6, acl.Addresses.IPv6...)
+	}
+
+	ipv4 = reconcileAddresses(ctx, data.IPv4, ipv4, diags)
+	ipv6 = reconcileAddresses(ctx, data.IPv6, ipv6, diags)
+	if diags.HasError() {
+		return
+	}
+
+	data.IPv4 = helper.KeepOrUpdateStringSet(data.IPv4, ipv4, preserveKnown, diags)
+	data.IPv6 = helper.KeepOrUpdateStringSet(data.IPv6, ipv6, preserveKnown, diags)
+}
+
+func (data *ResourceModel) GetUpdateOptions(
+	ctx context.Context,
+	diags *diag.Diagnostics,
+) linodego.LKEClusterControlPlaneACLUpdateOptions {
+	enabled := data.Enabled.ValueBool()
+
+	ipv4 := make([]string, 0)
+	ipv6 := make([]string, 0)
+
+	if !data.IPv4.IsNull() && !data.IPv4.IsUnknown() {
+		diags.Append(data.IPv4.ElementsAs(ctx, &ipv4, false)...)
+	}
+
+	if !data.IPv6.IsNull() && !data.IPv6.IsUnknown() {
+		diags.Append(data.IPv6.ElementsAs(ctx, &ipv6, false)...)
+	}
+
+	return linodego.LKEClusterControlPlaneACLUpdateOptions{
+		ACL: linodego.LKEClusterControlPlaneACLOptions{
+			Enabled: &enabled,
+			Addresses: &linodego.LKEClusterControlPlaneACLAddressesOptions{
+				IPv4: &ipv4,
+				IPv6: &ipv6,
+			},
+		},
+	}
+}
+
+// reconcileAddresses returns the addresses reported by the API, keeping the
+// known notation of any entry that only differs in form (e.g. 10.0.0.1 and
+// 10.0.0.1/32) so that only added or removed entries are reported as drift.
+func reconcileAddresses(
+	ctx context.Context,
+	known types.Set,
+	actual []string,
+	diags *diag.Diagnostics,
+) []string {
+	if known.IsNull() || known.IsUnknown() {
+		return actual
+	}
+
+	var knownAddresses []string
+	diags.Append(known.ElementsAs(ctx, &knownAddresses, false)...)
+	if diags.HasError() {
+		return nil
+	}
+
+	knownByCanonical := make(map[string]string, len(knownAddresses))
+	for _, address := range knownAddresses {
+		knownByCanonical[canonicalAddress(address)] = address
+	}
+
+	result := make([]string, len(actual))
+	for i, address := range actual {
+		if knownAddress, ok := knownByCanonical[canonicalAddress(address)]; ok {
+			result[i] = knownAddress
+			continue
+		}
+
+		result[i] = address
+	}
+
+	return result
+}
+
+// canonicalAddress returns the masked CIDR form of the given address or range,
+// or the value unchanged if it can't be parsed.
+func canonicalAddress(address string) string {
+	if prefix, err := netip.ParsePrefix(address); err == nil {
+		return prefix.Masked().String()
+	}
+
+	if addr, err := netip.ParseAddr(address); err == nil {
+		return netip.PrefixFrom(addr, addr.BitLen()).String()
+	}
+
+	return address
+}
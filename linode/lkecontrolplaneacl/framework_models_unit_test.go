@@ -0,0 +1,88 @@
+//go:build unit
+
+package lkecontrolplaneacl
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/linode/linodego"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestFlattenACL(t *testing.T) {
+	ctx := context.Background()
+
+	acl := linodego.LKEClusterControlPlaneACL{
+		Enabled: true,
+		Addresses: &linodego.LKEClusterControlPlaneACLAddresses{
+			IPv4: []string{"10.0.0.1/32", "192.168.0.0/24"},
+			IPv6: []string{"2001:db8::/32"},
+		},
+	}
+
+	data := ResourceModel{
+		IPv4: types.SetValueMust(types.StringType, []attr.Value{
+			types.StringValue("10.0.0.1"),
+		}),
+		IPv6: types.SetNull(types.StringType),
+	}
+
+	var diags diag.Diagnostics
+	data.FlattenACL(ctx, 123, acl, false, &diags)
+	assert.False(t, diags.HasError())
+
+	assert.Equal(t, "123", data.ID.ValueString())
+	assert.Equal(t, int64(123), data.ClusterID.ValueInt64())
+	assert.True(t, data.Enabled.ValueBool())
+
+	var ipv4, ipv6 []string
+	assert.False(t, data.IPv4.ElementsAs(ctx, &ipv4, false).HasError())
+	assert.False(t, data.IPv6.ElementsAs(ctx, &ipv6, false).HasError())
+
+	// The known notation of 10.0.0.1 is kept while the new range is reported as drift
+	assert.ElementsMatch(t, []string{"10.0.0.1", "192.168.0.0/24"}, ipv4)
+	assert.ElementsMatch(t, []string{"2001:db8::/32"}, ipv6)
+}
+
+func TestFlattenACLNoAddresses(t *testing.T) {
+	var diags diag.Diagnostics
+
+	data := ResourceModel{}
+	data.FlattenACL(context.Background(), 123, linodego.LKEClusterControlPlaneACL{}, false, &diags)
+	assert.False(t, diags.HasError())
+
+	assert.False(t, data.Enabled.ValueBool())
+	assert.Empty(t, data.IPv4.Elements())
+	assert.Empty(t, data.IPv6.Elements())
+}
+
+func TestGetUpdateOptions(t *testing.T) {
+	var diags diag.Diagnostics
+
+	data := ResourceModel{
+		Enabled: types.BoolValue(true),
+		IPv4: types.SetValueMust(types.StringType, []attr.Value{
+			types.StringValue("10.0.0.1"),
+		}),
+		IPv6: types.SetValueMust(types.StringType, []attr.Value{}),
+	}
+
+	opts := data.GetUpdateOptions(context.Background(), &diags)
+	assert.False(t, diags.HasError())
+
+	assert.True(t, *opts.ACL.Enabled)
+	assert.Equal(t, []string{"10.0.0.1"}, *opts.ACL.Addresses.IPv4)
+	assert.Empty(t, *opts.ACL.Addresses.IPv6)
+}
+
+func TestCanonicalAddress(t *testing.T) {
+	assert.Equal(t, "10.0.0.1/32", canonicalAddress("10.0.0.1"))
+	assert.Equal(t, "10.0.0.1/32", canonicalAddress("10.0.0.1/32"))
+	assert.Equal(t, "10.0.0.0/24", canonicalAddress("10.0.0.5/24"))
+	assert.Equal(t, "2001:db8::1/128", canonicalAddress("2001:db8::1"))
+	assert.Equal(t, "invalid", canonicalAddress("invalid"))
+}
@@ -0,0 +1,216 @@
+package lkecontrolplaneacl
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/linode/linodego"
+	"github.com/linode/terraform-provider-linode/v2/linode/helper"
+)
+
+func NewResource() resource.Resource {
+	return &Resource{
+		BaseResource: helper.NewBaseResource(
+			helper.BaseResourceConfig{
+				Name:   "linode_lke_control_plane_acl",
+				IDType: types.StringType,
+				Schema: &frameworkResourceSchema,
+			},
+		),
+	}
+}
+
+type Resource struct {
+	helper.BaseResource
+}
+
+func (r *Resource) Create(
+	ctx context.Context,
+	req resource.CreateRequest,
+	resp *resource.CreateResponse,
+) {
+	tflog.Debug(ctx, "Create "+r.Config.Name)
+
+	var plan ResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	clusterID := helper.FrameworkSafeInt64ToInt(plan.ClusterID.ValueInt64(), &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx = tflog.SetField(ctx, "cluster_id", clusterID)
+
+	r.apply(ctx, &plan, clusterID, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *Resource) Read(
+	ctx context.Context,
+	req resource.ReadRequest,
+	resp *resource.ReadResponse,
+) {
+	tflog.Debug(ctx, "Read "+r.Config.Name)
+
+	var state ResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if helper.FrameworkAttemptRemoveResourceForEmptyID(ctx, state.ID, resp) {
+		return
+	}
+
+	ctx = populateLogAttributes(ctx, state)
+
+	clusterID := helper.FrameworkSafeStringToInt(state.ID.ValueString(), &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	client := r.Meta.Client
+
+	tflog.Trace(ctx, "client.GetLKEClusterControlPlaneACL(...)")
+
+	aclResp, err := client.GetLKEClusterControlPlaneACL(ctx, clusterID)
+	if err != nil {
+		if linodego.IsNotFound(err) {
+			resp.Diagnostics.AddWarning(
+				"LKE Cluster Not Found",
+				fmt.Sprintf(
+					"Removing control plane ACL of LKE cluster %d from state because it no longer exists",
+					clusterID,
+				),
+			)
+			resp.State.RemoveResource(ctx)
+			return
+		}
+
+		resp.Diagnostics.AddError(
+			fmt.Sprintf("Failed to Get Control Plane ACL of LKE Cluster %d", clusterID),
+			err.Error(),
+		)
+		return
+	}
+
+	state.FlattenACL(ctx, clusterID, aclResp.ACL, false, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+func (r *Resource) Update(
+	ctx context.Context,
+	req resource.UpdateRequest,
+	resp *resource.UpdateResponse,
+) {
+	tflog.Debug(ctx, "Update "+r.Config.Name)
+
+	var plan, state ResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx = populateLogAttributes(ctx, state)
+
+	clusterID := helper.FrameworkSafeInt64ToInt(plan.ClusterID.ValueInt64(), &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	r.apply(ctx, &plan, clusterID, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+// apply replaces the control plane ACL of the cluster with the one in the
+// given plan, then refreshes the plan from the API response.
+func (r *Resource) apply(
+	ctx context.Context,
+	plan *ResourceModel,
+	clusterID int,
+	diags *diag.Diagnostics,
+) {
+	client := r.Meta.Client
+
+	updateOpts := plan.GetUpdateOptions(ctx, diags)
+	if diags.HasError() {
+		return
+	}
+
+	tflog.Debug(ctx, "client.UpdateLKEClusterControlPlaneACL(...)", map[string]any{
+		"options": updateOpts,
+	})
+
+	aclResp, err := client.UpdateLKEClusterControlPlaneACL(ctx, clusterID, updateOpts)
+	if err != nil {
+		diags.AddError(
+			fmt.Sprintf("Failed to Update Control Plane ACL of LKE Cluster %d", clusterID),
+			err.Error(),
+		)
+		return
+	}
+
+	plan.FlattenACL(ctx, clusterID, aclResp.ACL, true, diags)
+}
+
+func (r *Resource) Delete(
+	ctx context.Context,
+	req resource.DeleteRequest,
+	resp *resource.DeleteResponse,
+) {
+	tflog.Debug(ctx, "Delete "+r.Config.Name)
+
+	var state ResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx = populateLogAttributes(ctx, state)
+
+	clusterID := helper.FrameworkSafeInt64ToInt(state.ClusterID.ValueInt64(), &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	client := r.Meta.Client
+
+	tflog.Debug(ctx, "client.DeleteLKEClusterControlPlaneACL(...)")
+
+	if err := client.DeleteLKEClusterControlPlaneACL(ctx, clusterID); err != nil {
+		if linodego.IsNotFound(err) {
+			return
+		}
+
+		resp.Diagnostics.AddError(
+			fmt.Sprintf("Failed to Delete Control Plane ACL of LKE Cluster %d", clusterID),
+			err.Error(),
+		)
+	}
+}
+
+func populateLogAttributes(ctx context.Context, data ResourceModel) context.Context {
+	return helper.SetLogFieldBulk(ctx, map[string]any{
+		"cluster_id": data.ID.ValueString(),
+	})
+}
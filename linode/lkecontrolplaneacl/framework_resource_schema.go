@@ -0,0 +1,51 @@
+package lkecontrolplaneacl
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/linode/terraform-provider-linode/v2/linode/helper"
+)
+
+var frameworkResourceSchema = schema.Schema{
+	Attributes: map[string]schema.Attribute{
+		"id": schema.StringAttribute{
+			Description: "The ID of the LKE cluster.",
+			Computed:    true,
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.UseStateForUnknown(),
+			},
+		},
+		"cluster_id": schema.Int64Attribute{
+			Description: "The ID of the LKE cluster to manage the control plane ACL of.",
+			Required:    true,
+			PlanModifiers: []planmodifier.Int64{
+				int64planmodifier.RequiresReplace(),
+			},
+		},
+		"enabled": schema.BoolAttribute{
+			Description: "Defines default policy. A value of true results in a default policy of DENY. " +
+				"A value of false results in default policy of ALLOW.",
+			Optional: true,
+			Computed: true,
+			Default:  booldefault.StaticBool(true),
+		},
+		"ipv4": schema.SetAttribute{
+			Description: "A set of individual IPv4 addresses or CIDRs to ALLOW.",
+			ElementType: types.StringType,
+			Optional:    true,
+			Computed:    true,
+			Default:     helper.EmptySetDefault(types.StringType),
+		},
+		"ipv6": schema.SetAttribute{
+			Description: "A set of individual IPv6 addresses or CIDRs to ALLOW.",
+			ElementType: types.StringType,
+			Optional:    true,
+			Computed:    true,
+			Default:     helper.EmptySetDefault(types.StringType),
+		},
+	},
+}
@@ -0,0 +1,98 @@
+//go:build integration || lkecontrolplaneacl
+
+package lkecontrolplaneacl_test
+
+import (
+	"context"
+	"log"
+	"sort"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/linode/terraform-provider-linode/v2/linode/acceptance"
+	"github.com/linode/terraform-provider-linode/v2/linode/lkecontrolplaneacl/tmpl"
+)
+
+const testACLResName = "linode_lke_control_plane_acl.foobar"
+
+var (
+	k8sVersion string
+	testRegion string
+)
+
+func init() {
+	client, err := acceptance.GetTestClient()
+	if err != nil {
+		log.Fatalf("failed to get client: %s", err)
+	}
+
+	versions, err := client.ListLKEVersions(context.Background(), nil)
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	if len(versions) < 1 {
+		log.Fatal("no k8s versions found")
+	}
+
+	k8sVersions := make([]string, len(versions))
+	for i, v := range versions {
+		k8sVersions[i] = v.ID
+	}
+
+	sort.Strings(k8sVersions)
+
+	k8sVersion = k8sVersions[len(k8sVersions)-1]
+
+	region, err := acceptance.GetRandomRegionWithCaps([]string{"kubernetes"}, "core")
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	testRegion = region
+}
+
+func TestAccResourceLKEControlPlaneACL_basic(t *testing.T) {
+	t.Parallel()
+
+	acceptance.RunTestWithRetries(t, 2, func(t *acceptance.WrappedT) {
+		label := acctest.RandomWithPrefix("tf_test")
+
+		resource.Test(t, resource.TestCase{
+			PreCheck:                 func() { acceptance.PreCheck(t) },
+			ProtoV5ProviderFactories: acceptance.ProtoV5ProviderFactories,
+			CheckDestroy:             acceptance.CheckLKEClusterDestroy,
+			Steps: []resource.TestStep{
+				{
+					Config: tmpl.Basic(t, label, testRegion, k8sVersion, true,
+						[]string{"203.0.113.1", "198.51.100.0/24"}, []string{"2001:db8::/32"}),
+					Check: resource.ComposeTestCheckFunc(
+						resource.TestCheckResourceAttrPair(testACLResName, "id", "linode_lke_cluster.foobar", "id"),
+						resource.TestCheckResourceAttr(testACLResName, "enabled", "true"),
+						resource.TestCheckResourceAttr(testACLResName, "ipv4.#", "2"),
+						resource.TestCheckTypeSetElemAttr(testACLResName, "ipv4.*", "203.0.113.1"),
+						resource.TestCheckTypeSetElemAttr(testACLResName, "ipv4.*", "198.51.100.0/24"),
+						resource.TestCheckResourceAttr(testACLResName, "ipv6.#", "1"),
+						resource.TestCheckTypeSetElemAttr(testACLResName, "ipv6.*", "2001:db8::/32"),
+					),
+				},
+				{
+					Config: tmpl.Basic(t, label, testRegion, k8sVersion, false,
+						[]string{"198.51.100.0/24"}, []string{}),
+					Check: resource.ComposeTestCheckFunc(
+						resource.TestCheckResourceAttr(testACLResName, "enabled", "false"),
+						resource.TestCheckResourceAttr(testACLResName, "ipv4.#", "1"),
+						resource.TestCheckTypeSetElemAttr(testACLResName, "ipv4.*", "198.51.100.0/24"),
+						resource.TestCheckResourceAttr(testACLResName, "ipv6.#", "0"),
+					),
+				},
+				{
+					ResourceName:      testACLResName,
+					ImportState:       true,
+					ImportStateVerify: true,
+				},
+			},
+		})
+	})
+}
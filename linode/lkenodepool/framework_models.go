@@ -4,6 +4,7 @@ import (
 	"context"
 	"strconv"
 
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
 	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
 
@@ -24,6 +25,12 @@ type NodePoolModel struct {
 	Autoscaler     []NodePoolAutoscalerModel `tfsdk:"autoscaler"`
 	Taints         []NodePoolTaintModel      `tfsdk:"taint"`
 	Labels         types.Map                 `tfsdk:"labels"`
+
+	RecycleTrigger         types.Map                      `tfsdk:"recycle_trigger"`
+	RecycleStrategy        []NodePoolRecycleStrategyModel `tfsdk:"recycle_strategy"`
+	WaitForKubernetesReady types.Bool                     `tfsdk:"wait_for_kubernetes_ready"`
+
+	Timeouts timeouts.Value `tfsdk:"timeouts"`
 }
 
 type NodePoolAutoscalerModel struct {
@@ -31,6 +38,11 @@ type NodePoolAutoscalerModel struct {
 	Max types.Int64 `tfsdk:"max"`
 }
 
+type NodePoolRecycleStrategyModel struct {
+	Type           types.String `tfsdk:"type"`
+	MaxUnavailable types.Int64  `tfsdk:"max_unavailable"`
+}
+
 type NodePoolTaintModel struct {
 	Effect types.String `tfsdk:"effect"`
 	Key    types.String `tfsdk:"key"`
@@ -212,3 +224,28 @@ func (pool *NodePoolModel) getLKENodePoolTaints() []linodego.LKENodePoolTaint {
 
 	return taints
 }
+
+// GetRecycleStrategy returns the strategy used to recycle the nodes of this pool,
+// falling back to recycling the whole pool at once when none is configured.
+func (pool *NodePoolModel) GetRecycleStrategy(diags *diag.Diagnostics) RecycleStrategy {
+	result := RecycleStrategy{
+		Type:           RecycleStrategyPool,
+		MaxUnavailable: DefaultRecycleMaxUnavailable,
+	}
+
+	if len(pool.RecycleStrategy) < 1 {
+		return result
+	}
+
+	strategy := pool.RecycleStrategy[0]
+
+	if !strategy.Type.IsNull() && !strategy.Type.IsUnknown() {
+		result.Type = strategy.Type.ValueString()
+	}
+
+	if !strategy.MaxUnavailable.IsNull() && !strategy.MaxUnavailable.IsUnknown() {
+		result.MaxUnavailable = helper.FrameworkSafeInt64ToInt(strategy.MaxUnavailable.ValueInt64(), diags)
+	}
+
+	return result
+}
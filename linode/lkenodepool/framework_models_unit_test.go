@@ -11,6 +11,8 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/linode/linodego"
 	"github.com/stretchr/testify/assert"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 )
 
 func TestParseNodePool(t *testing.T) {
@@ -97,6 +99,117 @@ func TestSetNodePoolUpdateOptions(t *testing.T) {
 	assert.Equal(t, 5, updateOpts.Autoscaler.Max)
 }
 
+func TestGetRecycleStrategy(t *testing.T) {
+	var diags diag.Diagnostics
+
+	nodePoolModel := createNodePoolModel()
+
+	strategy := nodePoolModel.GetRecycleStrategy(&diags)
+	assert.False(t, diags.HasError())
+	assert.Equal(t, RecycleStrategyPool, strategy.Type)
+	assert.Equal(t, DefaultRecycleMaxUnavailable, strategy.MaxUnavailable)
+
+	nodePoolModel.RecycleStrategy = []NodePoolRecycleStrategyModel{
+		{
+			Type:           types.StringValue(RecycleStrategyNode),
+			MaxUnavailable: types.Int64Value(2),
+		},
+	}
+
+	strategy = nodePoolModel.GetRecycleStrategy(&diags)
+	assert.False(t, diags.HasError())
+	assert.Equal(t, RecycleStrategyNode, strategy.Type)
+	assert.Equal(t, 2, strategy.MaxUnavailable)
+}
+
+func TestRecycleBatches(t *testing.T) {
+	nodes := []linodego.LKENodePoolLinode{
+		{InstanceID: 1, ID: "linode123"},
+		{InstanceID: 2, ID: "linode124"},
+		{InstanceID: 3, ID: "linode125"},
+	}
+
+	batches := RecycleBatches(nodes, RecycleStrategy{Type: RecycleStrategyPool, MaxUnavailable: 1})
+	assert.Equal(t, [][]linodego.LKENodePoolLinode{nodes}, batches)
+
+	batches = RecycleBatches(nodes, RecycleStrategy{Type: RecycleStrategyNode, MaxUnavailable: 2})
+	assert.Equal(t, [][]linodego.LKENodePoolLinode{nodes[:2], nodes[2:]}, batches)
+
+	batches = RecycleBatches(nodes, RecycleStrategy{Type: RecycleStrategyNode})
+	assert.Len(t, batches, 3)
+
+	assert.Empty(t, RecycleBatches(nil, RecycleStrategy{Type: RecycleStrategyNode, MaxUnavailable: 1}))
+}
+
+func TestNodePoolNotReadyReason(t *testing.T) {
+	pool := &linodego.LKENodePool{
+		Linodes: []linodego.LKENodePoolLinode{
+			{InstanceID: 1, ID: "linode123"},
+		},
+		Labels: linodego.LKENodePoolLabels{"foo": "bar"},
+		Taints: []linodego.LKENodePoolTaint{
+			{Key: "foo", Value: "bar", Effect: linodego.LKENodePoolTaintEffectNoSchedule},
+		},
+	}
+
+	node := corev1.Node{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:   "lke1-123",
+			Labels: map[string]string{"foo": "bar"},
+		},
+		Spec: corev1.NodeSpec{
+			ProviderID: "linode://1",
+			Taints: []corev1.Taint{
+				{Key: "foo", Value: "bar", Effect: corev1.TaintEffectNoSchedule},
+			},
+		},
+		Status: corev1.NodeStatus{
+			Conditions: []corev1.NodeCondition{
+				{Type: corev1.NodeReady, Status: corev1.ConditionTrue},
+			},
+		},
+	}
+
+	assert.Empty(t, nodePoolNotReadyReason(pool, []corev1.Node{node}))
+	assert.Contains(t, nodePoolNotReadyReason(pool, nil), "has not registered")
+
+	notReady := *node.DeepCopy()
+	notReady.Status.Conditions[0].Status = corev1.ConditionFalse
+	assert.Contains(t, nodePoolNotReadyReason(pool, []corev1.Node{notReady}), "is not Ready")
+
+	unlabeled := *node.DeepCopy()
+	unlabeled.Labels["foo"] = "baz"
+	assert.Contains(t, nodePoolNotReadyReason(pool, []corev1.Node{unlabeled}), "missing label foo=bar")
+
+	untainted := *node.DeepCopy()
+	untainted.Spec.Taints = nil
+	assert.Contains(t, nodePoolNotReadyReason(pool, []corev1.Node{untainted}), "missing taint foo=bar:NoSchedule")
+}
+
+func TestNodePoolLinodesNotReadyReason(t *testing.T) {
+	pool := &linodego.LKENodePool{
+		Count: 2,
+		Linodes: []linodego.LKENodePoolLinode{
+			{InstanceID: 1, ID: "linode123", Status: linodego.LKELinodeReady},
+			{InstanceID: 2, ID: "linode124", Status: linodego.LKELinodeReady},
+		},
+	}
+
+	assert.Empty(t, nodePoolLinodesNotReadyReason(pool))
+
+	// A recycled node has been deleted but not yet replaced
+	recycling := *pool
+	recycling.Linodes = pool.Linodes[:1]
+	assert.Contains(t, nodePoolLinodesNotReadyReason(&recycling), "1 of 2 nodes")
+
+	notReady := *pool
+	notReady.Linodes = []linodego.LKENodePoolLinode{
+		pool.Linodes[0],
+		{InstanceID: 3, ID: "linode125", Status: linodego.LKELinodeNotReady},
+	}
+	assert.Contains(t, nodePoolLinodesNotReadyReason(&notReady), "node linode125 is not ready")
+}
+
 func createNodePoolModel() *NodePoolModel {
 	tags, _ := types.SetValueFrom(context.Background(), types.StringType, []string{"production", "web-server"})
 	nodes, _ := flattenLKENodePoolLinodeList([]linodego.LKENodePoolLinode{
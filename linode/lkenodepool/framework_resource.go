@@ -4,7 +4,10 @@ import (
 	"context"
 	"fmt"
 	"strconv"
+	"time"
 
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/types"
@@ -13,6 +16,11 @@ import (
 	"github.com/linode/terraform-provider-linode/v2/linode/helper"
 )
 
+const (
+	createLKENodePoolTimeout = 35 * time.Minute
+	updateLKENodePoolTimeout = 40 * time.Minute
+)
+
 func NewResource() resource.Resource {
 	return &Resource{
 		BaseResource: helper.NewBaseResource(
@@ -20,6 +28,10 @@ func NewResource() resource.Resource {
 				Name:   "linode_lke_node_pool",
 				IDType: types.StringType,
 				Schema: &resourceSchema,
+				TimeoutOpts: &timeouts.Opts{
+					Create: true,
+					Update: true,
+				},
 			},
 		),
 	}
@@ -29,6 +41,80 @@ type Resource struct {
 	helper.BaseResource
 }
 
+func (r *Resource) ModifyPlan(
+	ctx context.Context,
+	req resource.ModifyPlanRequest,
+	resp *resource.ModifyPlanResponse,
+) {
+	// Nothing to validate when the resource is being destroyed
+	// or the provider hasn't been configured yet
+	if req.Plan.Raw.IsNull() || r.Meta == nil {
+		return
+	}
+
+	var clusterID types.Int64
+	var nodeType types.String
+
+	resp.Diagnostics.Append(req.Plan.GetAttribute(ctx, path.Root("cluster_id"), &clusterID)...)
+	resp.Diagnostics.Append(req.Plan.GetAttribute(ctx, path.Root("type"), &nodeType)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if clusterID.IsUnknown() || nodeType.IsUnknown() {
+		return
+	}
+
+	if !req.State.Raw.IsNull() {
+		var stateClusterID types.Int64
+		var stateNodeType types.String
+
+		resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root("cluster_id"), &stateClusterID)...)
+		resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root("type"), &stateNodeType)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+
+		if clusterID.Equal(stateClusterID) && nodeType.Equal(stateNodeType) {
+			return
+		}
+	}
+
+	id := helper.FrameworkSafeInt64ToInt(clusterID.ValueInt64(), &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	client := r.Meta.Client
+
+	cluster, err := client.GetLKECluster(ctx, id)
+	if err != nil {
+		// The cluster may be planned for replacement
+		if linodego.IsNotFound(err) {
+			return
+		}
+
+		resp.Diagnostics.AddError(fmt.Sprintf("Failed to Get LKE Cluster %d", id), err.Error())
+		return
+	}
+
+	linodeType, err := client.GetType(ctx, nodeType.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			fmt.Sprintf("Failed to Get Linode Type %s", nodeType.ValueString()), err.Error(),
+		)
+		return
+	}
+
+	helper.ValidateRegionCapabilities(
+		ctx,
+		client,
+		cluster.Region,
+		helper.TypeRegionCapabilityRequirements(linodeType, path.Root("type")),
+		&resp.Diagnostics,
+	)
+}
+
 func (r *Resource) Read(
 	ctx context.Context,
 	req resource.ReadRequest,
@@ -80,6 +166,11 @@ func (r *Resource) Read(
 		return
 	}
 
+	// wait_for_kubernetes_ready is not returned by the API, so it is unset after import
+	if data.WaitForKubernetesReady.IsNull() {
+		data.WaitForKubernetesReady = types.BoolValue(false)
+	}
+
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 	tflog.Trace(ctx, "Read linode_lke_node_pool done")
 }
@@ -98,6 +189,15 @@ func (r *Resource) Create(
 		return
 	}
 
+	createTimeout, diags := plan.Timeouts.Create(ctx, createLKENodePoolTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, createTimeout)
+	defer cancel()
+
 	var createOpts linodego.LKENodePoolCreateOptions
 
 	plan.SetNodePoolCreateOptions(ctx, &createOpts, &resp.Diagnostics)
@@ -140,6 +240,15 @@ func (r *Resource) Create(
 		return
 	}
 
+	if plan.WaitForKubernetesReady.ValueBool() {
+		tflog.Debug(ctx, "waiting for node pool nodes to be ready in Kubernetes")
+
+		if err := WaitForNodePoolKubernetesReady(ctx, *client, clusterID, readyPool); err != nil {
+			resp.Diagnostics.AddError("Linode Node Pool is not ready in Kubernetes after create", err.Error())
+			return
+		}
+	}
+
 	plan.FlattenLKENodePool(ctx, readyPool, true, &resp.Diagnostics)
 	if resp.Diagnostics.HasError() {
 		return
@@ -172,6 +281,15 @@ func (r *Resource) Update(
 		return
 	}
 
+	updateTimeout, diags := plan.Timeouts.Update(ctx, updateLKENodePoolTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, updateTimeout)
+	defer cancel()
+
 	var updateOpts linodego.LKENodePoolUpdateOptions
 
 	plan.SetNodePoolUpdateOptions(ctx, &updateOpts, &resp.Diagnostics)
@@ -206,6 +324,23 @@ func (r *Resource) Update(
 		return
 	}
 
+	// The recycle trigger only recycles the pool when it changes after creation
+	if !plan.RecycleTrigger.IsNull() && !plan.RecycleTrigger.Equal(state.RecycleTrigger) {
+		readyPool = r.recycle(ctx, plan, clusterID, readyPool, &resp.Diagnostics)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+
+	if plan.WaitForKubernetesReady.ValueBool() {
+		tflog.Debug(ctx, "waiting for node pool nodes to be ready in Kubernetes")
+
+		if err := WaitForNodePoolKubernetesReady(ctx, *client, clusterID, readyPool); err != nil {
+			resp.Diagnostics.AddError("Linode Node Pool is not ready in Kubernetes after update", err.Error())
+			return
+		}
+	}
+
 	plan.FlattenLKENodePool(ctx, readyPool, true, &resp.Diagnostics)
 
 	// Workaround for Crossplane issue where ID is not
@@ -222,6 +357,41 @@ func (r *Resource) Update(
 	tflog.Trace(ctx, "Update linode_lke_node_pool done")
 }
 
+func (r *Resource) recycle(
+	ctx context.Context,
+	plan NodePoolModel,
+	clusterID int,
+	pool *linodego.LKENodePool,
+	diags *diag.Diagnostics,
+) *linodego.LKENodePool {
+	client := r.Meta.Client
+	pollMs := int(r.Meta.Config.EventPollMilliseconds.ValueInt64())
+
+	strategy := plan.GetRecycleStrategy(diags)
+	if diags.HasError() {
+		return nil
+	}
+
+	tflog.Debug(ctx, "Recycling LKE node pool", map[string]any{
+		"cluster_id":       clusterID,
+		"pool_id":          pool.ID,
+		"recycle_strategy": strategy,
+	})
+
+	if err := RecycleNodePool(ctx, *client, pollMs, clusterID, *pool, strategy); err != nil {
+		diags.AddError("Failed to recycle Linode Node Pool", err.Error())
+		return nil
+	}
+
+	recycledPool, err := WaitForNodePoolReady(ctx, *client, pollMs, clusterID, pool.ID)
+	if err != nil {
+		diags.AddError("Linode Node Pool is not ready after recycle", err.Error())
+		return nil
+	}
+
+	return recycledPool
+}
+
 func (r *Resource) Delete(
 	ctx context.Context,
 	req resource.DeleteRequest,
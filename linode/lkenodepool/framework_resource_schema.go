@@ -7,8 +7,11 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64default"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
@@ -81,6 +84,18 @@ var resourceSchema = schema.Schema{
 			Computed:    true,
 			Default:     helper.EmptyMapDefault(types.StringType),
 		},
+		"wait_for_kubernetes_ready": schema.BoolAttribute{
+			Description: "Whether to wait for every node of the pool to be registered and Ready in Kubernetes, " +
+				"with the declared labels and taints, before create and update return.",
+			Optional: true,
+			Computed: true,
+			Default:  booldefault.StaticBool(false),
+		},
+		"recycle_trigger": schema.MapAttribute{
+			Description: "Arbitrary values that recycle the nodes of the pool when they change.",
+			Optional:    true,
+			ElementType: types.StringType,
+		},
 	},
 	Blocks: map[string]schema.Block{
 		"autoscaler": schema.ListNestedBlock{
@@ -100,6 +115,36 @@ var resourceSchema = schema.Schema{
 			},
 		},
 
+		"recycle_strategy": schema.ListNestedBlock{
+			Description: "Controls how the nodes of the pool are replaced when recycle_trigger changes.",
+			Validators: []validator.List{
+				listvalidator.SizeAtMost(1),
+			},
+			NestedObject: schema.NestedBlockObject{
+				Attributes: map[string]schema.Attribute{
+					"type": schema.StringAttribute{
+						Description: "The unit recycled at once: `pool` recycles every node of the pool together " +
+							"and `node` recycles max_unavailable nodes at a time.",
+						Optional: true,
+						Computed: true,
+						Default:  stringdefault.StaticString(RecycleStrategyPool),
+						Validators: []validator.String{
+							stringvalidator.OneOf(RecycleStrategyPool, RecycleStrategyNode),
+						},
+					},
+					"max_unavailable": schema.Int64Attribute{
+						Description: "The maximum number of nodes recycled at once when type is `node`.",
+						Optional:    true,
+						Computed:    true,
+						Default:     int64default.StaticInt64(DefaultRecycleMaxUnavailable),
+						Validators: []validator.Int64{
+							int64validator.AtLeast(1),
+						},
+					},
+				},
+			},
+		},
+
 		"taint": schema.SetNestedBlock{
 			Description: "Kubernetes taints to add to node pool nodes. Taints help control how " +
 				"pods are scheduled onto nodes, specifically allowing them to repel certain pods.",
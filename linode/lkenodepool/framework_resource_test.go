@@ -492,3 +492,112 @@ func resourceImportStateID(s *terraform.State) (string, error) {
 	}
 	return fmt.Sprintf("%d,%d", clusterID, id), nil
 }
+
+func TestAccResourceNodePool_recycle(t *testing.T) {
+	t.Parallel()
+
+	resName := "linode_lke_node_pool.foobar"
+	clusterLabel := acctest.RandomWithPrefix("tf_test_")
+	poolTag := acctest.RandomWithPrefix("tf_test_")
+
+	templateData := createTemplateData()
+	templateData.ClusterLabel = clusterLabel
+	templateData.PoolTag = poolTag
+	templateData.AutoscalerEnabled = false
+	templateData.NodeCount = 2
+	templateData.RecycleStrategy = "node"
+	templateData.MaxUnavailable = 1
+	templateData.RecycleTrigger = "1"
+	createConfig := createResourceConfig(t, &templateData)
+	templateData.RecycleTrigger = "2"
+	recycleConfig := createResourceConfig(t, &templateData)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acceptance.PreCheck(t) },
+		ProtoV5ProviderFactories: acceptance.ProtoV5ProviderFactories,
+		CheckDestroy:             checkNodePoolDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: createConfig,
+				Check: resource.ComposeTestCheckFunc(
+					checkNodePoolExists,
+					resource.TestCheckResourceAttr(resName, "node_count", "2"),
+					resource.TestCheckResourceAttr(resName, "nodes.#", "2"),
+					resource.TestCheckResourceAttr(resName, "recycle_trigger.version", "1"),
+					resource.TestCheckResourceAttr(resName, "recycle_strategy.0.type", "node"),
+					resource.TestCheckResourceAttr(resName, "recycle_strategy.0.max_unavailable", "1"),
+				),
+			},
+			{
+				Config: recycleConfig,
+				Check: resource.ComposeTestCheckFunc(
+					checkNodePoolExists,
+					resource.TestCheckResourceAttr(resName, "nodes.#", "2"),
+					resource.TestCheckResourceAttr(resName, "nodes.0.status", "ready"),
+					resource.TestCheckResourceAttr(resName, "nodes.1.status", "ready"),
+					resource.TestCheckResourceAttr(resName, "recycle_trigger.version", "2"),
+				),
+			},
+			{
+				ResourceName:            resName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateIdFunc:       resourceImportStateID,
+				ImportStateVerifyIgnore: []string{"recycle_trigger", "recycle_strategy"},
+			},
+		},
+	})
+}
+
+func TestAccResourceNodePool_waitForKubernetesReady(t *testing.T) {
+	t.Parallel()
+
+	resName := "linode_lke_node_pool.foobar"
+	clusterLabel := acctest.RandomWithPrefix("tf_test_")
+	poolTag := acctest.RandomWithPrefix("tf_test_")
+
+	templateData := createTemplateData()
+	templateData.ClusterLabel = clusterLabel
+	templateData.PoolTag = poolTag
+	templateData.AutoscalerEnabled = false
+	templateData.NodeCount = 1
+	templateData.WaitForKubernetesReady = true
+	templateData.Labels = map[string]string{"foo": "bar"}
+	templateData.Taints = []tmpl.TaintData{
+		{
+			Effect: "PreferNoSchedule",
+			Key:    "foo",
+			Value:  "bar",
+		},
+	}
+	createConfig := createResourceConfig(t, &templateData)
+	templateData.NodeCount = 2
+	updateConfig := createResourceConfig(t, &templateData)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acceptance.PreCheck(t) },
+		ProtoV5ProviderFactories: acceptance.ProtoV5ProviderFactories,
+		CheckDestroy:             checkNodePoolDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: createConfig,
+				Check: resource.ComposeTestCheckFunc(
+					checkNodePoolExists,
+					resource.TestCheckResourceAttr(resName, "wait_for_kubernetes_ready", "true"),
+					resource.TestCheckResourceAttr(resName, "nodes.#", "1"),
+					resource.TestCheckResourceAttr(resName, "nodes.0.status", "ready"),
+				),
+			},
+			{
+				Config: updateConfig,
+				Check: resource.ComposeTestCheckFunc(
+					checkNodePoolExists,
+					resource.TestCheckResourceAttr(resName, "node_count", "2"),
+					resource.TestCheckResourceAttr(resName, "nodes.#", "2"),
+					resource.TestCheckResourceAttr(resName, "nodes.0.status", "ready"),
+					resource.TestCheckResourceAttr(resName, "nodes.1.status", "ready"),
+				),
+			},
+		},
+	})
+}
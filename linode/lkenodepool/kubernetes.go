@@ -0,0 +1,123 @@
+package lkenodepool
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/linode/linodego"
+	"github.com/linode/linodego/k8s"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+const linodeProviderIDPrefix = "linode://"
+
+// WaitForNodePoolKubernetesReady waits for every node of the given pool to have
+// registered with the cluster's Kubernetes API server, report Ready and carry
+// the labels and taints of the pool. The wait is bounded by the deadline of the
+// given context.
+func WaitForNodePoolKubernetesReady(
+	ctx context.Context, client linodego.Client, clusterID int, pool *linodego.LKENodePool,
+) error {
+	ctx = tflog.SetField(ctx, "node_pool_id", pool.ID)
+
+	tflog.Trace(ctx, "client.WaitForLKEClusterConditions(...)", map[string]any{
+		"condition": "NodePoolKubernetesReady",
+	})
+
+	// The API server may briefly be unreachable while nodes are being replaced,
+	// so intermittent errors are retried until the context is done.
+	err := client.WaitForLKEClusterConditions(ctx, clusterID, linodego.LKEClusterPollOptions{
+		Retry: true,
+	}, NodePoolKubernetesReady(pool))
+	if err != nil {
+		return fmt.Errorf(
+			"failed to wait for LKE Cluster (%d) Pool (%d) nodes to be ready in Kubernetes: %w", clusterID, pool.ID, err,
+		)
+	}
+
+	return nil
+}
+
+// NodePoolKubernetesReady is a ClusterConditionFunc which polls for every node of
+// the given pool to be registered, Ready and labeled and tainted as declared.
+func NodePoolKubernetesReady(pool *linodego.LKENodePool) linodego.ClusterConditionFunc {
+	return func(ctx context.Context, options linodego.ClusterConditionOptions) (bool, error) {
+		clientset, err := k8s.BuildClientsetFromConfig(options.LKEClusterKubeconfig, options.TransportWrapper)
+		if err != nil {
+			return false, err
+		}
+
+		nodes, err := clientset.CoreV1().Nodes().List(ctx, metav1.ListOptions{})
+		if err != nil {
+			return false, fmt.Errorf("failed to get nodes for cluster: %w", err)
+		}
+
+		if reason := nodePoolNotReadyReason(pool, nodes.Items); reason != "" {
+			tflog.Trace(ctx, "Node pool not yet ready in Kubernetes", map[string]any{
+				"reason": reason,
+			})
+			return false, nil
+		}
+
+		tflog.Trace(ctx, "All nodes ready in Kubernetes!")
+
+		return true, nil
+	}
+}
+
+// nodePoolNotReadyReason describes why the given pool isn't ready yet in
+// Kubernetes, or returns an empty string if every node of the pool is ready.
+func nodePoolNotReadyReason(pool *linodego.LKENodePool, nodes []corev1.Node) string {
+	nodesByProviderID := make(map[string]corev1.Node, len(nodes))
+	for _, node := range nodes {
+		nodesByProviderID[node.Spec.ProviderID] = node
+	}
+
+	for _, instance := range pool.Linodes {
+		node, ok := nodesByProviderID[linodeProviderIDPrefix+strconv.Itoa(instance.InstanceID)]
+		if !ok {
+			return fmt.Sprintf("node %s has not registered with the API server", instance.ID)
+		}
+
+		if !nodeIsReady(node) {
+			return fmt.Sprintf("node %s is not Ready", node.Name)
+		}
+
+		for key, value := range pool.Labels {
+			if actual, ok := node.Labels[key]; !ok || actual != value {
+				return fmt.Sprintf("node %s is missing label %s=%s", node.Name, key, value)
+			}
+		}
+
+		for _, taint := range pool.Taints {
+			if !nodeHasTaint(node, taint) {
+				return fmt.Sprintf("node %s is missing taint %s=%s:%s", node.Name, taint.Key, taint.Value, taint.Effect)
+			}
+		}
+	}
+
+	return ""
+}
+
+func nodeIsReady(node corev1.Node) bool {
+	for _, condition := range node.Status.Conditions {
+		if condition.Type == corev1.NodeReady {
+			return condition.Status == corev1.ConditionTrue
+		}
+	}
+
+	return false
+}
+
+func nodeHasTaint(node corev1.Node, taint linodego.LKENodePoolTaint) bool {
+	for _, t := range node.Spec.Taints {
+		if t.Key == taint.Key && t.Value == taint.Value && string(t.Effect) == string(taint.Effect) {
+			return true
+		}
+	}
+
+	return false
+}
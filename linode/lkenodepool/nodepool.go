@@ -9,6 +9,8 @@ import (
 	"github.com/linode/linodego"
 )
 
+// WaitForNodePoolReady waits until the given node pool has provisioned all of
+// its nodes and every node is ready.
 func WaitForNodePoolReady(
 	ctx context.Context, client linodego.Client, pollMs, clusterID, poolID int,
 ) (*linodego.LKENodePool, error) {
@@ -27,20 +29,10 @@ func WaitForNodePoolReady(
 				return nil, fmt.Errorf("failed to get LKE Cluster (%d) Pool (%d): %w", clusterID, poolID, err)
 			}
 
-			allNodesReady := true
-
-			for _, instance := range pool.Linodes {
-				if instance.Status == linodego.LKELinodeNotReady {
-					allNodesReady = false
-					tflog.Trace(ctx, "Node detected as unready", map[string]any{
-						"node_id":     instance.ID,
-						"instance_id": instance.InstanceID,
-					})
-					break
-				}
-			}
-
-			if !allNodesReady {
+			if reason := nodePoolLinodesNotReadyReason(pool); reason != "" {
+				tflog.Trace(ctx, "Node pool not yet ready", map[string]any{
+					"reason": reason,
+				})
 				continue
 			}
 
@@ -51,3 +43,109 @@ func WaitForNodePoolReady(
 		}
 	}
 }
+
+// nodePoolLinodesNotReadyReason returns why the nodes of the given pool aren't
+// ready yet, or an empty string if they are. Nodes removed by a recycle may
+// not have been replaced yet, so the pool isn't ready until it has all of its
+// nodes.
+func nodePoolLinodesNotReadyReason(pool *linodego.LKENodePool) string {
+	if len(pool.Linodes) != pool.Count {
+		return fmt.Sprintf("pool has %d of %d nodes", len(pool.Linodes), pool.Count)
+	}
+
+	for _, instance := range pool.Linodes {
+		if instance.Status == linodego.LKELinodeNotReady {
+			return fmt.Sprintf("node %s is not ready", instance.ID)
+		}
+	}
+
+	return ""
+}
+
+// WaitForNodesDeleted waits for the Linode instances backing the given
+// LKE nodes to be deleted.
+func WaitForNodesDeleted(
+	ctx context.Context,
+	client linodego.Client,
+	intervalMS int,
+	nodes []linodego.LKENodePoolLinode,
+) error {
+	ticker := time.NewTicker(time.Duration(intervalMS) * time.Millisecond)
+	defer ticker.Stop()
+
+	// Let's track which nodes still haven't been deleted
+	// using a pseudo-set
+	remainingNodes := make(map[int]bool, len(nodes))
+	for _, node := range nodes {
+		remainingNodes[node.InstanceID] = true
+	}
+
+	// Filter down to only instance deletion events
+	f := linodego.Filter{
+		OrderBy: "created",
+		Order:   linodego.Descending,
+	}
+	f.AddField(linodego.Eq, "entity.type", linodego.EntityLinode)
+	f.AddField(linodego.Eq, "action", linodego.ActionLinodeDelete)
+
+	filterBytes, err := f.MarshalJSON()
+	if err != nil {
+		return fmt.Errorf("failed to marshal filter: %w", err)
+	}
+
+	listOpts := linodego.ListOptions{
+		Filter:      string(filterBytes),
+		PageOptions: &linodego.PageOptions{Page: 1},
+	}
+
+	for {
+		select {
+		case <-ticker.C:
+			tflog.Trace(ctx, "client.ListEvents(...)", map[string]any{
+				"options": listOpts,
+			})
+
+			events, err := client.ListEvents(ctx, &listOpts)
+			if err != nil {
+				return fmt.Errorf("failed to list events: %w", err)
+			}
+
+			for _, event := range events {
+				var instID int
+
+				// Sometimes go will parse entity.id as float,
+				// we should convert accordingly
+				switch event.Entity.ID.(type) {
+				case int:
+					instID = event.Entity.ID.(int)
+				case float64:
+					instID = int(event.Entity.ID.(float64))
+				case float32:
+					instID = int(event.Entity.ID.(float32))
+				default:
+					// This shouldn't happen, but let's handle it gracefully just in case
+					tflog.Trace(ctx, "Invalid entity.id type detected", map[string]any{
+						"value": event.Entity.ID,
+						"type":  fmt.Sprintf("%T", event.Entity.ID),
+					})
+					continue
+				}
+
+				if _, ok := remainingNodes[instID]; ok {
+					delete(remainingNodes, instID)
+					tflog.Trace(ctx, "Node detected as deleted", map[string]any{
+						"instance_id":     instID,
+						"nodes_remaining": len(remainingNodes),
+					})
+				}
+			}
+
+			// All nodes have been deleted
+			if len(remainingNodes) < 1 {
+				return nil
+			}
+		case <-ctx.Done():
+			return fmt.Errorf("failed to wait for node deletion: %w", ctx.Err())
+		}
+	}
+}
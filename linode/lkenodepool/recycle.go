@@ -0,0 +1,108 @@
+package lkenodepool
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/linode/linodego"
+)
+
+const (
+	// RecycleStrategyCluster recycles every node of the cluster at once.
+	RecycleStrategyCluster = "cluster"
+
+	// RecycleStrategyPool recycles one node pool at a time.
+	RecycleStrategyPool = "pool"
+
+	// RecycleStrategyNode recycles up to MaxUnavailable nodes of a pool at a time.
+	RecycleStrategyNode = "node"
+
+	DefaultRecycleMaxUnavailable = 1
+)
+
+// RecycleStrategy describes how the nodes of an LKE node pool are replaced.
+type RecycleStrategy struct {
+	Type           string
+	MaxUnavailable int
+}
+
+// RecycleBatches splits the nodes of a pool into the groups that are
+// recycled together under the given strategy.
+func RecycleBatches(nodes []linodego.LKENodePoolLinode, strategy RecycleStrategy) [][]linodego.LKENodePoolLinode {
+	if len(nodes) < 1 {
+		return nil
+	}
+
+	if strategy.Type != RecycleStrategyNode {
+		return [][]linodego.LKENodePoolLinode{nodes}
+	}
+
+	batchSize := strategy.MaxUnavailable
+	if batchSize < 1 {
+		batchSize = DefaultRecycleMaxUnavailable
+	}
+
+	batches := make([][]linodego.LKENodePoolLinode, 0, (len(nodes)+batchSize-1)/batchSize)
+	for start := 0; start < len(nodes); start += batchSize {
+		end := min(start+batchSize, len(nodes))
+		batches = append(batches, nodes[start:end])
+	}
+
+	return batches
+}
+
+// RecycleNodePool replaces the nodes of the given pool using the pool or node
+// strategy, waiting for each batch of old nodes to be deleted and for the pool
+// to become ready again before moving on to the next batch.
+func RecycleNodePool(
+	ctx context.Context,
+	client linodego.Client,
+	pollMs, clusterID int,
+	pool linodego.LKENodePool,
+	strategy RecycleStrategy,
+) error {
+	ctx = tflog.SetField(ctx, "node_pool_id", pool.ID)
+
+	batches := RecycleBatches(pool.Linodes, strategy)
+
+	for i, batch := range batches {
+		tflog.Debug(ctx, "Recycling LKE node pool batch", map[string]any{
+			"batch":         i + 1,
+			"total_batches": len(batches),
+			"nodes":         batch,
+		})
+
+		if strategy.Type == RecycleStrategyNode {
+			for _, node := range batch {
+				tflog.Trace(ctx, "client.RecycleLKENodePoolNode(...)", map[string]any{
+					"node_id": node.ID,
+				})
+
+				if err := client.RecycleLKENodePoolNode(ctx, clusterID, node.ID); err != nil {
+					return fmt.Errorf(
+						"failed to recycle node %s of LKE Cluster (%d) Pool (%d): %w", node.ID, clusterID, pool.ID, err,
+					)
+				}
+			}
+		} else {
+			tflog.Trace(ctx, "client.RecycleLKENodePool(...)")
+
+			if err := client.RecycleLKENodePool(ctx, clusterID, pool.ID); err != nil {
+				return fmt.Errorf("failed to recycle LKE Cluster (%d) Pool (%d): %w", clusterID, pool.ID, err)
+			}
+		}
+
+		if err := WaitForNodesDeleted(ctx, client, pollMs, batch); err != nil {
+			return fmt.Errorf("failed to wait for old nodes to be recycled: %w", err)
+		}
+
+		if _, err := WaitForNodePoolReady(ctx, client, pollMs, clusterID, pool.ID); err != nil {
+			return fmt.Errorf("failed to wait for pool %d ready: %w", pool.ID, err)
+		}
+	}
+
+	tflog.Debug(ctx, "All batches of the node pool have been recycled")
+
+	return nil
+}
@@ -25,6 +25,11 @@ type TemplateData struct {
 	AutoscalerMax     int
 	Taints            []TaintData
 	Labels            map[string]string
+	RecycleTrigger    string
+	RecycleStrategy   string
+	MaxUnavailable    int
+
+	WaitForKubernetesReady bool
 }
 
 func Generate(t testing.TB, data *TemplateData) string {
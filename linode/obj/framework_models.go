@@ -3,6 +3,7 @@ package obj
 import (
 	"bytes"
 	"context"
+	"crypto/md5" // #nosec G501 -- S3 requires the MD5 digest of SSE-C keys
 	"encoding/base64"
 	"fmt"
 	"os"
@@ -10,6 +11,8 @@ import (
 
 	s3manager "github.com/aws/aws-sdk-go-v2/feature/s3/manager"
 	"github.com/aws/aws-sdk-go-v2/service/s3"
+	s3types "github.com/aws/aws-sdk-go-v2/service/s3/types"
+	"github.com/hashicorp/terraform-plugin-framework-timetypes/timetypes"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/linode/linodego"
@@ -38,6 +41,13 @@ type BaseModel struct {
 	Metadata           types.Map    `tfsdk:"metadata"`
 	VersionID          types.String `tfsdk:"version_id"`
 	WebsiteRedirect    types.String `tfsdk:"website_redirect"`
+
+	ObjectLockMode            types.String      `tfsdk:"object_lock_mode"`
+	ObjectLockRetainUntilDate timetypes.RFC3339 `tfsdk:"object_lock_retain_until_date"`
+	LegalHold                 types.Bool        `tfsdk:"legal_hold"`
+
+	SSECustomerAlgorithm types.String `tfsdk:"sse_customer_algorithm"`
+	SSECustomerKey       types.String `tfsdk:"sse_customer_key"`
 }
 
 // TODO: consider merging two models when resource's ID change to int type
@@ -81,62 +91,18 @@ func (data ResourceModel) GetObjectStorageKeys(
 	permissions string,
 	diags *diag.Diagnostics,
 ) (*ObjectKeys, func()) {
-	result := &ObjectKeys{}
-
-	result.AccessKey = data.AccessKey.ValueString()
-	result.SecretKey = data.SecreteKey.ValueString()
-
-	if result.Ok() {
-		return result, nil
-	}
-
-	result.AccessKey = config.ObjAccessKey.ValueString()
-	result.SecretKey = config.ObjSecretKey.ValueString()
-
-	if result.Ok() {
-		return result, nil
-	}
-
-	if config.ObjUseTempKeys.ValueBool() {
-		objKey := fwCreateTempKeys(ctx, client, data.Bucket.ValueString(), data.GetRegionOrCluster(ctx), permissions, diags)
-		if diags.HasError() {
-			return nil, nil
-		}
-
-		result.AccessKey = objKey.AccessKey
-		result.SecretKey = objKey.SecretKey
-
-		teardownTempKeysCleanUp := func() { cleanUpTempKeys(ctx, client, objKey.ID) }
-
-		return result, teardownTempKeysCleanUp
-	}
-
-	diags.AddError(
-		"Keys Not Found",
-		"`access_key` and `secret_key` are Required but not Configured",
+	return FwGetObjectStorageKeys(
+		ctx, client, config,
+		data.AccessKey, data.SecreteKey,
+		data.Bucket.ValueString(), data.GetRegionOrCluster(ctx),
+		permissions, diags,
 	)
-
-	return nil, nil
 }
 
 func (plan *ResourceModel) ComputeEndpointIfUnknown(ctx context.Context, client *linodego.Client, diags *diag.Diagnostics) {
-	if !plan.Endpoint.IsUnknown() {
-		return
-	}
-
-	bucketName := plan.Bucket.ValueString()
-	regionOrCluster := plan.GetRegionOrCluster(ctx)
-
-	bucket, err := client.GetObjectStorageBucket(ctx, regionOrCluster, bucketName)
-	if err != nil {
-		diags.AddError(
-			"Failed to Find the Specified Linode ObjectStorageBucket",
-			err.Error(),
-		)
-		return
-	}
-
-	plan.Endpoint = types.StringValue(bucket.S3Endpoint)
+	plan.Endpoint = FwComputeEndpointIfUnknown(
+		ctx, client, plan.Endpoint, plan.Bucket.ValueString(), plan.GetRegionOrCluster(ctx), diags,
+	)
 }
 
 func (data *ResourceModel) GenerateObjectStorageObjectID(apply bool, preserveKnown bool) string {
@@ -165,15 +131,56 @@ func (data *ResourceModel) FlattenObject(
 	data.ContentEncoding = helper.KeepOrUpdateStringPointer(data.ContentEncoding, obj.ContentEncoding, preserveKnown)
 	data.ContentLanguage = helper.KeepOrUpdateStringPointer(data.ContentLanguage, obj.ContentLanguage, preserveKnown)
 	data.ContentType = helper.KeepOrUpdateStringPointer(data.ContentType, obj.ContentType, preserveKnown)
-	data.ETag = helper.KeepOrUpdateStringPointer(data.ETag, getQuotesTrimmedETag(obj), preserveKnown)
+	// The ETag of an object encrypted with SSE-C isn't the MD5 of its content,
+	// so a known ETag, e.g. one configured with filemd5(), is kept as is.
+	if data.SSECustomerKey.IsNull() || data.ETag.IsNull() || data.ETag.IsUnknown() {
+		data.ETag = helper.KeepOrUpdateStringPointer(data.ETag, getQuotesTrimmedETag(obj), preserveKnown)
+	}
 	data.WebsiteRedirect = helper.KeepOrUpdateStringPointer(data.WebsiteRedirect, obj.WebsiteRedirectLocation, preserveKnown)
 	data.VersionID = helper.KeepOrUpdateStringPointer(data.VersionID, obj.VersionId, preserveKnown)
 	data.Metadata = helper.KeepOrUpdateValue(data.Metadata, types.MapValueMust(types.StringType, flattenObjectMetadata(obj.Metadata)), preserveKnown)
 	data.ContentDisposition = helper.KeepOrUpdateStringPointer(data.ContentDisposition, obj.ContentDisposition, preserveKnown)
 
+	var lockMode *string
+	if obj.ObjectLockMode != "" {
+		lockMode = (*string)(&obj.ObjectLockMode)
+	}
+	data.ObjectLockMode = helper.KeepOrUpdateStringPointer(data.ObjectLockMode, lockMode, preserveKnown)
+	data.ObjectLockRetainUntilDate = helper.KeepOrUpdateValue(
+		data.ObjectLockRetainUntilDate,
+		timetypes.NewRFC3339TimePointerValue(obj.ObjectLockRetainUntilDate),
+		preserveKnown,
+	)
+	data.SSECustomerAlgorithm = helper.KeepOrUpdateStringPointer(
+		data.SSECustomerAlgorithm, obj.SSECustomerAlgorithm, preserveKnown,
+	)
+	data.LegalHold = helper.KeepOrUpdateBool(
+		data.LegalHold,
+		obj.ObjectLockLegalHoldStatus == s3types.ObjectLockLegalHoldStatusOn,
+		preserveKnown,
+	)
+
 	data.GenerateObjectStorageObjectID(true, preserveKnown)
 }
 
+// SSECustomerKeyMD5 returns the base64-encoded MD5 digest of the
+// customer-provided key, which is required alongside the key by S3.
+func (data ResourceModel) SSECustomerKeyMD5() (*string, error) {
+	if data.SSECustomerKey.IsNull() || data.SSECustomerKey.IsUnknown() {
+		return nil, nil
+	}
+
+	key, err := base64.StdEncoding.DecodeString(data.SSECustomerKey.ValueString())
+	if err != nil {
+		return nil, fmt.Errorf("failed to decode sse_customer_key: %w", err)
+	}
+
+	sum := md5.Sum(key) // #nosec G401 -- S3 requires the MD5 digest of SSE-C keys
+	keyMD5 := base64.StdEncoding.EncodeToString(sum[:])
+
+	return &keyMD5, nil
+}
+
 func (data ResourceModel) ETagChanged(
 	obj s3.HeadObjectOutput,
 ) bool {
@@ -203,4 +210,11 @@ func (plan *ResourceModel) CopyFrom(state ResourceModel, preserveKnown bool) {
 	plan.Metadata = helper.KeepOrUpdateValue(plan.Metadata, state.Metadata, preserveKnown)
 	plan.VersionID = helper.KeepOrUpdateValue(plan.VersionID, state.VersionID, preserveKnown)
 	plan.WebsiteRedirect = helper.KeepOrUpdateValue(plan.WebsiteRedirect, state.WebsiteRedirect, preserveKnown)
+	plan.ObjectLockMode = helper.KeepOrUpdateValue(plan.ObjectLockMode, state.ObjectLockMode, preserveKnown)
+	plan.ObjectLockRetainUntilDate = helper.KeepOrUpdateValue(
+		plan.ObjectLockRetainUntilDate, state.ObjectLockRetainUntilDate, preserveKnown,
+	)
+	plan.LegalHold = helper.KeepOrUpdateValue(plan.LegalHold, state.LegalHold, preserveKnown)
+	plan.SSECustomerAlgorithm = helper.KeepOrUpdateValue(plan.SSECustomerAlgorithm, state.SSECustomerAlgorithm, preserveKnown)
+	plan.SSECustomerKey = helper.KeepOrUpdateValue(plan.SSECustomerKey, state.SSECustomerKey, preserveKnown)
 }
@@ -0,0 +1,63 @@
+//go:build unit
+
+package obj
+
+import (
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/s3"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// A 256-bit key of zero bytes
+const testSSECustomerKey = "AAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAA="
+
+func TestSSECustomerKeyMD5(t *testing.T) {
+	var data ResourceModel
+	data.SSECustomerKey = types.StringNull()
+
+	keyMD5, err := data.SSECustomerKeyMD5()
+	require.NoError(t, err)
+	assert.Nil(t, keyMD5)
+
+	data.SSECustomerKey = types.StringValue(testSSECustomerKey)
+
+	keyMD5, err = data.SSECustomerKeyMD5()
+	require.NoError(t, err)
+	assert.Equal(t, "cLyPS3KoaSFGi/joRB3OUQ==", *keyMD5)
+
+	data.SSECustomerKey = types.StringValue("not base64!")
+
+	_, err = data.SSECustomerKeyMD5()
+	assert.Error(t, err)
+}
+
+func TestFlattenObject_SSECustomerKeyETag(t *testing.T) {
+	headOutput := s3.HeadObjectOutput{
+		ETag:                 aws.String(`"a1b2c3"`),
+		SSECustomerAlgorithm: aws.String("AES256"),
+	}
+
+	var data ResourceModel
+	data.SSECustomerKey = types.StringValue(testSSECustomerKey)
+	data.ETag = types.StringUnknown()
+
+	data.FlattenObject(headOutput, false)
+	assert.Equal(t, "a1b2c3", data.ETag.ValueString())
+	assert.Equal(t, "AES256", data.SSECustomerAlgorithm.ValueString())
+
+	// The ETag of SSE-C objects doesn't change on reads
+	headOutput.ETag = aws.String(`"d4e5f6"`)
+
+	data.FlattenObject(headOutput, false)
+	assert.Equal(t, "a1b2c3", data.ETag.ValueString())
+
+	// Without SSE-C the ETag is refreshed
+	data.SSECustomerKey = types.StringNull()
+
+	data.FlattenObject(headOutput, false)
+	assert.Equal(t, "d4e5f6", data.ETag.ValueString())
+}
@@ -4,13 +4,16 @@ import (
 	"context"
 	"fmt"
 	"strings"
+	"time"
 
 	"github.com/aws/aws-sdk-go-v2/service/s3"
 
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/linode/terraform-provider-linode/v2/linode/helper"
 )
@@ -68,7 +71,7 @@ func (r *Resource) Create(
 		return
 	}
 
-	fwPutObject(ctx, plan, s3client, &resp.Diagnostics)
+	fwPutObject(ctx, plan, isRetentionConfigured(ctx, req.Config, &resp.Diagnostics), s3client, &resp.Diagnostics)
 
 	// Add resource to TF states earlier to prevent
 	// dangling resources (resources created but not managed by TF)
@@ -105,9 +108,34 @@ func RefreshObject(
 		Key:    data.Key.ValueStringPointer(),
 	}
 
-	tflog.Debug(ctx, "getting object header", map[string]any{"HeadObjectInput": headObjectInput})
+	if !data.SSECustomerKey.IsNull() {
+		keyMD5, err := data.SSECustomerKeyMD5()
+		if err != nil {
+			diags.AddError("Invalid SSE-C Key", err.Error())
+			return
+		}
+
+		headObjectInput.SSECustomerAlgorithm = data.SSECustomerAlgorithm.ValueStringPointer()
+		headObjectInput.SSECustomerKey = data.SSECustomerKey.ValueStringPointer()
+		headObjectInput.SSECustomerKeyMD5 = keyMD5
+	}
+
+	tflog.Debug(ctx, "getting object header", map[string]any{
+		"bucket": data.Bucket.ValueString(),
+		"key":    data.Key.ValueString(),
+	})
 	headOutput, err := s3client.HeadObject(ctx, headObjectInput)
 	if err != nil {
+		if data.SSECustomerKey.IsNull() &&
+			sseCustomerKeyRequired(ctx, s3client, data.Bucket.ValueString(), data.Key.ValueString(), err) {
+			diags.AddError(
+				"Failed to Refresh the Object",
+				"The object may be encrypted with a customer-provided key (SSE-C). "+
+					"Please configure sse_customer_key and sse_customer_algorithm to read it: "+err.Error(),
+			)
+			return
+		}
+
 		if helper.IsObjNotFoundErr(err) && removeResource != nil {
 			removeResource(ctx)
 			diags.AddWarning(
@@ -154,7 +182,7 @@ func (r *Resource) Read(
 	}
 
 	if resp.Diagnostics.HasError() {
-		if newDiags := deleteBucketNotFound(resp.Diagnostics); len(newDiags) < len(resp.Diagnostics) {
+		if newDiags := DeleteBucketNotFound(resp.Diagnostics); len(newDiags) < len(resp.Diagnostics) {
 			resp.Diagnostics = newDiags
 
 			resp.Diagnostics.AddWarning(
@@ -194,6 +222,8 @@ func (r *Resource) Update(
 	client := r.Meta.Client
 	config := r.Meta.Config
 
+	retentionConfigured := isRetentionConfigured(ctx, req.Config, &resp.Diagnostics)
+
 	plan.ComputeEndpointIfUnknown(ctx, client, &resp.Diagnostics)
 
 	s3client, teardownKeys := getS3ClientFromModel(
@@ -218,9 +248,13 @@ func (r *Resource) Update(
 		!plan.Content.Equal(state.Content) ||
 		!plan.Metadata.Equal(state.Metadata) ||
 		!plan.Source.Equal(state.Source) ||
-		!plan.WebsiteRedirect.Equal(state.WebsiteRedirect) {
+		!plan.WebsiteRedirect.Equal(state.WebsiteRedirect) ||
+		!plan.SSECustomerAlgorithm.Equal(state.SSECustomerAlgorithm) ||
+		!plan.SSECustomerKey.Equal(state.SSECustomerKey) {
 
-		fwPutObject(ctx, plan, s3client, &resp.Diagnostics)
+		fwPutObject(ctx, plan, retentionConfigured, s3client, &resp.Diagnostics)
+	} else if objectLockChanged(plan, state, retentionConfigured) {
+		fwUpdateObjectLock(ctx, plan, state, retentionConfigured, s3client, &resp.Diagnostics)
 	}
 
 	RefreshObject(ctx, &plan, s3client, &resp.Diagnostics, nil, true)
@@ -272,6 +306,20 @@ func (r *Resource) Delete(
 	bucket := state.Bucket.ValueString()
 	key := state.Key.ValueString()
 
+	if err := validateObjectLockForDelete(state, force, time.Now()); err != nil {
+		resp.Diagnostics.AddError("Failed to Delete the Locked Object", err.Error())
+		return
+	}
+
+	if force && state.LegalHold.ValueBool() {
+		tflog.Debug(ctx, "releasing the legal hold before deleting the object")
+
+		releaseLegalHolds(ctx, s3client, bucket, key, !state.VersionID.IsNull(), &resp.Diagnostics)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+
 	if !state.VersionID.IsNull() {
 		tflog.Debug(ctx, "versioning was enabled for this object, deleting all versions and delete markers")
 
@@ -299,3 +347,13 @@ func populateLogAttributes(ctx context.Context, model ResourceModel) context.Con
 		"object_key":        model.Key.ValueString(),
 	})
 }
+
+// isRetentionConfigured returns whether the Object Lock retention of the
+// object is set in the configuration rather than computed from the default
+// retention of the bucket.
+func isRetentionConfigured(ctx context.Context, config tfsdk.Config, diags *diag.Diagnostics) bool {
+	var lockMode types.String
+	diags.Append(config.GetAttribute(ctx, path.Root("object_lock_mode"), &lockMode)...)
+
+	return !lockMode.IsNull()
+}
@@ -2,9 +2,11 @@ package obj
 
 import (
 	"context"
+	"regexp"
 	"strings"
 
 	s3types "github.com/aws/aws-sdk-go-v2/service/s3/types"
+	"github.com/hashicorp/terraform-plugin-framework-timetypes/timetypes"
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
@@ -43,6 +45,9 @@ func requireReplacementIfClusterOrRegionSemanticallyChanged(ctx context.Context,
 	rrifr.RequiresReplace = true
 }
 
+// sseCustomerKeyRegex matches the base64 encoding of a 32 bytes key.
+var sseCustomerKeyRegex = regexp.MustCompile(`^[A-Za-z0-9+/]{43}=$`)
+
 var frameworkResourceSchema = schema.Schema{
 	Attributes: map[string]schema.Attribute{
 		"id": schema.StringAttribute{
@@ -212,5 +217,61 @@ var frameworkResourceSchema = schema.Schema{
 			Description: "The website redirect location of this object.",
 			Optional:    true,
 		},
+		"object_lock_mode": schema.StringAttribute{
+			Description: "The Object Lock retention mode of this object. " +
+				"Requires Object Lock to be enabled on the bucket.",
+			Optional: true,
+			Computed: true,
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.UseStateForUnknown(),
+			},
+			Validators: []validator.String{
+				stringvalidator.OneOf(
+					helper.StringAliasSliceToStringSlice(
+						s3types.ObjectLockModeGovernance.Values(),
+					)...,
+				),
+				stringvalidator.AlsoRequires(path.MatchRoot("object_lock_retain_until_date")),
+			},
+		},
+		"object_lock_retain_until_date": schema.StringAttribute{
+			Description: "The date and time in RFC3339 format until which this object is retained.",
+			Optional:    true,
+			Computed:    true,
+			CustomType:  timetypes.RFC3339Type{},
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.UseStateForUnknown(),
+			},
+			Validators: []validator.String{
+				stringvalidator.AlsoRequires(path.MatchRoot("object_lock_mode")),
+			},
+		},
+		"sse_customer_algorithm": schema.StringAttribute{
+			Description: "The server-side encryption algorithm used with the customer-provided key.",
+			Optional:    true,
+			Validators: []validator.String{
+				stringvalidator.OneOf(string(s3types.ServerSideEncryptionAes256)),
+				stringvalidator.AlsoRequires(path.MatchRoot("sse_customer_key")),
+			},
+		},
+		"sse_customer_key": schema.StringAttribute{
+			Description: "The base64-encoded 256-bit key used to encrypt this object on the server side (SSE-C).",
+			Optional:    true,
+			Sensitive:   true,
+			Validators: []validator.String{
+				stringvalidator.RegexMatches(
+					sseCustomerKeyRegex,
+					"must be a base64-encoded 256-bit key",
+				),
+				stringvalidator.AlsoRequires(path.MatchRoot("sse_customer_algorithm")),
+			},
+		},
+		"legal_hold": schema.BoolAttribute{
+			Description: "Whether a legal hold is placed on this object. " +
+				"Requires Object Lock to be enabled on the bucket.",
+			Optional: true,
+			Computed: true,
+			Default:  booldefault.StaticBool(false),
+		},
 	},
 }
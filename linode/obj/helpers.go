@@ -3,17 +3,21 @@ package obj
 import (
 	"context"
 	"encoding/base64"
+	"errors"
 	"fmt"
 	"hash/crc32"
 	"io"
+	"net/http"
 	"regexp"
 	"slices"
 	"strings"
 	"time"
 
 	"github.com/aws/aws-sdk-go-v2/aws"
+	awshttp "github.com/aws/aws-sdk-go-v2/aws/transport/http"
 	"github.com/aws/aws-sdk-go-v2/service/s3"
 	s3types "github.com/aws/aws-sdk-go-v2/service/s3/types"
+	"github.com/aws/smithy-go"
 	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
@@ -39,18 +43,36 @@ func getS3ClientFromModel(
 	permission string,
 	diags *diag.Diagnostics,
 ) (*s3.Client, func()) {
-	keys, teardownKeys := data.GetObjectStorageKeys(ctx, client, config, permission, diags)
+	return FwGetS3Client(
+		ctx, client, config,
+		data.AccessKey, data.SecreteKey,
+		data.Bucket.ValueString(), data.GetRegionOrCluster(ctx), data.Endpoint.ValueString(),
+		permission, diags,
+	)
+}
+
+// FwGetS3Client returns an S3 client for the given bucket, authenticated with
+// the keys resolved by FwGetObjectStorageKeys.
+// The returned teardown function is non-nil if temporary keys have been created.
+func FwGetS3Client(
+	ctx context.Context,
+	client *linodego.Client,
+	config *helper.FrameworkProviderModel,
+	accessKey, secretKey types.String,
+	bucket, regionOrCluster, endpoint, permission string,
+	diags *diag.Diagnostics,
+) (*s3.Client, func()) {
+	keys, teardownKeys := FwGetObjectStorageKeys(
+		ctx, client, config,
+		accessKey, secretKey,
+		bucket, regionOrCluster,
+		permission, diags,
+	)
 	if diags.HasError() {
 		return nil, teardownKeys
 	}
 
-	s3client := helper.FwS3Connection(
-		ctx,
-		data.Endpoint.ValueString(),
-		keys.AccessKey,
-		keys.SecretKey,
-		diags,
-	)
+	s3client := helper.FwS3Connection(ctx, endpoint, keys.AccessKey, keys.SecretKey, diags)
 	if diags.HasError() {
 		return nil, teardownKeys
 	}
@@ -58,6 +80,31 @@ func getS3ClientFromModel(
 	return s3client, teardownKeys
 }
 
+// FwComputeEndpointIfUnknown returns the given S3 endpoint if it is known,
+// or the S3 endpoint of the given bucket otherwise.
+func FwComputeEndpointIfUnknown(
+	ctx context.Context,
+	client *linodego.Client,
+	endpoint types.String,
+	bucket, regionOrCluster string,
+	diags *diag.Diagnostics,
+) types.String {
+	if !endpoint.IsUnknown() && !endpoint.IsNull() {
+		return endpoint
+	}
+
+	result, err := client.GetObjectStorageBucket(ctx, regionOrCluster, bucket)
+	if err != nil {
+		diags.AddError(
+			"Failed to Find the Specified Linode ObjectStorageBucket",
+			err.Error(),
+		)
+		return endpoint
+	}
+
+	return types.StringValue(result.S3Endpoint)
+}
+
 // getObjKeysFromProvider gets obj_access_key and obj_secret_key from provider configuration.
 // Return whether both of the keys exist.
 func getObjKeysFromProvider(
@@ -227,6 +274,57 @@ func GetObjKeys(
 	return objKeys, nil, teardownTempKeysCleanUp
 }
 
+// FwGetObjectStorageKeys gets object access_key and secret_key in the following order:
+// 1) Whether the keys are specified in the resource configuration;
+// 2) Whether the provider-level object keys exist;
+// 3) Whether user opts-in temporary keys generation.
+// The returned teardown function is non-nil if temporary keys have been created.
+func FwGetObjectStorageKeys(
+	ctx context.Context,
+	client *linodego.Client,
+	config *helper.FrameworkProviderModel,
+	accessKey, secretKey types.String,
+	bucket, regionOrCluster, permissions string,
+	diags *diag.Diagnostics,
+) (*ObjectKeys, func()) {
+	result := &ObjectKeys{
+		AccessKey: accessKey.ValueString(),
+		SecretKey: secretKey.ValueString(),
+	}
+
+	if result.Ok() {
+		return result, nil
+	}
+
+	result.AccessKey = config.ObjAccessKey.ValueString()
+	result.SecretKey = config.ObjSecretKey.ValueString()
+
+	if result.Ok() {
+		return result, nil
+	}
+
+	if config.ObjUseTempKeys.ValueBool() {
+		objKey := fwCreateTempKeys(ctx, client, bucket, regionOrCluster, permissions, diags)
+		if diags.HasError() {
+			return nil, nil
+		}
+
+		result.AccessKey = objKey.AccessKey
+		result.SecretKey = objKey.SecretKey
+
+		teardownTempKeysCleanUp := func() { cleanUpTempKeys(ctx, client, objKey.ID) }
+
+		return result, teardownTempKeysCleanUp
+	}
+
+	diags.AddError(
+		"Keys Not Found",
+		"`access_key` and `secret_key` are Required but not Configured",
+	)
+
+	return nil, nil
+}
+
 func putObjectWithRetries(
 	ctx context.Context,
 	s3client *s3.Client,
@@ -311,7 +409,8 @@ func flattenObjectMetadata(metadata map[string]string) map[string]attr.Value {
 	return metadataObject
 }
 
-func deleteBucketNotFound(diags diag.Diagnostics) diag.Diagnostics {
+// DeleteBucketNotFound removes the "Bucket not found" errors from the given diagnostics.
+func DeleteBucketNotFound(diags diag.Diagnostics) diag.Diagnostics {
 	return slices.DeleteFunc(diags, func(d diag.Diagnostic) bool {
 		return strings.Contains(d.Detail(), "Bucket not found")
 	})
@@ -332,6 +431,7 @@ func AddObjectResource(
 func fwPutObject(
 	ctx context.Context,
 	data ResourceModel,
+	retentionConfigured bool,
 	s3client *s3.Client,
 	diags *diag.Diagnostics,
 ) {
@@ -379,6 +479,33 @@ func fwPutObject(
 		WebsiteRedirectLocation: data.WebsiteRedirect.ValueStringPointer(),
 	}
 
+	if !data.SSECustomerKey.IsNull() {
+		keyMD5, err := data.SSECustomerKeyMD5()
+		if err != nil {
+			diags.AddError("Invalid SSE-C Key", err.Error())
+			return
+		}
+
+		putInput.SSECustomerAlgorithm = data.SSECustomerAlgorithm.ValueStringPointer()
+		putInput.SSECustomerKey = data.SSECustomerKey.ValueStringPointer()
+		putInput.SSECustomerKeyMD5 = keyMD5
+	}
+
+	if retentionConfigured && !data.ObjectLockMode.IsUnknown() {
+		retainUntil, d := data.ObjectLockRetainUntilDate.ValueRFC3339Time()
+		diags.Append(d...)
+		if diags.HasError() {
+			return
+		}
+
+		putInput.ObjectLockMode = s3types.ObjectLockMode(data.ObjectLockMode.ValueString())
+		putInput.ObjectLockRetainUntilDate = &retainUntil
+	}
+
+	if data.LegalHold.ValueBool() {
+		putInput.ObjectLockLegalHoldStatus = s3types.ObjectLockLegalHoldStatusOn
+	}
+
 	if len(data.Metadata.Elements()) > 0 {
 		data.Metadata.ElementsAs(ctx, &putInput.Metadata, false)
 		tflog.Debug(ctx, fmt.Sprintf("got Metadata: %v", putInput.Metadata))
@@ -389,3 +516,208 @@ func fwPutObject(
 		return
 	}
 }
+
+// retentionChanged returns whether the configured Object Lock retention
+// differs from the state. Without a configured retention, the retention
+// applied by the default retention of the bucket is left untouched.
+func retentionChanged(plan, state ResourceModel, retentionConfigured bool) bool {
+	return retentionConfigured && (!plan.ObjectLockMode.Equal(state.ObjectLockMode) ||
+		!plan.ObjectLockRetainUntilDate.Equal(state.ObjectLockRetainUntilDate))
+}
+
+// objectLockChanged returns whether any of the Object Lock related
+// attributes differ between the plan and the state.
+func objectLockChanged(plan, state ResourceModel, retentionConfigured bool) bool {
+	return retentionChanged(plan, state, retentionConfigured) || !plan.LegalHold.Equal(state.LegalHold)
+}
+
+// fwUpdateObjectLock applies Object Lock retention and legal hold changes to
+// the current version of an object without re-uploading it.
+func fwUpdateObjectLock(
+	ctx context.Context,
+	plan, state ResourceModel,
+	retentionConfigured bool,
+	s3client *s3.Client,
+	diags *diag.Diagnostics,
+) {
+	if retentionChanged(plan, state, retentionConfigured) {
+		retainUntil, d := plan.ObjectLockRetainUntilDate.ValueRFC3339Time()
+		diags.Append(d...)
+		if diags.HasError() {
+			return
+		}
+
+		retention := &s3types.ObjectLockRetention{
+			Mode:            s3types.ObjectLockRetentionMode(plan.ObjectLockMode.ValueString()),
+			RetainUntilDate: &retainUntil,
+		}
+
+		retentionInput := &s3.PutObjectRetentionInput{
+			Bucket:                    plan.Bucket.ValueStringPointer(),
+			Key:                       plan.Key.ValueStringPointer(),
+			VersionId:                 state.VersionID.ValueStringPointer(),
+			Retention:                 retention,
+			BypassGovernanceRetention: plan.ForceDestroy.ValueBoolPointer(),
+		}
+
+		tflog.Debug(ctx, "client.PutObjectRetention(...)", map[string]any{"options": retentionInput})
+		if _, err := s3client.PutObjectRetention(ctx, retentionInput); err != nil {
+			diags.AddError("Failed to Update the Object Retention", err.Error())
+			return
+		}
+	}
+
+	if !plan.LegalHold.Equal(state.LegalHold) {
+		putObjectLegalHold(
+			ctx, s3client, plan.Bucket.ValueString(), plan.Key.ValueString(),
+			state.VersionID.ValueString(), plan.LegalHold.ValueBool(), diags,
+		)
+	}
+}
+
+func putObjectLegalHold(
+	ctx context.Context,
+	s3client *s3.Client,
+	bucket, key, version string,
+	enabled bool,
+	diags *diag.Diagnostics,
+) {
+	status := s3types.ObjectLockLegalHoldStatusOff
+	if enabled {
+		status = s3types.ObjectLockLegalHoldStatusOn
+	}
+
+	legalHoldInput := &s3.PutObjectLegalHoldInput{
+		Bucket:    &bucket,
+		Key:       &key,
+		LegalHold: &s3types.ObjectLockLegalHold{Status: status},
+	}
+	if version != "" {
+		legalHoldInput.VersionId = &version
+	}
+
+	tflog.Debug(ctx, "client.PutObjectLegalHold(...)", map[string]any{"options": legalHoldInput})
+	if _, err := s3client.PutObjectLegalHold(ctx, legalHoldInput); err != nil {
+		diags.AddError("Failed to Update the Object Legal Hold", err.Error())
+	}
+}
+
+// releaseLegalHolds removes the legal hold from every version of the given
+// object so that it can be deleted.
+func releaseLegalHolds(
+	ctx context.Context,
+	s3client *s3.Client,
+	bucket, key string,
+	versioned bool,
+	diags *diag.Diagnostics,
+) {
+	if !versioned {
+		putObjectLegalHold(ctx, s3client, bucket, key, "", false, diags)
+		return
+	}
+
+	paginator := s3.NewListObjectVersionsPaginator(s3client, &s3.ListObjectVersionsInput{
+		Bucket: &bucket,
+		Prefix: &key,
+	})
+
+	for paginator.HasMorePages() {
+		page, err := paginator.NextPage(ctx)
+		if err != nil {
+			diags.AddError("Failed to List the Object Versions", err.Error())
+			return
+		}
+
+		for _, version := range page.Versions {
+			if aws.ToString(version.Key) != key {
+				continue
+			}
+
+			putObjectLegalHold(ctx, s3client, bucket, key, aws.ToString(version.VersionId), false, diags)
+			if diags.HasError() {
+				return
+			}
+		}
+	}
+}
+
+// validateObjectLockForDelete returns an error if the object is protected by
+// an Object Lock that cannot be bypassed with the given force setting.
+func validateObjectLockForDelete(data ResourceModel, force bool, now time.Time) error {
+	retained := false
+
+	if !data.ObjectLockRetainUntilDate.IsNull() && !data.ObjectLockRetainUntilDate.IsUnknown() {
+		retainUntil, d := data.ObjectLockRetainUntilDate.ValueRFC3339Time()
+		if d.HasError() {
+			return fmt.Errorf("failed to parse object_lock_retain_until_date %q",
+				data.ObjectLockRetainUntilDate.ValueString())
+		}
+		retained = retainUntil.After(now)
+	}
+
+	if retained && data.ObjectLockMode.ValueString() == string(s3types.ObjectLockModeCompliance) {
+		return fmt.Errorf(
+			"object is retained in COMPLIANCE mode until %s and cannot be deleted before then",
+			data.ObjectLockRetainUntilDate.ValueString(),
+		)
+	}
+
+	if force {
+		return nil
+	}
+
+	if retained {
+		return fmt.Errorf(
+			"object is retained in GOVERNANCE mode until %s, set force_destroy to true to bypass the retention",
+			data.ObjectLockRetainUntilDate.ValueString(),
+		)
+	}
+
+	if data.LegalHold.ValueBool() {
+		return fmt.Errorf("object is under legal hold, set force_destroy to true to release the hold and delete it")
+	}
+
+	return nil
+}
+
+// sseCustomerKeyRequired returns whether the object failed to be read with
+// the given error because it is encrypted with a customer-provided key (SSE-C).
+// HEAD responses have no body to tell the cause of a 400 error apart, so it is
+// confirmed with a ranged GET of the object, whose error describes the cause.
+func sseCustomerKeyRequired(ctx context.Context, s3client *s3.Client, bucket, key string, err error) bool {
+	var respErr *awshttp.ResponseError
+	if !errors.As(err, &respErr) || respErr.HTTPStatusCode() != http.StatusBadRequest {
+		return false
+	}
+
+	tflog.Debug(ctx, "checking whether the object is encrypted with SSE-C")
+
+	output, err := s3client.GetObject(ctx, &s3.GetObjectInput{
+		Bucket: aws.String(bucket),
+		Key:    aws.String(key),
+		Range:  aws.String("bytes=0-0"),
+	})
+	if err != nil {
+		return isSSECustomerKeyRequiredErr(err)
+	}
+
+	output.Body.Close()
+
+	return false
+}
+
+// isSSECustomerKeyRequiredErr returns whether the given error is caused by
+// reading an object encrypted with SSE-C without providing its key.
+func isSSECustomerKeyRequiredErr(err error) bool {
+	var apiErr smithy.APIError
+	if !errors.As(err, &apiErr) {
+		return false
+	}
+
+	switch apiErr.ErrorCode() {
+	case "InvalidRequest", "InvalidArgument":
+		return strings.Contains(strings.ToLower(apiErr.ErrorMessage()), "server side encryption")
+	default:
+		return false
+	}
+}
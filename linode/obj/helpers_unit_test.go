@@ -0,0 +1,98 @@
+//go:build unit
+
+package obj
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/aws/smithy-go"
+	"github.com/hashicorp/terraform-plugin-framework-timetypes/timetypes"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestValidateObjectLockForDelete(t *testing.T) {
+	now := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+	future := timetypes.NewRFC3339TimeValue(now.Add(24 * time.Hour))
+	past := timetypes.NewRFC3339TimeValue(now.Add(-24 * time.Hour))
+
+	newModel := func(mode string, retainUntil timetypes.RFC3339, legalHold bool) ResourceModel {
+		var data ResourceModel
+		data.ObjectLockMode = types.StringNull()
+		if mode != "" {
+			data.ObjectLockMode = types.StringValue(mode)
+		}
+		data.ObjectLockRetainUntilDate = retainUntil
+		data.LegalHold = types.BoolValue(legalHold)
+		return data
+	}
+
+	testCases := []struct {
+		name    string
+		data    ResourceModel
+		force   bool
+		wantErr bool
+	}{
+		{"unlocked", newModel("", timetypes.NewRFC3339Null(), false), false, false},
+		{"expired retention", newModel("GOVERNANCE", past, false), false, false},
+		{"governance", newModel("GOVERNANCE", future, false), false, true},
+		{"governance forced", newModel("GOVERNANCE", future, false), true, false},
+		{"compliance forced", newModel("COMPLIANCE", future, false), true, true},
+		{"expired compliance", newModel("COMPLIANCE", past, false), false, false},
+		{"legal hold", newModel("", timetypes.NewRFC3339Null(), true), false, true},
+		{"legal hold forced", newModel("", timetypes.NewRFC3339Null(), true), true, false},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := validateObjectLockForDelete(tc.data, tc.force, now)
+			if tc.wantErr {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestIsSSECustomerKeyRequiredErr(t *testing.T) {
+	sseErr := &smithy.GenericAPIError{
+		Code: "InvalidRequest",
+		Message: "The object was stored using a form of Server Side Encryption. " +
+			"The correct parameters must be provided to retrieve the object.",
+	}
+
+	assert.True(t, isSSECustomerKeyRequiredErr(sseErr))
+	assert.True(t, isSSECustomerKeyRequiredErr(fmt.Errorf("operation error S3: GetObject: %w", sseErr)))
+
+	// Other bad requests aren't caused by a missing SSE-C key
+	assert.False(t, isSSECustomerKeyRequiredErr(&smithy.GenericAPIError{
+		Code:    "InvalidRequest",
+		Message: "Missing required header for this request: Content-Md5.",
+	}))
+	assert.False(t, isSSECustomerKeyRequiredErr(&smithy.GenericAPIError{Code: "BadRequest"}))
+	assert.False(t, isSSECustomerKeyRequiredErr(errors.New("bad request")))
+}
+
+func TestRetentionChanged(t *testing.T) {
+	var state ResourceModel
+	state.ObjectLockMode = types.StringValue("GOVERNANCE")
+	state.ObjectLockRetainUntilDate = timetypes.NewRFC3339ValueMust("2030-01-01T00:00:00Z")
+	state.LegalHold = types.BoolValue(false)
+
+	plan := state
+	plan.ObjectLockRetainUntilDate = timetypes.NewRFC3339ValueMust("2031-01-01T00:00:00Z")
+
+	assert.True(t, retentionChanged(plan, state, true))
+	assert.True(t, objectLockChanged(plan, state, true))
+
+	// A retention that isn't configured is never sent
+	assert.False(t, retentionChanged(plan, state, false))
+	assert.False(t, objectLockChanged(plan, state, false))
+
+	plan.LegalHold = types.BoolValue(true)
+	assert.True(t, objectLockChanged(plan, state, false))
+}
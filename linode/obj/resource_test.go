@@ -4,12 +4,16 @@ package obj_test
 
 import (
 	"context"
+	"crypto/md5"
+	"crypto/rand"
+	"encoding/base64"
 	"fmt"
 	"io"
 	"log"
 	"testing"
 	"time"
 
+	"github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/aws/aws-sdk-go-v2/service/s3"
 	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
@@ -149,6 +153,93 @@ func TestAccResourceObject_basic(t *testing.T) {
 	})
 }
 
+func TestAccResourceObject_objectLock(t *testing.T) {
+	t.Parallel()
+
+	resName := getObjectResourceName("locked")
+	content := "testing123"
+	retainUntil := time.Now().Add(time.Hour).UTC().Format(time.RFC3339)
+	retainUntilUpdated := time.Now().Add(2 * time.Hour).UTC().Format(time.RFC3339)
+
+	acceptance.RunTestWithRetries(t, 6, func(t *acceptance.WrappedT) {
+		bucketName := acctest.RandomWithPrefix("tf-test")
+		keyName := acctest.RandomWithPrefix("tf_test")
+
+		resource.Test(t, resource.TestCase{
+			PreCheck:                 func() { acceptance.PreCheck(t) },
+			ProtoV5ProviderFactories: acceptance.ProtoV5ProviderFactories,
+			CheckDestroy:             checkObjectDestroy,
+			Steps: []resource.TestStep{
+				{
+					Config: tmpl.ObjectLock(t, bucketName, testRegion, keyName, content, retainUntil, true),
+					Check: resource.ComposeTestCheckFunc(
+						validateObject(resName, "test_locked", content),
+						resource.TestCheckResourceAttr(resName, "object_lock_mode", "GOVERNANCE"),
+						resource.TestCheckResourceAttr(resName, "object_lock_retain_until_date", retainUntil),
+						resource.TestCheckResourceAttr(resName, "legal_hold", "true"),
+					),
+				},
+				{
+					// Only the lock settings change here, so the object must not be re-uploaded
+					Config: tmpl.ObjectLock(t, bucketName, testRegion, keyName, content, retainUntilUpdated, false),
+					Check: resource.ComposeTestCheckFunc(
+						validateObject(resName, "test_locked", content),
+						resource.TestCheckResourceAttr(resName, "object_lock_retain_until_date", retainUntilUpdated),
+						resource.TestCheckResourceAttr(resName, "legal_hold", "false"),
+					),
+				},
+				{
+					// The retention is kept when it is no longer configured
+					Config: tmpl.ObjectLock(t, bucketName, testRegion, keyName, content, "", false),
+					Check: resource.ComposeTestCheckFunc(
+						validateObject(resName, "test_locked", content),
+						resource.TestCheckResourceAttr(resName, "object_lock_mode", "GOVERNANCE"),
+						resource.TestCheckResourceAttr(resName, "object_lock_retain_until_date", retainUntilUpdated),
+					),
+				},
+			},
+		})
+	})
+}
+
+func TestAccResourceObject_sseCustomerKey(t *testing.T) {
+	t.Parallel()
+
+	resName := getObjectResourceName("encrypted")
+	content := "testing123"
+
+	acceptance.RunTestWithRetries(t, 6, func(t *acceptance.WrappedT) {
+		bucketName := acctest.RandomWithPrefix("tf-test")
+		keyName := acctest.RandomWithPrefix("tf_test")
+
+		rawKey := make([]byte, 32)
+		if _, err := rand.Read(rawKey); err != nil {
+			t.Fatal(err)
+		}
+		sseCustomerKey := base64.StdEncoding.EncodeToString(rawKey)
+
+		resource.Test(t, resource.TestCase{
+			PreCheck:                 func() { acceptance.PreCheck(t) },
+			ProtoV5ProviderFactories: acceptance.ProtoV5ProviderFactories,
+			CheckDestroy:             checkObjectDestroy,
+			Steps: []resource.TestStep{
+				{
+					Config: tmpl.SSEC(t, bucketName, testRegion, keyName, content, sseCustomerKey),
+					Check: resource.ComposeTestCheckFunc(
+						validateObject(resName, "test_sse_c", content),
+						resource.TestCheckResourceAttr(resName, "sse_customer_algorithm", "AES256"),
+						resource.TestCheckResourceAttrSet(resName, "etag"),
+					),
+				},
+				{
+					Config:   tmpl.SSEC(t, bucketName, testRegion, keyName, content, sseCustomerKey),
+					PlanOnly: true,
+				},
+			},
+		})
+	})
+}
+
 func TestAccResourceObject_credsConfiged(t *testing.T) {
 	t.Parallel()
 
@@ -242,14 +333,26 @@ func getObject(ctx context.Context, rs *terraform.ResourceState) (*s3.GetObjectO
 		return nil, fmt.Errorf("failed to get create s3 client: %w", err)
 	}
 
-	return s3client.GetObject(
-		ctx,
-		&s3.GetObjectInput{
-			Bucket:  &bucket,
-			Key:     &key,
-			IfMatch: &etag,
-		},
-	)
+	getObjectInput := &s3.GetObjectInput{
+		Bucket:  &bucket,
+		Key:     &key,
+		IfMatch: &etag,
+	}
+
+	if sseCustomerKey := rs.Primary.Attributes["sse_customer_key"]; sseCustomerKey != "" {
+		rawKey, err := base64.StdEncoding.DecodeString(sseCustomerKey)
+		if err != nil {
+			return nil, err
+		}
+
+		keyMD5 := md5.Sum(rawKey)
+
+		getObjectInput.SSECustomerAlgorithm = aws.String(rs.Primary.Attributes["sse_customer_algorithm"])
+		getObjectInput.SSECustomerKey = &sseCustomerKey
+		getObjectInput.SSECustomerKeyMD5 = aws.String(base64.StdEncoding.EncodeToString(keyMD5[:]))
+	}
+
+	return s3client.GetObject(ctx, getObjectInput)
 }
 
 func checkObjectExists(resourceName string, obj *s3.GetObjectOutput) resource.TestCheckFunc {
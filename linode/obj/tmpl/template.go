@@ -16,6 +16,11 @@ type TemplateData struct {
 
 	Content string
 	Source  string
+
+	RetainUntil string
+	LegalHold   bool
+
+	SSECustomerKey string
 }
 
 func BasicWithCluster(t testing.TB, name, cluster, keyName, content, source string) string {
@@ -70,3 +75,31 @@ func TempKeys(t testing.TB, name, region, keyName, content string) string {
 			Region:  region,
 		})
 }
+
+func ObjectLock(t testing.TB, name, region, keyName, content, retainUntil string, legalHold bool) string {
+	return acceptance.ExecuteTemplate(t,
+		"object_object_object_lock", TemplateData{
+			Bucket: objectbucket.TemplateData{
+				Label:          name,
+				Region:         region,
+				Key:            objectkey.TemplateData{Label: keyName},
+				ObjectLockMode: "GOVERNANCE",
+				ObjectLockDays: 1,
+			},
+			Content:     content,
+			Region:      region,
+			RetainUntil: retainUntil,
+			LegalHold:   legalHold,
+		})
+}
+
+func SSEC(t testing.TB, name, region, keyName, content, sseCustomerKey string) string {
+	return acceptance.ExecuteTemplate(t,
+		"object_object_sse_c", TemplateData{
+			Bucket:         objectbucket.TemplateData{Label: name, Region: region},
+			Key:            objectkey.TemplateData{Label: keyName},
+			Content:        content,
+			Region:         region,
+			SSECustomerKey: sseCustomerKey,
+		})
+}
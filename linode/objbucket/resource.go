@@ -12,6 +12,7 @@ import (
 	"github.com/aws/smithy-go"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/linode/linodego"
 	"github.com/linode/terraform-provider-linode/v2/linode/helper"
@@ -36,6 +37,18 @@ func resourceLifeCycle() *schema.Resource {
 	}
 }
 
+func resourceCORSRule() *schema.Resource {
+	return &schema.Resource{
+		Schema: resourceSchemaCORSRule,
+	}
+}
+
+func resourceObjectLockConfiguration() *schema.Resource {
+	return &schema.Resource{
+		Schema: resourceSchemaObjectLockConfiguration,
+	}
+}
+
 func Resource() *schema.Resource {
 	return &schema.Resource{
 		Schema:        resourceSchema,
@@ -43,12 +56,19 @@ func Resource() *schema.Resource {
 		CreateContext: createResource,
 		UpdateContext: updateResource,
 		DeleteContext: deleteResource,
+		CustomizeDiff: customdiff.ForceNewIfChange("object_lock_configuration", objectLockEnabled),
 		Importer: &schema.ResourceImporter{
 			StateContext: schema.ImportStatePassthroughContext,
 		},
 	}
 }
 
+// objectLockEnabled returns whether Object Lock is being enabled on an existing
+// bucket, which requires the bucket to be recreated.
+func objectLockEnabled(ctx context.Context, oldValue, newValue, meta any) bool {
+	return len(oldValue.([]any)) == 0 && len(newValue.([]any)) > 0
+}
+
 func readResource(
 	ctx context.Context, d *schema.ResourceData, meta any,
 ) diag.Diagnostics {
@@ -86,11 +106,15 @@ func readResource(
 
 	_, versioningPresent := d.GetOk("versioning")
 	_, lifecyclePresent := d.GetOk("lifecycle_rule")
+	_, corsPresent := d.GetOk("cors_rule")
+	_, objectLockPresent := d.GetOk("object_lock_configuration")
 
-	if versioningPresent || lifecyclePresent {
-		tflog.Debug(ctx, "versioning or lifecycle presents", map[string]any{
+	if versioningPresent || lifecyclePresent || corsPresent || objectLockPresent {
+		tflog.Debug(ctx, "versioning, lifecycle, cors or object lock presents", map[string]any{
 			"versioningPresent": versioningPresent,
 			"lifecyclePresent":  lifecyclePresent,
+			"corsPresent":       corsPresent,
+			"objectLockPresent": objectLockPresent,
 		})
 
 		objKeys, diags, teardownKeysCleanUp := obj.GetObjKeys(ctx, d, config, client, bucket.Label, regionOrCluster, "read_only")
@@ -116,6 +140,19 @@ func readResource(
 		if err := readBucketVersioning(ctx, d, s3Client); err != nil {
 			return diag.Errorf("failed to find get object storage bucket versioning: %s", err)
 		}
+
+		// CORS rules are always read so that rules added out of band are detected
+		tflog.Trace(ctx, "getting bucket cors")
+		if err := readBucketCORS(ctx, d, s3Client); err != nil {
+			return diag.Errorf("failed to find get object storage bucket cors: %s", err)
+		}
+
+		if objectLockPresent {
+			tflog.Trace(ctx, "getting bucket object lock configuration")
+			if err := readBucketObjectLock(ctx, d, s3Client); err != nil {
+				return diag.Errorf("failed to find get object storage bucket object lock configuration: %s", err)
+			}
+		}
 	}
 	if bucket.Region != "" {
 		d.SetId(fmt.Sprintf("%s:%s", bucket.Region, bucket.Label))
@@ -213,11 +250,15 @@ func updateResource(
 
 	versioningChanged := d.HasChange("versioning")
 	lifecycleChanged := d.HasChange("lifecycle_rule")
-
-	if versioningChanged || lifecycleChanged {
-		tflog.Debug(ctx, "versioning or lifecycle change detected", map[string]any{
-			"versioning_changed": versioningChanged,
-			"lifecycle_changed":  lifecycleChanged,
+	corsChanged := d.HasChange("cors_rule")
+	objectLockChanged := d.HasChange("object_lock_configuration")
+
+	if versioningChanged || lifecycleChanged || corsChanged || objectLockChanged {
+		tflog.Debug(ctx, "versioning, lifecycle, cors or object lock change detected", map[string]any{
+			"versioning_changed":  versioningChanged,
+			"lifecycle_changed":   lifecycleChanged,
+			"cors_changed":        corsChanged,
+			"object_lock_changed": objectLockChanged,
 		})
 
 		config := meta.(*helper.ProviderMeta).Config
@@ -253,6 +294,21 @@ func updateResource(
 				return diag.FromErr(err)
 			}
 		}
+
+		if corsChanged {
+			tflog.Debug(ctx, "Updating bucket cors configuration")
+			if err := updateBucketCORS(ctx, d, s3client); err != nil {
+				return diag.FromErr(err)
+			}
+		}
+
+		// Object Lock requires versioning, so this must happen after the versioning update
+		if objectLockChanged {
+			tflog.Debug(ctx, "Updating bucket object lock configuration")
+			if err := updateBucketObjectLock(ctx, d, s3client); err != nil {
+				return diag.FromErr(err)
+			}
+		}
 	}
 
 	return readResource(ctx, d, meta)
@@ -286,6 +342,12 @@ func deleteResource(
 			return diag.FromErr(err)
 		}
 
+		// Objects under legal hold can't be deleted, even when bypassing the retention
+		tflog.Debug(ctx, "helper.ReleaseAllLegalHolds(...)")
+		if err := helper.ReleaseAllLegalHolds(ctx, label, s3client); err != nil {
+			return diag.Errorf("Error releasing legal holds in ObjectStorageBucket: %s: %s", d.Id(), err)
+		}
+
 		tflog.Debug(ctx, "helper.PurgeAllObjects(...)")
 		err = helper.PurgeAllObjects(ctx, label, s3client, true, true)
 		if err != nil {
@@ -351,6 +413,52 @@ func readBucketLifecycle(ctx context.Context, d *schema.ResourceData, client *s3
 	return nil
 }
 
+func readBucketCORS(ctx context.Context, d *schema.ResourceData, client *s3.Client) error {
+	label := d.Get("label").(string)
+
+	corsOutput, err := client.GetBucketCors(
+		ctx,
+		&s3.GetBucketCorsInput{Bucket: &label},
+	)
+	// A "NoSuchCORSConfiguration" error means the bucket has no rules
+	if err != nil {
+		var ae smithy.APIError
+		if ok := errors.As(err, &ae); !ok || ae.ErrorCode() != "NoSuchCORSConfiguration" {
+			return fmt.Errorf("failed to get cors for bucket id %s: %w", d.Id(), err)
+		}
+
+		d.Set("cors_rule", []map[string]any{})
+		return nil
+	}
+
+	d.Set("cors_rule", flattenCORSRules(ctx, corsOutput.CORSRules))
+
+	return nil
+}
+
+func readBucketObjectLock(ctx context.Context, d *schema.ResourceData, client *s3.Client) error {
+	label := d.Get("label").(string)
+
+	lockOutput, err := client.GetObjectLockConfiguration(
+		ctx,
+		&s3.GetObjectLockConfigurationInput{Bucket: &label},
+	)
+	// Object Lock was never enabled on this bucket
+	if err != nil {
+		var ae smithy.APIError
+		if ok := errors.As(err, &ae); !ok || ae.ErrorCode() != "ObjectLockConfigurationNotFoundError" {
+			return fmt.Errorf("failed to get object lock configuration for bucket id %s: %w", d.Id(), err)
+		}
+
+		d.Set("object_lock_configuration", []map[string]any{})
+		return nil
+	}
+
+	d.Set("object_lock_configuration", flattenObjectLockConfiguration(lockOutput.ObjectLockConfiguration))
+
+	return nil
+}
+
 func updateBucketVersioning(
 	ctx context.Context,
 	d *schema.ResourceData,
@@ -421,6 +529,94 @@ func updateBucketLifecycle(
 	return err
 }
 
+func updateBucketCORS(
+	ctx context.Context,
+	d *schema.ResourceData,
+	client *s3.Client,
+) error {
+	bucket := d.Get("label").(string)
+
+	ruleSpecs := d.Get("cors_rule").([]any)
+
+	// A max_age_seconds of 0 disables caching, so it is only left out when it isn't configured
+	for i, ruleSpec := range ruleSpecs {
+		maxAgePath := fmt.Sprintf("cors_rule.%d.max_age_seconds", i)
+		if helper.SDKv2UnwrapOptionalConfigAttr[int](ctx, d, maxAgePath) == nil {
+			delete(ruleSpec.(map[string]any), "max_age_seconds")
+		}
+	}
+
+	rules, err := expandCORSRules(ctx, ruleSpecs)
+	if err != nil {
+		return err
+	}
+
+	if len(rules) > 0 {
+		options := &s3.PutBucketCorsInput{
+			Bucket: &bucket,
+			CORSConfiguration: &s3types.CORSConfiguration{
+				CORSRules: rules,
+			},
+		}
+		tflog.Debug(ctx, "client.PutBucketCors(...)", map[string]any{
+			"options": options,
+		})
+
+		_, err = client.PutBucketCors(ctx, options)
+	} else {
+		options := &s3.DeleteBucketCorsInput{Bucket: &bucket}
+		tflog.Debug(ctx, "client.DeleteBucketCors(...)", map[string]any{
+			"options": options,
+		})
+
+		_, err = client.DeleteBucketCors(ctx, options)
+	}
+
+	return err
+}
+
+func updateBucketObjectLock(
+	ctx context.Context,
+	d *schema.ResourceData,
+	client *s3.Client,
+) error {
+	bucket := d.Get("label").(string)
+	lockSpecs := d.Get("object_lock_configuration").([]any)
+
+	if len(lockSpecs) == 0 {
+		oldSpecs, _ := d.GetChange("object_lock_configuration")
+		if len(oldSpecs.([]any)) == 0 {
+			return nil
+		}
+
+		// Object Lock can't be disabled, so only the default retention is removed here
+		tflog.Warn(ctx, "Object Lock can't be disabled on a bucket, removing the default retention only")
+	} else if !d.Get("versioning").(bool) {
+		return fmt.Errorf("versioning must be enabled to use object_lock_configuration")
+	}
+
+	var lockSpec map[string]any
+	if len(lockSpecs) > 0 && lockSpecs[0] != nil {
+		lockSpec = lockSpecs[0].(map[string]any)
+	}
+
+	lockConfig, err := expandObjectLockConfiguration(lockSpec)
+	if err != nil {
+		return err
+	}
+
+	options := &s3.PutObjectLockConfigurationInput{
+		Bucket:                  &bucket,
+		ObjectLockConfiguration: lockConfig,
+	}
+	tflog.Debug(ctx, "client.PutObjectLockConfiguration(...)", map[string]any{
+		"options": options,
+	})
+
+	_, err = client.PutObjectLockConfiguration(ctx, options)
+	return err
+}
+
 func updateBucketAccess(
 	ctx context.Context, d *schema.ResourceData, client linodego.Client,
 ) error {
@@ -695,3 +891,127 @@ func matchRulesWithSchema(
 
 	return result
 }
+
+func flattenCORSRules(ctx context.Context, rules []s3types.CORSRule) []map[string]any {
+	tflog.Debug(ctx, "entering flattenCORSRules")
+	result := make([]map[string]any, len(rules))
+
+	for i, rule := range rules {
+		ruleMap := map[string]any{
+			"allowed_origins": rule.AllowedOrigins,
+			"allowed_methods": rule.AllowedMethods,
+			"allowed_headers": rule.AllowedHeaders,
+			"expose_headers":  rule.ExposeHeaders,
+		}
+
+		if id := rule.ID; id != nil {
+			ruleMap["id"] = *id
+		}
+
+		if maxAge := rule.MaxAgeSeconds; maxAge != nil {
+			ruleMap["max_age_seconds"] = int(*maxAge)
+		}
+
+		result[i] = ruleMap
+	}
+
+	return result
+}
+
+func expandCORSRules(ctx context.Context, ruleSpecs []any) ([]s3types.CORSRule, error) {
+	tflog.Debug(ctx, "entering expandCORSRules")
+
+	rules := make([]s3types.CORSRule, len(ruleSpecs))
+	for i, ruleSpec := range ruleSpecs {
+		ruleSpec := ruleSpec.(map[string]any)
+		rule := s3types.CORSRule{
+			AllowedOrigins: helper.ExpandStringList(ruleSpec["allowed_origins"].([]any)),
+			AllowedMethods: helper.ExpandStringList(ruleSpec["allowed_methods"].([]any)),
+			AllowedHeaders: helper.ExpandStringList(ruleSpec["allowed_headers"].([]any)),
+			ExposeHeaders:  helper.ExpandStringList(ruleSpec["expose_headers"].([]any)),
+		}
+
+		if id, ok := ruleSpec["id"].(string); ok && id != "" {
+			rule.ID = &id
+		}
+
+		if maxAge, ok := ruleSpec["max_age_seconds"].(int); ok {
+			int32MaxAge, err := helper.SafeIntToInt32(maxAge)
+			if err != nil {
+				return nil, err
+			}
+			rule.MaxAgeSeconds = &int32MaxAge
+		}
+
+		rules[i] = rule
+	}
+
+	return rules, nil
+}
+
+func flattenObjectLockConfiguration(lockConfig *s3types.ObjectLockConfiguration) []map[string]any {
+	if lockConfig == nil || lockConfig.ObjectLockEnabled != s3types.ObjectLockEnabledEnabled {
+		return []map[string]any{}
+	}
+
+	result := map[string]any{}
+
+	if lockConfig.Rule != nil && lockConfig.Rule.DefaultRetention != nil {
+		retention := lockConfig.Rule.DefaultRetention
+
+		result["mode"] = string(retention.Mode)
+
+		if retention.Days != nil {
+			result["days"] = int(*retention.Days)
+		}
+
+		if retention.Years != nil {
+			result["years"] = int(*retention.Years)
+		}
+	}
+
+	return []map[string]any{result}
+}
+
+func expandObjectLockConfiguration(lockSpec map[string]any) (*s3types.ObjectLockConfiguration, error) {
+	lockConfig := &s3types.ObjectLockConfiguration{
+		ObjectLockEnabled: s3types.ObjectLockEnabledEnabled,
+	}
+
+	mode, _ := lockSpec["mode"].(string)
+	days, _ := lockSpec["days"].(int)
+	years, _ := lockSpec["years"].(int)
+
+	if mode == "" {
+		if days > 0 || years > 0 {
+			return nil, fmt.Errorf("mode is required when days or years is specified in object_lock_configuration")
+		}
+
+		return lockConfig, nil
+	}
+
+	retention := &s3types.DefaultRetention{
+		Mode: s3types.ObjectLockRetentionMode(mode),
+	}
+
+	switch {
+	case days > 0:
+		int32Days, err := helper.SafeIntToInt32(days)
+		if err != nil {
+			return nil, err
+		}
+		retention.Days = &int32Days
+	case years > 0:
+		int32Years, err := helper.SafeIntToInt32(years)
+		if err != nil {
+			return nil, err
+		}
+		retention.Years = &int32Years
+	default:
+		return nil, fmt.Errorf("either days or years is required when mode is specified in object_lock_configuration")
+	}
+
+	lockConfig.Rule = &s3types.ObjectLockRule{DefaultRetention: retention}
+
+	return lockConfig, nil
+}
@@ -22,12 +22,14 @@ import (
 	"github.com/aws/aws-sdk-go-v2/aws"
 	s3manager "github.com/aws/aws-sdk-go-v2/feature/s3/manager"
 	"github.com/aws/aws-sdk-go-v2/service/s3"
+	s3types "github.com/aws/aws-sdk-go-v2/service/s3/types"
 
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
 	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
 	"github.com/hashicorp/terraform-plugin-testing/statecheck"
 	"github.com/hashicorp/terraform-plugin-testing/terraform"
 	"github.com/hashicorp/terraform-plugin-testing/tfjsonpath"
@@ -452,6 +454,125 @@ func TestAccResourceBucket_lifecycle(t *testing.T) {
 	})
 }
 
+func TestAccResourceBucket_cors(t *testing.T) {
+	t.Parallel()
+
+	acceptance.RunTestWithRetries(t, 5, func(t *acceptance.WrappedT) {
+		resName := "linode_object_storage_bucket.foobar"
+		objectStorageBucketName := acctest.RandomWithPrefix("tf-test")
+		objectStorageKeyName := acctest.RandomWithPrefix("tf-test")
+
+		var accessKey, secretKey, endpoint string
+
+		resource.Test(t, resource.TestCase{
+			PreCheck:                 func() { acceptance.PreCheck(t) },
+			ProtoV5ProviderFactories: acceptance.ProtoV5ProviderFactories,
+			CheckDestroy:             checkBucketDestroy,
+			Steps: []resource.TestStep{
+				{
+					Config: tmpl.CORS(t, objectStorageBucketName, testRegion, objectStorageKeyName),
+					Check: resource.ComposeTestCheckFunc(
+						resource.TestCheckResourceAttr(resName, "cors_rule.#", "1"),
+						resource.TestCheckResourceAttr(resName, "cors_rule.0.id", "test-rule"),
+						resource.TestCheckResourceAttr(resName, "cors_rule.0.allowed_origins.#", "1"),
+						resource.TestCheckResourceAttr(resName, "cors_rule.0.allowed_origins.0", "https://example.com"),
+						resource.TestCheckResourceAttr(resName, "cors_rule.0.allowed_methods.#", "2"),
+						resource.TestCheckResourceAttr(resName, "cors_rule.0.allowed_headers.0", "*"),
+						resource.TestCheckResourceAttr(resName, "cors_rule.0.expose_headers.0", "ETag"),
+						resource.TestCheckResourceAttr(resName, "cors_rule.0.max_age_seconds", "3000"),
+						func(s *terraform.State) error {
+							bucket := s.RootModule().Resources[resName].Primary.Attributes
+							accessKey = bucket["access_key"]
+							secretKey = bucket["secret_key"]
+							endpoint = bucket["s3_endpoint"]
+							return nil
+						},
+					),
+				},
+				{
+					// Rules removed out of band should be detected as drift.
+					PreConfig: func() {
+						s3Client, err := helper.S3Connection(context.Background(), endpoint, accessKey, secretKey)
+						if err != nil {
+							t.Fatal(err)
+						}
+
+						if _, err := s3Client.DeleteBucketCors(context.Background(), &s3.DeleteBucketCorsInput{
+							Bucket: &objectStorageBucketName,
+						}); err != nil {
+							t.Fatal(err)
+						}
+					},
+					Config:             tmpl.CORS(t, objectStorageBucketName, testRegion, objectStorageKeyName),
+					PlanOnly:           true,
+					ExpectNonEmptyPlan: true,
+				},
+				{
+					Config: tmpl.CORSUpdates(t, objectStorageBucketName, testRegion, objectStorageKeyName),
+					Check: resource.ComposeTestCheckFunc(
+						resource.TestCheckResourceAttr(resName, "cors_rule.#", "2"),
+						resource.TestCheckResourceAttr(resName, "cors_rule.0.id", "test-rule-update"),
+						resource.TestCheckResourceAttr(resName, "cors_rule.0.allowed_origins.#", "2"),
+						resource.TestCheckResourceAttr(resName, "cors_rule.0.allowed_methods.#", "2"),
+						resource.TestCheckResourceAttr(resName, "cors_rule.1.allowed_origins.0", "*"),
+					),
+				},
+			},
+		})
+	})
+}
+
+func TestAccResourceBucket_objectLock(t *testing.T) {
+	t.Parallel()
+
+	resName := "linode_object_storage_bucket.foobar"
+	objectStorageBucketName := acctest.RandomWithPrefix("tf-test")
+	objectStorageKeyName := acctest.RandomWithPrefix("tf-test")
+
+	acceptance.RunTestWithRetries(t, 5, func(t *acceptance.WrappedT) {
+		resource.Test(t, resource.TestCase{
+			PreCheck:                 func() { acceptance.PreCheck(t) },
+			ProtoV5ProviderFactories: acceptance.ProtoV5ProviderFactories,
+			CheckDestroy:             checkBucketDestroy,
+			Steps: []resource.TestStep{
+				{
+					Config: tmpl.Versioning(t, objectStorageBucketName, testRegion, objectStorageKeyName, true),
+					Check: resource.ComposeTestCheckFunc(
+						resource.TestCheckResourceAttr(resName, "object_lock_configuration.#", "0"),
+					),
+				},
+				{
+					// Object Lock can only be enabled when the bucket is created
+					Config: tmpl.ObjectLock(t, objectStorageBucketName, testRegion, objectStorageKeyName, "GOVERNANCE", 1),
+					ConfigPlanChecks: resource.ConfigPlanChecks{
+						PreApply: []plancheck.PlanCheck{
+							plancheck.ExpectResourceAction(resName, plancheck.ResourceActionReplace),
+						},
+					},
+					Check: resource.ComposeTestCheckFunc(
+						resource.TestCheckResourceAttr(resName, "versioning", "true"),
+						resource.TestCheckResourceAttr(resName, "object_lock_configuration.#", "1"),
+						resource.TestCheckResourceAttr(resName, "object_lock_configuration.0.mode", "GOVERNANCE"),
+						resource.TestCheckResourceAttr(resName, "object_lock_configuration.0.days", "1"),
+					),
+				},
+				{
+					Config: tmpl.ObjectLock(t, objectStorageBucketName, testRegion, objectStorageKeyName, "GOVERNANCE", 2),
+					ConfigPlanChecks: resource.ConfigPlanChecks{
+						PreApply: []plancheck.PlanCheck{
+							plancheck.ExpectResourceAction(resName, plancheck.ResourceActionUpdate),
+						},
+					},
+					Check: resource.ComposeTestCheckFunc(
+						resource.TestCheckResourceAttr(resName, "object_lock_configuration.#", "1"),
+						resource.TestCheckResourceAttr(resName, "object_lock_configuration.0.days", "2"),
+					),
+				},
+			},
+		})
+	})
+}
+
 func TestAccResourceBucket_lifecycleNoID(t *testing.T) {
 	t.Parallel()
 
@@ -678,40 +799,41 @@ func TestAccResourceBucket_forceDelete(t *testing.T) {
 				},
 				{
 					PreConfig: func() {
-						client := acceptance.TestAccProvider.Meta().(*helper.ProviderMeta).Client
-						createOpts := linodego.ObjectStorageKeyCreateOptions{
-							Label: fmt.Sprintf("temp_%s_%v", objectStorageBucketName, time.Now().Unix()),
-							BucketAccess: &[]linodego.ObjectStorageKeyBucketAccess{{
-								BucketName:  objectStorageBucketName,
-								Region:      testRegion,
-								Permissions: "read_write",
-							}},
-						}
-
-						keys, err := client.CreateObjectStorageKey(context.Background(), createOpts)
-						if err != nil {
-							t.Errorf("error creating obj keys in PreConfig func: %v", err)
-						}
-						defer client.DeleteObjectStorageKey(context.Background(), keys.ID)
+						putTestObject(t, objectStorageBucketName, objectStorageKeyName, false)
+					},
+					Config: tmpl.ForceDelete_Empty(t),
+					Check:  resource.ComposeTestCheckFunc(checkBucketDestroy),
+				},
+			},
+		})
+	})
+}
 
-						bucket, err := client.GetObjectStorageBucket(context.Background(), testRegion, objectStorageBucketName)
-						if err != nil {
-							t.Errorf("error getting obj bucket in PreConfig func: %v", err)
-						}
+func TestAccResourceBucket_forceDeleteLegalHold(t *testing.T) {
+	t.Parallel()
 
-						s3client, err := helper.S3Connection(context.Background(), bucket.S3Endpoint, keys.AccessKey, keys.SecretKey)
-						if err != nil {
-							t.Errorf("error connecting s3 in PreConfig func: %v", err)
-						}
+	resName := "linode_object_storage_bucket.foobar"
+	objectStorageBucketName := acctest.RandomWithPrefix("tf-test")
+	objectStorageKeyName := acctest.RandomWithPrefix("tf-test")
 
-						contentBytes := []byte("delete test")
-						body := *s3manager.ReadSeekCloser(bytes.NewReader(contentBytes))
-						putInput := &s3.PutObjectInput{
-							Bucket: &objectStorageBucketName,
-							Key:    &objectStorageKeyName,
-							Body:   &body,
-						}
-						s3client.PutObject(context.Background(), putInput)
+	acceptance.RunTestWithRetries(t, 5, func(t *acceptance.WrappedT) {
+		resource.Test(t, resource.TestCase{
+			PreCheck:                 func() { acceptance.PreCheck(t) },
+			ProtoV5ProviderFactories: acceptance.ProtoV5ProviderFactories,
+			CheckDestroy:             checkBucketDestroy,
+			Steps: []resource.TestStep{
+				{
+					Config: tmpl.ForceDeleteObjectLock(t, objectStorageBucketName, testRegion, "GOVERNANCE", 1),
+					Check: resource.ComposeTestCheckFunc(
+						resource.TestCheckResourceAttr(resName, "label", objectStorageBucketName),
+						resource.TestCheckResourceAttr(resName, "object_lock_configuration.0.mode", "GOVERNANCE"),
+					),
+				},
+				{
+					// The object is retained and under legal hold, which both
+					// must be lifted to delete the bucket
+					PreConfig: func() {
+						putTestObject(t, objectStorageBucketName, objectStorageKeyName, true)
 					},
 					Config: tmpl.ForceDelete_Empty(t),
 					Check:  resource.ComposeTestCheckFunc(checkBucketDestroy),
@@ -721,6 +843,52 @@ func TestAccResourceBucket_forceDelete(t *testing.T) {
 	})
 }
 
+// putTestObject uploads an object to the given bucket with temporary keys,
+// optionally placing it under legal hold.
+func putTestObject(t *acceptance.WrappedT, bucketName, keyName string, legalHold bool) {
+	client := acceptance.TestAccProvider.Meta().(*helper.ProviderMeta).Client
+	createOpts := linodego.ObjectStorageKeyCreateOptions{
+		Label: fmt.Sprintf("temp_%s_%v", bucketName, time.Now().Unix()),
+		BucketAccess: &[]linodego.ObjectStorageKeyBucketAccess{{
+			BucketName:  bucketName,
+			Region:      testRegion,
+			Permissions: "read_write",
+		}},
+	}
+
+	keys, err := client.CreateObjectStorageKey(context.Background(), createOpts)
+	if err != nil {
+		t.Errorf("error creating obj keys in PreConfig func: %v", err)
+	}
+	defer client.DeleteObjectStorageKey(context.Background(), keys.ID)
+
+	bucket, err := client.GetObjectStorageBucket(context.Background(), testRegion, bucketName)
+	if err != nil {
+		t.Errorf("error getting obj bucket in PreConfig func: %v", err)
+	}
+
+	s3client, err := helper.S3Connection(context.Background(), bucket.S3Endpoint, keys.AccessKey, keys.SecretKey)
+	if err != nil {
+		t.Errorf("error connecting s3 in PreConfig func: %v", err)
+	}
+
+	contentBytes := []byte("delete test")
+	body := *s3manager.ReadSeekCloser(bytes.NewReader(contentBytes))
+	putInput := &s3.PutObjectInput{
+		Bucket: &bucketName,
+		Key:    &keyName,
+		Body:   &body,
+	}
+
+	if legalHold {
+		putInput.ObjectLockLegalHoldStatus = s3types.ObjectLockLegalHoldStatusOn
+	}
+
+	if _, err := s3client.PutObject(context.Background(), putInput); err != nil {
+		t.Errorf("error putting object in PreConfig func: %v", err)
+	}
+}
+
 func checkBucketExists(s *terraform.State) error {
 	client := acceptance.TestAccProvider.Meta().(*helper.ProviderMeta).Client
 
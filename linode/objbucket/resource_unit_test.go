@@ -0,0 +1,137 @@
+//go:build unit
+
+package objbucket
+
+import (
+	"context"
+	"testing"
+
+	s3types "github.com/aws/aws-sdk-go-v2/service/s3/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestExpandCORSRules(t *testing.T) {
+	ruleSpecs := []any{
+		map[string]any{
+			"id":              "web",
+			"allowed_origins": []any{"https://example.com"},
+			"allowed_methods": []any{"GET", "HEAD"},
+			"allowed_headers": []any{"*"},
+			"expose_headers":  []any{"ETag"},
+			"max_age_seconds": 3000,
+		},
+		map[string]any{
+			"id":              "",
+			"allowed_origins": []any{"*"},
+			"allowed_methods": []any{"GET"},
+			"allowed_headers": []any{},
+			"expose_headers":  []any{},
+		},
+		map[string]any{
+			"id":              "",
+			"allowed_origins": []any{"*"},
+			"allowed_methods": []any{"GET"},
+			"allowed_headers": []any{},
+			"expose_headers":  []any{},
+			"max_age_seconds": 0,
+		},
+	}
+
+	rules, err := expandCORSRules(context.Background(), ruleSpecs)
+	require.NoError(t, err)
+	require.Len(t, rules, 3)
+
+	assert.Equal(t, "web", *rules[0].ID)
+	assert.Equal(t, []string{"https://example.com"}, rules[0].AllowedOrigins)
+	assert.Equal(t, []string{"GET", "HEAD"}, rules[0].AllowedMethods)
+	assert.Equal(t, []string{"*"}, rules[0].AllowedHeaders)
+	assert.Equal(t, []string{"ETag"}, rules[0].ExposeHeaders)
+	assert.Equal(t, int32(3000), *rules[0].MaxAgeSeconds)
+
+	assert.Nil(t, rules[1].ID)
+	assert.Nil(t, rules[1].MaxAgeSeconds)
+
+	// An explicit max_age_seconds of 0 disables caching
+	require.NotNil(t, rules[2].MaxAgeSeconds)
+	assert.Equal(t, int32(0), *rules[2].MaxAgeSeconds)
+}
+
+func TestFlattenCORSRules(t *testing.T) {
+	id := "web"
+	maxAge := int32(3000)
+
+	result := flattenCORSRules(context.Background(), []s3types.CORSRule{
+		{
+			ID:             &id,
+			AllowedOrigins: []string{"https://example.com"},
+			AllowedMethods: []string{"PUT"},
+			MaxAgeSeconds:  &maxAge,
+		},
+	})
+
+	require.Len(t, result, 1)
+	assert.Equal(t, "web", result[0]["id"])
+	assert.Equal(t, []string{"https://example.com"}, result[0]["allowed_origins"])
+	assert.Equal(t, []string{"PUT"}, result[0]["allowed_methods"])
+	assert.Equal(t, 3000, result[0]["max_age_seconds"])
+}
+
+func TestExpandObjectLockConfiguration(t *testing.T) {
+	lockConfig, err := expandObjectLockConfiguration(map[string]any{
+		"mode":  "GOVERNANCE",
+		"days":  30,
+		"years": 0,
+	})
+	require.NoError(t, err)
+
+	assert.Equal(t, s3types.ObjectLockEnabledEnabled, lockConfig.ObjectLockEnabled)
+	require.NotNil(t, lockConfig.Rule)
+	assert.Equal(t, s3types.ObjectLockRetentionModeGovernance, lockConfig.Rule.DefaultRetention.Mode)
+	assert.Equal(t, int32(30), *lockConfig.Rule.DefaultRetention.Days)
+	assert.Nil(t, lockConfig.Rule.DefaultRetention.Years)
+
+	lockConfig, err = expandObjectLockConfiguration(nil)
+	require.NoError(t, err)
+	assert.Equal(t, s3types.ObjectLockEnabledEnabled, lockConfig.ObjectLockEnabled)
+	assert.Nil(t, lockConfig.Rule)
+
+	_, err = expandObjectLockConfiguration(map[string]any{"mode": "COMPLIANCE"})
+	assert.Error(t, err)
+
+	_, err = expandObjectLockConfiguration(map[string]any{"years": 1})
+	assert.Error(t, err)
+}
+
+func TestFlattenObjectLockConfiguration(t *testing.T) {
+	years := int32(2)
+
+	result := flattenObjectLockConfiguration(&s3types.ObjectLockConfiguration{
+		ObjectLockEnabled: s3types.ObjectLockEnabledEnabled,
+		Rule: &s3types.ObjectLockRule{
+			DefaultRetention: &s3types.DefaultRetention{
+				Mode:  s3types.ObjectLockRetentionModeCompliance,
+				Years: &years,
+			},
+		},
+	})
+
+	require.Len(t, result, 1)
+	assert.Equal(t, "COMPLIANCE", result[0]["mode"])
+	assert.Equal(t, 2, result[0]["years"])
+	assert.NotContains(t, result[0], "days")
+
+	assert.Empty(t, flattenObjectLockConfiguration(nil))
+}
+
+func TestObjectLockEnabled(t *testing.T) {
+	ctx := context.Background()
+	lockSpecs := []any{map[string]any{"mode": "GOVERNANCE", "days": 1}}
+
+	assert.True(t, objectLockEnabled(ctx, []any{}, lockSpecs, nil))
+	assert.True(t, objectLockEnabled(ctx, []any{}, []any{nil}, nil))
+
+	// Changing the default retention or removing it doesn't recreate the bucket
+	assert.False(t, objectLockEnabled(ctx, lockSpecs, []any{map[string]any{"mode": "GOVERNANCE", "days": 2}}, nil))
+	assert.False(t, objectLockEnabled(ctx, lockSpecs, []any{}, nil))
+}
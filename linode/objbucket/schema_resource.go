@@ -1,11 +1,14 @@
 package objbucket
 
-import "github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+import (
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
 
 var resourceSchema = map[string]*schema.Schema{
 	"secret_key": {
 		Type: schema.TypeString,
-		Description: "The S3 secret key to use for this resource. (Required for lifecycle_rule and versioning). " +
+		Description: "The S3 secret key to use for this resource. (Required for lifecycle_rule, cors_rule and versioning). " +
 			"If not specified with the resource, the value will be read from provider-level obj_secret_key, " +
 			"or, generated implicitly at apply-time if obj_use_temp_keys in provider configuration is set.",
 		Optional:  true,
@@ -13,7 +16,7 @@ var resourceSchema = map[string]*schema.Schema{
 	},
 	"access_key": {
 		Type: schema.TypeString,
-		Description: "The S3 access key to use for this resource. (Required for lifecycle_rule and versioning). " +
+		Description: "The S3 access key to use for this resource. (Required for lifecycle_rule, cors_rule and versioning). " +
 			"If not specified with the resource, the value will be read from provider-level obj_access_key, " +
 			"or, generated implicitly at apply-time if obj_use_temp_keys in provider configuration is set.",
 		Optional: true,
@@ -69,10 +72,19 @@ var resourceSchema = map[string]*schema.Schema{
 		Default:     "private",
 	},
 	"cors_enabled": {
-		Type:        schema.TypeBool,
-		Description: "If true, the bucket will be created with CORS enabled for all origins.",
-		Optional:    true,
-		Computed:    true,
+		Type:          schema.TypeBool,
+		Description:   "If true, the bucket will be created with CORS enabled for all origins.",
+		Optional:      true,
+		Computed:      true,
+		ConflictsWith: []string{"cors_rule"},
+	},
+	"cors_rule": {
+		Type: schema.TypeList,
+		Description: "CORS rules to be applied to the bucket. (Requires access_key and secret_key). " +
+			"Conflicts with cors_enabled.",
+		Optional:      true,
+		Elem:          resourceCORSRule(),
+		ConflictsWith: []string{"cors_enabled"},
 	},
 	"lifecycle_rule": {
 		Type:        schema.TypeList,
@@ -80,6 +92,15 @@ var resourceSchema = map[string]*schema.Schema{
 		Optional:    true,
 		Elem:        resourceLifeCycle(),
 	},
+	"object_lock_configuration": {
+		Type: schema.TypeList,
+		Description: "The Object Lock configuration of the bucket. Enabling Object Lock on an existing bucket " +
+			"recreates it, and Object Lock can't be disabled once enabled. " +
+			"(Requires access_key, secret_key and versioning).",
+		Optional: true,
+		MaxItems: 1,
+		Elem:     resourceObjectLockConfiguration(),
+	},
 	"hostname": {
 		Type: schema.TypeString,
 		Description: "The hostname where this bucket can be accessed. " +
@@ -180,3 +201,69 @@ var resourceSchemaNonCurrentExp = map[string]*schema.Schema{
 		Required:    true,
 	},
 }
+
+var resourceSchemaCORSRule = map[string]*schema.Schema{
+	"id": {
+		Type:        schema.TypeString,
+		Description: "The unique identifier for the rule.",
+		Optional:    true,
+	},
+	"allowed_origins": {
+		Type:        schema.TypeList,
+		Description: "The origins that are allowed to access the bucket, e.g. `https://example.com`.",
+		Required:    true,
+		MinItems:    1,
+		Elem:        &schema.Schema{Type: schema.TypeString},
+	},
+	"allowed_methods": {
+		Type:        schema.TypeList,
+		Description: "The HTTP methods that the allowed origins are allowed to execute.",
+		Required:    true,
+		MinItems:    1,
+		Elem: &schema.Schema{
+			Type:         schema.TypeString,
+			ValidateFunc: validation.StringInSlice([]string{"GET", "PUT", "POST", "DELETE", "HEAD"}, false),
+		},
+	},
+	"allowed_headers": {
+		Type:        schema.TypeList,
+		Description: "The headers that are allowed in a preflight request.",
+		Optional:    true,
+		Elem:        &schema.Schema{Type: schema.TypeString},
+	},
+	"expose_headers": {
+		Type:        schema.TypeList,
+		Description: "The response headers that clients are allowed to access.",
+		Optional:    true,
+		Elem:        &schema.Schema{Type: schema.TypeString},
+	},
+	"max_age_seconds": {
+		Type:         schema.TypeInt,
+		Description:  "The time in seconds that browsers can cache the response for a preflight request.",
+		Optional:     true,
+		ValidateFunc: validation.IntAtLeast(0),
+	},
+}
+
+var resourceSchemaObjectLockConfiguration = map[string]*schema.Schema{
+	"mode": {
+		Type:         schema.TypeString,
+		Description:  "The default retention mode applied to new objects, either GOVERNANCE or COMPLIANCE.",
+		Optional:     true,
+		ValidateFunc: validation.StringInSlice([]string{"GOVERNANCE", "COMPLIANCE"}, false),
+	},
+	"days": {
+		Type:          schema.TypeInt,
+		Description:   "The number of days new objects are retained by default.",
+		Optional:      true,
+		ValidateFunc:  validation.IntAtLeast(1),
+		ConflictsWith: []string{"object_lock_configuration.0.years"},
+	},
+	"years": {
+		Type:          schema.TypeInt,
+		Description:   "The number of years new objects are retained by default.",
+		Optional:      true,
+		ValidateFunc:  validation.IntAtLeast(1),
+		ConflictsWith: []string{"object_lock_configuration.0.days"},
+	},
+}
@@ -21,6 +21,9 @@ type TemplateData struct {
 	Region       string
 	EndpointType string
 	EndpointURL  string
+
+	ObjectLockMode string
+	ObjectLockDays int
 }
 
 func Basic(t testing.TB, label, region string) string {
@@ -124,6 +127,35 @@ func LifeCycleRemoved(t testing.TB, label, region, keyName string) string {
 		})
 }
 
+func CORS(t testing.TB, label, region, keyName string) string {
+	return acceptance.ExecuteTemplate(t,
+		"object_bucket_cors", TemplateData{
+			Key:    objkey.TemplateData{Label: keyName},
+			Label:  label,
+			Region: region,
+		})
+}
+
+func CORSUpdates(t testing.TB, label, region, keyName string) string {
+	return acceptance.ExecuteTemplate(t,
+		"object_bucket_cors_updates", TemplateData{
+			Key:    objkey.TemplateData{Label: keyName},
+			Label:  label,
+			Region: region,
+		})
+}
+
+func ObjectLock(t testing.TB, label, region, keyName, mode string, days int) string {
+	return acceptance.ExecuteTemplate(t,
+		"object_bucket_object_lock", TemplateData{
+			Key:            objkey.TemplateData{Label: keyName},
+			Label:          label,
+			Region:         region,
+			ObjectLockMode: mode,
+			ObjectLockDays: days,
+		})
+}
+
 func TempKeys(t testing.TB, label, region, keyName string) string {
 	return acceptance.ExecuteTemplate(t,
 		"object_bucket_temp_keys", TemplateData{
@@ -141,6 +173,16 @@ func ForceDelete(t testing.TB, label, region string) string {
 		})
 }
 
+func ForceDeleteObjectLock(t testing.TB, label, region, mode string, days int) string {
+	return acceptance.ExecuteTemplate(t,
+		"object_bucket_force_delete_object_lock", TemplateData{
+			Label:          label,
+			Region:         region,
+			ObjectLockMode: mode,
+			ObjectLockDays: days,
+		})
+}
+
 func ForceDelete_Empty(t testing.TB) string {
 	return acceptance.ExecuteTemplate(t, "object_bucket_force_delete_empty", nil)
 }
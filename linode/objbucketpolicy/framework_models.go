@@ -0,0 +1,65 @@
+package objbucketpolicy
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/aws/aws-sdk-go-v2/service/s3"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/linode/linodego"
+	"github.com/linode/terraform-provider-linode/v2/linode/helper"
+	"github.com/linode/terraform-provider-linode/v2/linode/helper/customtypes"
+	"github.com/linode/terraform-provider-linode/v2/linode/obj"
+)
+
+// ResourceModel describes the Terraform resource data model to match the
+// resource schema.
+type ResourceModel struct {
+	ID        types.String                `tfsdk:"id"`
+	Bucket    types.String                `tfsdk:"bucket"`
+	Region    types.String                `tfsdk:"region"`
+	Policy    customtypes.JSONStringValue `tfsdk:"policy"`
+	AccessKey types.String                `tfsdk:"access_key"`
+	SecretKey types.String                `tfsdk:"secret_key"`
+	Endpoint  types.String                `tfsdk:"endpoint"`
+}
+
+func (data *ResourceModel) GenerateID() {
+	data.ID = types.StringValue(fmt.Sprintf("%s:%s", data.Region.ValueString(), data.Bucket.ValueString()))
+}
+
+func (data *ResourceModel) FlattenBucketPolicy(policy *string, preserveKnown bool) {
+	data.Policy = helper.KeepOrUpdateValue(
+		data.Policy,
+		customtypes.JSONValue(helper.StringValue(policy)),
+		preserveKnown,
+	)
+
+	data.GenerateID()
+}
+
+func (data *ResourceModel) ComputeEndpointIfUnknown(
+	ctx context.Context,
+	client *linodego.Client,
+	diags *diag.Diagnostics,
+) {
+	data.Endpoint = obj.FwComputeEndpointIfUnknown(
+		ctx, client, data.Endpoint, data.Bucket.ValueString(), data.Region.ValueString(), diags,
+	)
+}
+
+func (data ResourceModel) getS3Client(
+	ctx context.Context,
+	client *linodego.Client,
+	config *helper.FrameworkProviderModel,
+	permission string,
+	diags *diag.Diagnostics,
+) (*s3.Client, func()) {
+	return obj.FwGetS3Client(
+		ctx, client, config,
+		data.AccessKey, data.SecretKey,
+		data.Bucket.ValueString(), data.Region.ValueString(), data.Endpoint.ValueString(),
+		permission, diags,
+	)
+}
@@ -0,0 +1,40 @@
+//go:build unit
+
+package objbucketpolicy
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/linode/terraform-provider-linode/v2/linode/helper/customtypes"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestFlattenBucketPolicy(t *testing.T) {
+	policy := `{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Action":"s3:GetObject"}]}`
+
+	data := ResourceModel{
+		Bucket: types.StringValue("my-bucket"),
+		Region: types.StringValue("us-mia"),
+	}
+
+	data.FlattenBucketPolicy(&policy, false)
+
+	assert.Equal(t, types.StringValue("us-mia:my-bucket"), data.ID)
+	assert.Equal(t, customtypes.JSONValue(policy), data.Policy)
+}
+
+func TestFlattenBucketPolicyPreserveKnown(t *testing.T) {
+	policy := `{"Version":"2012-10-17"}`
+	configured := customtypes.JSONValue("{\n  \"Version\": \"2012-10-17\"\n}")
+
+	data := ResourceModel{
+		Bucket: types.StringValue("my-bucket"),
+		Region: types.StringValue("us-mia"),
+		Policy: configured,
+	}
+
+	data.FlattenBucketPolicy(&policy, true)
+
+	assert.Equal(t, configured, data.Policy)
+}
@@ -0,0 +1,266 @@
+package objbucketpolicy
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/service/s3"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/linode/terraform-provider-linode/v2/linode/helper"
+	"github.com/linode/terraform-provider-linode/v2/linode/obj"
+)
+
+func NewResource() resource.Resource {
+	return &Resource{
+		BaseResource: helper.NewBaseResource(
+			helper.BaseResourceConfig{
+				Name:   "linode_object_storage_bucket_policy",
+				IDType: types.StringType,
+				Schema: &frameworkResourceSchema,
+			},
+		),
+	}
+}
+
+type Resource struct {
+	helper.BaseResource
+}
+
+func (r *Resource) Create(
+	ctx context.Context,
+	req resource.CreateRequest,
+	resp *resource.CreateResponse,
+) {
+	tflog.Debug(ctx, "Create "+r.Config.Name)
+
+	var plan ResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx = populateLogAttributes(ctx, plan)
+
+	plan.ComputeEndpointIfUnknown(ctx, r.Meta.Client, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	s3client, teardownKeys := plan.getS3Client(
+		ctx, r.Meta.Client, r.Meta.Config, obj.READ_WRITE_PERMISSION, &resp.Diagnostics,
+	)
+	if teardownKeys != nil {
+		defer teardownKeys()
+	}
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	putBucketPolicy(ctx, plan, s3client, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	plan.GenerateID()
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *Resource) Read(
+	ctx context.Context,
+	req resource.ReadRequest,
+	resp *resource.ReadResponse,
+) {
+	tflog.Debug(ctx, "Read "+r.Config.Name)
+
+	var state ResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx = populateLogAttributes(ctx, state)
+
+	state.ComputeEndpointIfUnknown(ctx, r.Meta.Client, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		if newDiags := obj.DeleteBucketNotFound(resp.Diagnostics); len(newDiags) < len(resp.Diagnostics) {
+			resp.Diagnostics = newDiags
+			resp.Diagnostics.AddWarning(
+				"The Bucket No Longer Exists",
+				"Removing the bucket policy from state because the bucket no longer exists",
+			)
+			resp.State.RemoveResource(ctx)
+		}
+		return
+	}
+
+	s3client, teardownKeys := state.getS3Client(
+		ctx, r.Meta.Client, r.Meta.Config, obj.READ_PERMISSION, &resp.Diagnostics,
+	)
+	if teardownKeys != nil {
+		defer teardownKeys()
+	}
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Debug(ctx, "s3client.GetBucketPolicy(...)")
+
+	output, err := s3client.GetBucketPolicy(ctx, &s3.GetBucketPolicyInput{
+		Bucket: state.Bucket.ValueStringPointer(),
+	})
+	if err != nil {
+		if isPolicyNotFoundErr(err) {
+			resp.Diagnostics.AddWarning(
+				"The Bucket Policy No Longer Exists",
+				"Removing the bucket policy from state because it no longer exists",
+			)
+			resp.State.RemoveResource(ctx)
+			return
+		}
+
+		resp.Diagnostics.AddError("Failed to Get the Bucket Policy", err.Error())
+		return
+	}
+
+	state.FlattenBucketPolicy(output.Policy, false)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+func (r *Resource) Update(
+	ctx context.Context,
+	req resource.UpdateRequest,
+	resp *resource.UpdateResponse,
+) {
+	tflog.Debug(ctx, "Update "+r.Config.Name)
+
+	var plan, state ResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx = populateLogAttributes(ctx, plan)
+
+	plan.ComputeEndpointIfUnknown(ctx, r.Meta.Client, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !plan.Policy.Equal(state.Policy) {
+		s3client, teardownKeys := plan.getS3Client(
+			ctx, r.Meta.Client, r.Meta.Config, obj.READ_WRITE_PERMISSION, &resp.Diagnostics,
+		)
+		if teardownKeys != nil {
+			defer teardownKeys()
+		}
+		if resp.Diagnostics.HasError() {
+			return
+		}
+
+		putBucketPolicy(ctx, plan, s3client, &resp.Diagnostics)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+
+	plan.GenerateID()
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *Resource) Delete(
+	ctx context.Context,
+	req resource.DeleteRequest,
+	resp *resource.DeleteResponse,
+) {
+	tflog.Debug(ctx, "Delete "+r.Config.Name)
+
+	var state ResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx = populateLogAttributes(ctx, state)
+
+	state.ComputeEndpointIfUnknown(ctx, r.Meta.Client, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		// Nothing to delete if the bucket itself is gone.
+		resp.Diagnostics = obj.DeleteBucketNotFound(resp.Diagnostics)
+		return
+	}
+
+	s3client, teardownKeys := state.getS3Client(
+		ctx, r.Meta.Client, r.Meta.Config, obj.READ_WRITE_PERMISSION, &resp.Diagnostics,
+	)
+	if teardownKeys != nil {
+		defer teardownKeys()
+	}
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Debug(ctx, "s3client.DeleteBucketPolicy(...)")
+
+	_, err := s3client.DeleteBucketPolicy(ctx, &s3.DeleteBucketPolicyInput{
+		Bucket: state.Bucket.ValueStringPointer(),
+	})
+	if err != nil && !isPolicyNotFoundErr(err) {
+		resp.Diagnostics.AddError("Failed to Delete the Bucket Policy", err.Error())
+	}
+}
+
+func (r *Resource) ImportState(
+	ctx context.Context,
+	req resource.ImportStateRequest,
+	resp *resource.ImportStateResponse,
+) {
+	helper.ImportStateWithMultipleIDs(
+		ctx,
+		req,
+		resp,
+		[]helper.ImportableID{
+			{
+				Name:          "region",
+				TypeConverter: helper.IDTypeConverterString,
+			},
+			{
+				Name:          "bucket",
+				TypeConverter: helper.IDTypeConverterString,
+			},
+		},
+	)
+}
+
+func putBucketPolicy(
+	ctx context.Context,
+	data ResourceModel,
+	s3client *s3.Client,
+	diags *diag.Diagnostics,
+) {
+	tflog.Debug(ctx, "s3client.PutBucketPolicy(...)")
+
+	_, err := s3client.PutBucketPolicy(ctx, &s3.PutBucketPolicyInput{
+		Bucket: data.Bucket.ValueStringPointer(),
+		Policy: data.Policy.ValueStringPointer(),
+	})
+	if err != nil {
+		diags.AddError("Failed to Put the Bucket Policy", err.Error())
+	}
+}
+
+func populateLogAttributes(ctx context.Context, data ResourceModel) context.Context {
+	return helper.SetLogFieldBulk(ctx, map[string]any{
+		"bucket": data.Bucket.ValueString(),
+		"region": data.Region.ValueString(),
+	})
+}
@@ -0,0 +1,60 @@
+package objbucketpolicy
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/linode/terraform-provider-linode/v2/linode/helper/customtypes"
+)
+
+var frameworkResourceSchema = schema.Schema{
+	Attributes: map[string]schema.Attribute{
+		"id": schema.StringAttribute{
+			Description: "The ID of the bucket policy, in the format of `region:bucket`.",
+			Computed:    true,
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.UseStateForUnknown(),
+			},
+		},
+		"bucket": schema.StringAttribute{
+			Description: "The label of the bucket to attach the policy to.",
+			Required:    true,
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.RequiresReplace(),
+			},
+		},
+		"region": schema.StringAttribute{
+			Description: "The region of the bucket.",
+			Required:    true,
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.RequiresReplace(),
+			},
+		},
+		"policy": schema.StringAttribute{
+			Description: "The JSON bucket policy document.",
+			Required:    true,
+			CustomType:  customtypes.JSONStringType{},
+		},
+		"access_key": schema.StringAttribute{
+			Description: "The S3 access key with access to the target bucket. " +
+				"If not specified with the resource, you must provide its value by configuring the obj_access_key, " +
+				"or, opting-in generating it implicitly at apply-time using obj_use_temp_keys at provider-level.",
+			Optional: true,
+		},
+		"secret_key": schema.StringAttribute{
+			Description: "The S3 secret key with access to the target bucket. " +
+				"If not specified with the resource, you must provide its value by configuring the obj_secret_key, " +
+				"or, opting-in generating it implicitly at apply-time using obj_use_temp_keys at provider-level.",
+			Optional:  true,
+			Sensitive: true,
+		},
+		"endpoint": schema.StringAttribute{
+			Description: "The endpoint for the bucket used for s3 connections.",
+			Optional:    true,
+			Computed:    true,
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.UseStateForUnknown(),
+			},
+		},
+	},
+}
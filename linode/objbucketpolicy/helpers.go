@@ -0,0 +1,20 @@
+package objbucketpolicy
+
+import (
+	"errors"
+
+	"github.com/aws/smithy-go"
+	"github.com/linode/terraform-provider-linode/v2/linode/helper"
+)
+
+// isPolicyNotFoundErr returns whether the given error indicates that
+// either the bucket or its policy does not exist.
+func isPolicyNotFoundErr(err error) bool {
+	var apiErr smithy.APIError
+	if errors.As(err, &apiErr) &&
+		(apiErr.ErrorCode() == "NoSuchBucketPolicy" || apiErr.ErrorCode() == "NoSuchBucket") {
+		return true
+	}
+
+	return helper.IsObjNotFoundErr(err)
+}
@@ -0,0 +1,63 @@
+//go:build integration || objbucketpolicy
+
+package objbucketpolicy_test
+
+import (
+	"log"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/linode/terraform-provider-linode/v2/linode/acceptance"
+	"github.com/linode/terraform-provider-linode/v2/linode/objbucketpolicy/tmpl"
+)
+
+var testRegion string
+
+func init() {
+	endpoint, err := acceptance.GetRandomObjectStorageEndpoint()
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	testRegion = acceptance.GetEndpointRegion(*endpoint)
+}
+
+func TestAccResourceBucketPolicy_basic(t *testing.T) {
+	t.Parallel()
+
+	resName := "linode_object_storage_bucket_policy.foobar"
+
+	acceptance.RunTestWithRetries(t, 6, func(t *acceptance.WrappedT) {
+		bucketName := acctest.RandomWithPrefix("tf-test")
+		keyName := acctest.RandomWithPrefix("tf_test")
+
+		resource.Test(t, resource.TestCase{
+			PreCheck:                 func() { acceptance.PreCheck(t) },
+			ProtoV5ProviderFactories: acceptance.ProtoV5ProviderFactories,
+			Steps: []resource.TestStep{
+				{
+					Config: tmpl.Basic(t, bucketName, testRegion, keyName, "public"),
+					Check: resource.ComposeTestCheckFunc(
+						resource.TestCheckResourceAttr(resName, "id", testRegion+":"+bucketName),
+						resource.TestCheckResourceAttr(resName, "bucket", bucketName),
+						resource.TestCheckResourceAttrSet(resName, "policy"),
+						resource.TestCheckResourceAttrSet(resName, "endpoint"),
+					),
+				},
+				{
+					// Re-applying the same policy must not produce a diff
+					// even though the API normalizes the document.
+					Config:   tmpl.Basic(t, bucketName, testRegion, keyName, "public"),
+					PlanOnly: true,
+				},
+				{
+					Config: tmpl.Basic(t, bucketName, testRegion, keyName, "shared"),
+					Check: resource.ComposeTestCheckFunc(
+						resource.TestCheckResourceAttr(resName, "id", testRegion+":"+bucketName),
+					),
+				},
+			},
+		})
+	})
+}
@@ -0,0 +1,26 @@
+package tmpl
+
+import (
+	"testing"
+
+	"github.com/linode/terraform-provider-linode/v2/linode/acceptance"
+	objectbucket "github.com/linode/terraform-provider-linode/v2/linode/objbucket/tmpl"
+	objectkey "github.com/linode/terraform-provider-linode/v2/linode/objkey/tmpl"
+)
+
+type TemplateData struct {
+	Bucket objectbucket.TemplateData
+	Key    objectkey.TemplateData
+	Region string
+	Prefix string
+}
+
+func Basic(t testing.TB, name, region, keyName, prefix string) string {
+	return acceptance.ExecuteTemplate(t,
+		"object_bucket_policy_basic", TemplateData{
+			Bucket: objectbucket.TemplateData{Label: name, Region: region},
+			Key:    objectkey.TemplateData{Label: keyName},
+			Region: region,
+			Prefix: prefix,
+		})
+}
@@ -0,0 +1,128 @@
+package objkey
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/linode/linodego"
+	"github.com/linode/terraform-provider-linode/v2/linode/helper"
+)
+
+// privateKeyID is the private data key used to carry the ID of the
+// temporary key from Open to Close.
+const privateKeyID = "key_id"
+
+func NewEphemeralResource() ephemeral.EphemeralResource {
+	return &EphemeralResource{
+		BaseEphemeralResource: helper.NewBaseEphemeralResource(
+			helper.BaseEphemeralResourceConfig{
+				Name:   "linode_object_storage_temp_key",
+				Schema: &frameworkEphemeralResourceSchema,
+			},
+		),
+	}
+}
+
+type EphemeralResource struct {
+	helper.BaseEphemeralResource
+}
+
+var _ ephemeral.EphemeralResourceWithClose = &EphemeralResource{}
+
+func (r *EphemeralResource) Open(
+	ctx context.Context,
+	req ephemeral.OpenRequest,
+	resp *ephemeral.OpenResponse,
+) {
+	tflog.Debug(ctx, "Open ephemeral."+r.Config.Name)
+
+	var data EphemeralResourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	plan := data.toResourceModel()
+
+	validateRegionsAgainstBucketAccesses(ctx, plan, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	createOpts := plan.GetCreateOptions(ctx)
+
+	tflog.Debug(ctx, "client.CreateObjectStorageKey(...)", map[string]any{
+		"options": createOpts,
+	})
+
+	key, err := r.Meta.Client.CreateObjectStorageKey(ctx, createOpts)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Failed to create temporary Object Storage Key",
+			err.Error(),
+		)
+		return
+	}
+
+	ctx = helper.SetLogFieldBulk(ctx, map[string]any{
+		"key_id": key.ID,
+		"label":  key.Label,
+	})
+
+	keyID, err := json.Marshal(key.ID)
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to marshal Object Storage Key ID", err.Error())
+		return
+	}
+
+	resp.Diagnostics.Append(resp.Private.SetKey(ctx, privateKeyID, keyID)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	data.FlattenObjectStorageKey(ctx, key, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.Result.Set(ctx, &data)...)
+}
+
+func (r *EphemeralResource) Close(
+	ctx context.Context,
+	req ephemeral.CloseRequest,
+	resp *ephemeral.CloseResponse,
+) {
+	tflog.Debug(ctx, "Close ephemeral."+r.Config.Name)
+
+	rawKeyID, d := req.Private.GetKey(ctx, privateKeyID)
+	resp.Diagnostics.Append(d...)
+	if resp.Diagnostics.HasError() || rawKeyID == nil {
+		return
+	}
+
+	var keyID int
+	if err := json.Unmarshal(rawKeyID, &keyID); err != nil {
+		resp.Diagnostics.AddError("Failed to unmarshal Object Storage Key ID", err.Error())
+		return
+	}
+
+	ctx = tflog.SetField(ctx, "key_id", keyID)
+
+	tflog.Debug(ctx, "client.DeleteObjectStorageKey(...)")
+
+	if err := r.Meta.Client.DeleteObjectStorageKey(ctx, keyID); err != nil {
+		if lerr, ok := err.(*linodego.Error); ok && lerr.Code == 404 {
+			return
+		}
+
+		resp.Diagnostics.AddError(
+			fmt.Sprintf("Failed to delete temporary Object Storage Key (%d)", keyID),
+			err.Error(),
+		)
+	}
+}
@@ -2,8 +2,10 @@ package objkey
 
 import (
 	"context"
+	"fmt"
 	"slices"
 	"strconv"
+	"time"
 
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/types"
@@ -205,3 +207,45 @@ func (b *BucketAccessModelEntry) toLinodeObject() linodego.ObjectStorageKeyBucke
 
 	return result
 }
+
+// EphemeralResourceModel describes the Terraform data model to match the
+// linode_object_storage_temp_key ephemeral resource schema.
+type EphemeralResourceModel struct {
+	ID        types.String `tfsdk:"id"`
+	Label     types.String `tfsdk:"label"`
+	AccessKey types.String `tfsdk:"access_key"`
+	SecretKey types.String `tfsdk:"secret_key"`
+	Regions   types.Set    `tfsdk:"regions"`
+
+	BucketAccess []BucketAccessModelEntry `tfsdk:"bucket_access"`
+}
+
+func (m EphemeralResourceModel) toResourceModel() ResourceModel {
+	label := m.Label
+	if label.IsNull() || label.IsUnknown() || label.ValueString() == "" {
+		label = types.StringValue(fmt.Sprintf("temp_%v", time.Now().Unix()))
+	}
+
+	return ResourceModel{
+		Label:        label,
+		Regions:      m.Regions,
+		BucketAccess: m.BucketAccess,
+	}
+}
+
+func (m *EphemeralResourceModel) FlattenObjectStorageKey(
+	ctx context.Context,
+	key *linodego.ObjectStorageKey,
+	diags *diag.Diagnostics,
+) {
+	m.ID = types.StringValue(strconv.Itoa(key.ID))
+	m.Label = types.StringValue(key.Label)
+	m.AccessKey = types.StringValue(key.AccessKey)
+	m.SecretKey = types.StringValue(key.SecretKey)
+
+	regions, d := types.SetValueFrom(ctx, types.StringType, getObjectStorageKeyRegionIDsSet(key.Regions))
+	diags.Append(d...)
+	m.Regions = regions
+
+	m.BucketAccess = FlattenBucketAccessEntries(key.BucketAccess, nil, false)
+}
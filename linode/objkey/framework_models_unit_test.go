@@ -72,3 +72,52 @@ func TestFlattenObjectStorageKeyPreserveKnown(t *testing.T) {
 	assert.True(t, expectedID.Equal(rm.ID))
 	assert.True(t, expectedSecretKey.Equal(rm.SecretKey))
 }
+
+func TestFlattenObjectStorageKeyEphemeral(t *testing.T) {
+	key := linodego.ObjectStorageKey{
+		ID:        456,
+		Label:     "temp_1700000000",
+		AccessKey: "KVAKUTGBA4WTR2NSJQ81",
+		SecretKey: "OiA6F5r0niLs3QA2stbyq7mY5VCV7KqOzcmitmHw",
+		Limited:   true,
+		Regions: []linodego.ObjectStorageKeyRegion{
+			{ID: "us-mia"},
+			{ID: "us-mia"},
+		},
+		BucketAccess: &[]linodego.ObjectStorageKeyBucketAccess{
+			{
+				Region:      "us-mia",
+				BucketName:  "example-bucket",
+				Permissions: "read_write",
+			},
+		},
+	}
+
+	data := EphemeralResourceModel{}
+	var diags diag.Diagnostics
+	data.FlattenObjectStorageKey(context.Background(), &key, &diags)
+	assert.False(t, diags.HasError(), "error flattening obj key")
+
+	assert.Equal(t, types.StringValue("456"), data.ID)
+	assert.Equal(t, types.StringValue("temp_1700000000"), data.Label)
+	assert.Equal(t, types.StringValue("KVAKUTGBA4WTR2NSJQ81"), data.AccessKey)
+	assert.Equal(t, types.StringValue("OiA6F5r0niLs3QA2stbyq7mY5VCV7KqOzcmitmHw"), data.SecretKey)
+	assert.Len(t, data.Regions.Elements(), 1)
+
+	assert.Len(t, data.BucketAccess, 1)
+	assert.Equal(t, types.StringValue("us-mia"), data.BucketAccess[0].Region)
+	assert.Equal(t, types.StringValue("read_write"), data.BucketAccess[0].Permissions)
+}
+
+func TestEphemeralResourceModelDefaultLabel(t *testing.T) {
+	data := EphemeralResourceModel{
+		Label:   types.StringNull(),
+		Regions: types.SetNull(types.StringType),
+	}
+
+	rm := data.toResourceModel()
+	assert.Regexp(t, `^temp_\d+$`, rm.Label.ValueString())
+
+	data.Label = types.StringValue("my-temp-key")
+	assert.Equal(t, "my-temp-key", data.toResourceModel().Label.ValueString())
+}
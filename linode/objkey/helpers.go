@@ -4,21 +4,12 @@ import (
 	"context"
 	"fmt"
 	"slices"
-	"strings"
 
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/linode/terraform-provider-linode/v2/linode/helper"
 )
 
-func getRegionFromCluster(cluster string) (string, error) {
-	s := strings.Split(cluster, "-")
-	if len(s) <= 2 {
-		return "", fmt.Errorf("failed to parse cluster %q", cluster)
-	}
-	return strings.Join(s[:2], "-"), nil
-}
-
 func validateRegionsAgainstBucketAccesses(ctx context.Context, plan ResourceModel, diags *diag.Diagnostics) {
 	// regions will be computed if not configured, so it's okay to be null or unknown.
 	if plan.BucketAccess == nil || plan.Regions.IsNull() || plan.Regions.IsUnknown() {
@@ -35,7 +26,7 @@ func validateRegionsAgainstBucketAccesses(ctx context.Context, plan ResourceMode
 		var err error
 
 		if ba.Region.IsNull() || ba.Region.IsUnknown() {
-			bucketRegion, err = getRegionFromCluster(ba.Cluster.ValueString())
+			bucketRegion, err = helper.GetRegionFromCluster(ba.Cluster.ValueString())
 			if err != nil {
 				diags.AddWarning("Failed to Parse Cluster", err.Error())
 				continue
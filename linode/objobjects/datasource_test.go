@@ -0,0 +1,60 @@
+//go:build integration || objobjects
+
+package objobjects_test
+
+import (
+	"log"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/linode/terraform-provider-linode/v2/linode/acceptance"
+	"github.com/linode/terraform-provider-linode/v2/linode/objobjects/tmpl"
+)
+
+var testRegion string
+
+func init() {
+	endpoint, err := acceptance.GetRandomObjectStorageEndpoint()
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	testRegion = acceptance.GetEndpointRegion(*endpoint)
+}
+
+func TestAccDataSourceObjects_basic(t *testing.T) {
+	t.Parallel()
+
+	logsDataName := "data.linode_object_storage_objects.logs"
+	filteredDataName := "data.linode_object_storage_objects.filtered"
+
+	acceptance.RunTestWithRetries(t, 6, func(t *acceptance.WrappedT) {
+		bucketName := acctest.RandomWithPrefix("tf-test")
+		keyName := acctest.RandomWithPrefix("tf_test")
+
+		resource.Test(t, resource.TestCase{
+			PreCheck:                 func() { acceptance.PreCheck(t) },
+			ProtoV5ProviderFactories: acceptance.ProtoV5ProviderFactories,
+			Steps: []resource.TestStep{
+				{
+					Config: tmpl.DataBasic(t, bucketName, testRegion, keyName),
+					Check: resource.ComposeTestCheckFunc(
+						resource.TestCheckResourceAttr(logsDataName, "objects.#", "2"),
+						resource.TestCheckResourceAttr(logsDataName, "objects.0.key", "logs/db.log"),
+						resource.TestCheckResourceAttr(logsDataName, "objects.1.key", "logs/app.log"),
+						resource.TestCheckResourceAttrSet(logsDataName, "objects.0.size"),
+						resource.TestCheckResourceAttrSet(logsDataName, "objects.0.etag"),
+						resource.TestCheckResourceAttrSet(logsDataName, "objects.0.last_modified"),
+						resource.TestCheckResourceAttrSet(logsDataName, "objects.0.storage_class"),
+						resource.TestCheckResourceAttr(logsDataName, "common_prefixes.#", "1"),
+						resource.TestCheckResourceAttr(logsDataName, "common_prefixes.0", "logs/2025/"),
+
+						resource.TestCheckResourceAttr(filteredDataName, "objects.#", "1"),
+						resource.TestCheckResourceAttr(filteredDataName, "objects.0.key", "index.html"),
+					),
+				},
+			},
+		})
+	})
+}
@@ -0,0 +1,116 @@
+package objobjects
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/linode/linodego"
+	"github.com/linode/terraform-provider-linode/v2/linode/helper"
+	"github.com/linode/terraform-provider-linode/v2/linode/obj"
+)
+
+func NewDataSource() datasource.DataSource {
+	return &DataSource{
+		BaseDataSource: helper.NewBaseDataSource(
+			helper.BaseDataSourceConfig{
+				Name:   "linode_object_storage_objects",
+				Schema: &frameworkDatasourceSchema,
+			},
+		),
+	}
+}
+
+type DataSource struct {
+	helper.BaseDataSource
+}
+
+func (d *DataSource) Read(
+	ctx context.Context,
+	req datasource.ReadRequest,
+	resp *datasource.ReadResponse,
+) {
+	tflog.Debug(ctx, "Read data."+d.Config.Name)
+
+	var data ObjectFilterModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx = helper.SetLogFieldBulk(ctx, map[string]any{
+		"bucket": data.Bucket.ValueString(),
+		"region": data.Region.ValueString(),
+	})
+
+	id, diag := filterConfig.GenerateID(data.Filters)
+	if diag != nil {
+		resp.Diagnostics.Append(diag)
+		return
+	}
+	data.ID = id
+
+	data.ComputeEndpointIfUnknown(ctx, d.Meta.Client, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	s3client, teardownKeys := obj.FwGetS3Client(
+		ctx, d.Meta.Client, d.Meta.Config,
+		data.AccessKey, data.SecretKey,
+		data.Bucket.ValueString(), data.Region.ValueString(), data.Endpoint.ValueString(),
+		obj.READ_PERMISSION, &resp.Diagnostics,
+	)
+	if teardownKeys != nil {
+		defer teardownKeys()
+	}
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var commonPrefixes []string
+
+	listObjects := func(ctx context.Context, _ *linodego.Client, _ string) ([]any, error) {
+		tflog.Trace(ctx, "s3client.ListObjectsV2(...)", map[string]any{
+			"prefix":    data.Prefix.ValueString(),
+			"delimiter": data.Delimiter.ValueString(),
+		})
+
+		objects, prefixes, err := helper.ListAllObjectsV2(
+			ctx, s3client, data.Bucket.ValueString(),
+			data.Prefix.ValueString(), data.Delimiter.ValueString(),
+		)
+		if err != nil {
+			return nil, err
+		}
+
+		commonPrefixes = prefixes
+
+		result := make([]objectEntry, len(objects))
+		for i, object := range objects {
+			result[i] = newObjectEntry(object)
+		}
+
+		return helper.TypedSliceToAny(result), nil
+	}
+
+	result, diag := filterConfig.GetAndFilter(
+		ctx, d.Meta.Client, data.Filters, listObjects,
+		data.Order, data.OrderBy)
+	if diag != nil {
+		resp.Diagnostics.Append(diag)
+		return
+	}
+
+	objects := helper.AnySliceToTyped[objectEntry](result)
+	sortObjects(objects, data.OrderBy.ValueString(), data.Order.ValueString())
+
+	data.parseObjects(objects)
+	data.parseCommonPrefixes(commonPrefixes, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
@@ -0,0 +1,96 @@
+package objobjects
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/linode/terraform-provider-linode/v2/linode/helper/frameworkfilter"
+)
+
+var filterConfig = frameworkfilter.Config{
+	"key":           {APIFilterable: false, TypeFunc: frameworkfilter.FilterTypeString, AllowOrderOverride: true},
+	"size":          {APIFilterable: false, TypeFunc: frameworkfilter.FilterTypeInt, AllowOrderOverride: true},
+	"etag":          {APIFilterable: false, TypeFunc: frameworkfilter.FilterTypeString},
+	"last_modified": {APIFilterable: false, TypeFunc: frameworkfilter.FilterTypeString, AllowOrderOverride: true},
+	"storage_class": {APIFilterable: false, TypeFunc: frameworkfilter.FilterTypeString},
+}
+
+var frameworkDatasourceSchema = schema.Schema{
+	Attributes: map[string]schema.Attribute{
+		"id": schema.StringAttribute{
+			Description: "The data source's unique ID.",
+			Computed:    true,
+		},
+		"bucket": schema.StringAttribute{
+			Description: "The label of the bucket to list the objects of.",
+			Required:    true,
+		},
+		"region": schema.StringAttribute{
+			Description: "The region of the bucket.",
+			Required:    true,
+		},
+		"prefix": schema.StringAttribute{
+			Description: "Only list the objects whose keys begin with this prefix.",
+			Optional:    true,
+		},
+		"delimiter": schema.StringAttribute{
+			Description: "The character used to group keys, e.g. `/`. " +
+				"Keys containing the delimiter after the prefix are returned in common_prefixes.",
+			Optional: true,
+		},
+		"common_prefixes": schema.ListAttribute{
+			Description: "The keys rolled up by the delimiter, e.g. the sub-directories of the prefix.",
+			Computed:    true,
+			ElementType: types.StringType,
+		},
+		"access_key": schema.StringAttribute{
+			Description: "The S3 access key with access to the target bucket. " +
+				"If not specified with the data source, you must provide its value by configuring the obj_access_key, " +
+				"or, opting-in generating it implicitly at apply-time using obj_use_temp_keys at provider-level.",
+			Optional: true,
+		},
+		"secret_key": schema.StringAttribute{
+			Description: "The S3 secret key with access to the target bucket. " +
+				"If not specified with the data source, you must provide its value by configuring the obj_secret_key, " +
+				"or, opting-in generating it implicitly at apply-time using obj_use_temp_keys at provider-level.",
+			Optional:  true,
+			Sensitive: true,
+		},
+		"endpoint": schema.StringAttribute{
+			Description: "The endpoint for the bucket used for s3 connections.",
+			Optional:    true,
+			Computed:    true,
+		},
+		"order":    filterConfig.OrderSchema(),
+		"order_by": filterConfig.OrderBySchema(),
+	},
+	Blocks: map[string]schema.Block{
+		"filter": filterConfig.Schema(),
+		"objects": schema.ListNestedBlock{
+			Description: "The returned list of objects.",
+			NestedObject: schema.NestedBlockObject{
+				Attributes: map[string]schema.Attribute{
+					"key": schema.StringAttribute{
+						Description: "The key of the object.",
+						Computed:    true,
+					},
+					"size": schema.Int64Attribute{
+						Description: "The size of the object in bytes.",
+						Computed:    true,
+					},
+					"etag": schema.StringAttribute{
+						Description: "The entity tag of the object.",
+						Computed:    true,
+					},
+					"last_modified": schema.StringAttribute{
+						Description: "When the object was last modified.",
+						Computed:    true,
+					},
+					"storage_class": schema.StringAttribute{
+						Description: "The storage class of the object.",
+						Computed:    true,
+					},
+				},
+			},
+		},
+	},
+}
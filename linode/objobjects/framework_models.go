@@ -0,0 +1,127 @@
+package objobjects
+
+import (
+	"context"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	s3types "github.com/aws/aws-sdk-go-v2/service/s3/types"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/linode/linodego"
+	"github.com/linode/terraform-provider-linode/v2/linode/helper"
+	"github.com/linode/terraform-provider-linode/v2/linode/helper/frameworkfilter"
+	"github.com/linode/terraform-provider-linode/v2/linode/obj"
+)
+
+// ObjectFilterModel describes the Terraform data source data model to match the
+// data source schema.
+type ObjectFilterModel struct {
+	ID             types.String                     `tfsdk:"id"`
+	Bucket         types.String                     `tfsdk:"bucket"`
+	Region         types.String                     `tfsdk:"region"`
+	Prefix         types.String                     `tfsdk:"prefix"`
+	Delimiter      types.String                     `tfsdk:"delimiter"`
+	CommonPrefixes types.List                       `tfsdk:"common_prefixes"`
+	AccessKey      types.String                     `tfsdk:"access_key"`
+	SecretKey      types.String                     `tfsdk:"secret_key"`
+	Endpoint       types.String                     `tfsdk:"endpoint"`
+	Filters        frameworkfilter.FiltersModelType `tfsdk:"filter"`
+	Order          types.String                     `tfsdk:"order"`
+	OrderBy        types.String                     `tfsdk:"order_by"`
+	Objects        []ObjectModel                    `tfsdk:"objects"`
+}
+
+type ObjectModel struct {
+	Key          types.String `tfsdk:"key"`
+	Size         types.Int64  `tfsdk:"size"`
+	ETag         types.String `tfsdk:"etag"`
+	LastModified types.String `tfsdk:"last_modified"`
+	StorageClass types.String `tfsdk:"storage_class"`
+}
+
+// objectEntry is a flattened S3 object whose JSON tags match the
+// filterable attributes.
+type objectEntry struct {
+	Key          string     `json:"key"`
+	Size         int64      `json:"size"`
+	ETag         string     `json:"etag"`
+	LastModified *time.Time `json:"last_modified"`
+	StorageClass string     `json:"storage_class"`
+}
+
+func newObjectEntry(object s3types.Object) objectEntry {
+	return objectEntry{
+		Key:          aws.ToString(object.Key),
+		Size:         aws.ToInt64(object.Size),
+		ETag:         strings.Trim(aws.ToString(object.ETag), `"`),
+		LastModified: object.LastModified,
+		StorageClass: string(object.StorageClass),
+	}
+}
+
+func (data *ObjectModel) parseObject(object objectEntry) {
+	data.Key = types.StringValue(object.Key)
+	data.Size = types.Int64Value(object.Size)
+	data.ETag = types.StringValue(object.ETag)
+	data.StorageClass = types.StringValue(object.StorageClass)
+
+	data.LastModified = types.StringNull()
+	if object.LastModified != nil {
+		data.LastModified = types.StringValue(object.LastModified.UTC().Format(helper.TIME_FORMAT))
+	}
+}
+
+func (model *ObjectFilterModel) parseObjects(objects []objectEntry) {
+	result := make([]ObjectModel, len(objects))
+	for i, object := range objects {
+		result[i].parseObject(object)
+	}
+
+	model.Objects = result
+}
+
+func (model *ObjectFilterModel) parseCommonPrefixes(prefixes []string, diags *diag.Diagnostics) {
+	commonPrefixes, d := types.ListValueFrom(context.Background(), types.StringType, prefixes)
+	diags.Append(d...)
+
+	model.CommonPrefixes = commonPrefixes
+}
+
+func (model *ObjectFilterModel) ComputeEndpointIfUnknown(
+	ctx context.Context,
+	client *linodego.Client,
+	diags *diag.Diagnostics,
+) {
+	model.Endpoint = obj.FwComputeEndpointIfUnknown(
+		ctx, client, model.Endpoint, model.Bucket.ValueString(), model.Region.ValueString(), diags,
+	)
+}
+
+// sortObjects orders the objects on the client, since S3 always returns
+// keys in ascending order.
+func sortObjects(objects []objectEntry, orderBy, order string) {
+	if orderBy == "" {
+		return
+	}
+
+	less := func(i, j int) bool {
+		switch orderBy {
+		case "size":
+			return objects[i].Size < objects[j].Size
+		case "last_modified":
+			return aws.ToTime(objects[i].LastModified).Before(aws.ToTime(objects[j].LastModified))
+		default:
+			return objects[i].Key < objects[j].Key
+		}
+	}
+
+	if strings.EqualFold(order, "desc") {
+		sort.SliceStable(objects, func(i, j int) bool { return less(j, i) })
+		return
+	}
+
+	sort.SliceStable(objects, less)
+}
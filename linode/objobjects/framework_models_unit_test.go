@@ -0,0 +1,62 @@
+//go:build unit
+
+package objobjects
+
+import (
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	s3types "github.com/aws/aws-sdk-go-v2/service/s3/types"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseObjects(t *testing.T) {
+	lastModified := time.Date(2025, 1, 2, 3, 4, 5, 0, time.UTC)
+
+	entry := newObjectEntry(s3types.Object{
+		Key:          aws.String("logs/app.log"),
+		Size:         aws.Int64(42),
+		ETag:         aws.String(`"5d41402abc4b2a76b9719d911017c592"`),
+		LastModified: &lastModified,
+		StorageClass: s3types.ObjectStorageClassStandard,
+	})
+
+	var model ObjectFilterModel
+	model.parseObjects([]objectEntry{entry})
+
+	assert.Len(t, model.Objects, 1)
+	assert.Equal(t, "logs/app.log", model.Objects[0].Key.ValueString())
+	assert.Equal(t, int64(42), model.Objects[0].Size.ValueInt64())
+	assert.Equal(t, "5d41402abc4b2a76b9719d911017c592", model.Objects[0].ETag.ValueString())
+	assert.Equal(t, "2025-01-02T03:04:05Z", model.Objects[0].LastModified.ValueString())
+	assert.Equal(t, "STANDARD", model.Objects[0].StorageClass.ValueString())
+}
+
+func TestSortObjects(t *testing.T) {
+	older := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	newer := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	objects := []objectEntry{
+		{Key: "b", Size: 1, LastModified: &newer},
+		{Key: "a", Size: 3, LastModified: &older},
+		{Key: "c", Size: 2, LastModified: &newer},
+	}
+
+	keys := func() []string {
+		result := make([]string, len(objects))
+		for i, object := range objects {
+			result[i] = object.Key
+		}
+		return result
+	}
+
+	sortObjects(objects, "size", "desc")
+	assert.Equal(t, []string{"a", "c", "b"}, keys())
+
+	sortObjects(objects, "key", "asc")
+	assert.Equal(t, []string{"a", "b", "c"}, keys())
+
+	sortObjects(objects, "last_modified", "")
+	assert.Equal(t, []string{"a", "b", "c"}, keys())
+}
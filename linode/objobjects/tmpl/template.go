@@ -0,0 +1,24 @@
+package tmpl
+
+import (
+	"testing"
+
+	"github.com/linode/terraform-provider-linode/v2/linode/acceptance"
+	objectbucket "github.com/linode/terraform-provider-linode/v2/linode/objbucket/tmpl"
+	objectkey "github.com/linode/terraform-provider-linode/v2/linode/objkey/tmpl"
+)
+
+type TemplateData struct {
+	Bucket objectbucket.TemplateData
+	Key    objectkey.TemplateData
+	Region string
+}
+
+func DataBasic(t testing.TB, name, region, keyName string) string {
+	return acceptance.ExecuteTemplate(t,
+		"object_objects_data_basic", TemplateData{
+			Bucket: objectbucket.TemplateData{Label: name, Region: region},
+			Key:    objectkey.TemplateData{Label: keyName},
+			Region: region,
+		})
+}
@@ -0,0 +1,138 @@
+package objsync
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/aws/aws-sdk-go-v2/service/s3"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/linode/linodego"
+	"github.com/linode/terraform-provider-linode/v2/linode/helper"
+	"github.com/linode/terraform-provider-linode/v2/linode/obj"
+)
+
+// ResourceModel describes the Terraform resource data model to match the
+// resource schema.
+type ResourceModel struct {
+	ID            types.String `tfsdk:"id"`
+	Bucket        types.String `tfsdk:"bucket"`
+	Region        types.String `tfsdk:"region"`
+	SourceDir     types.String `tfsdk:"source_dir"`
+	KeyPrefix     types.String `tfsdk:"key_prefix"`
+	Include       types.List   `tfsdk:"include"`
+	Exclude       types.List   `tfsdk:"exclude"`
+	ACL           types.String `tfsdk:"acl"`
+	CacheControl  types.String `tfsdk:"cache_control"`
+	DeleteRemoved types.Bool   `tfsdk:"delete_removed"`
+	Files         types.Map    `tfsdk:"files"`
+	AccessKey     types.String `tfsdk:"access_key"`
+	SecretKey     types.String `tfsdk:"secret_key"`
+	Endpoint      types.String `tfsdk:"endpoint"`
+}
+
+func (data *ResourceModel) GenerateID() {
+	data.ID = types.StringValue(fmt.Sprintf("%s/%s", data.Bucket.ValueString(), data.KeyPrefix.ValueString()))
+}
+
+// GetFilter builds the file filter from the include and exclude patterns.
+func (data ResourceModel) GetFilter(ctx context.Context, diags *diag.Diagnostics) *fileFilter {
+	var include, exclude []string
+
+	diags.Append(data.Include.ElementsAs(ctx, &include, false)...)
+	diags.Append(data.Exclude.ElementsAs(ctx, &exclude, false)...)
+	if diags.HasError() {
+		return nil
+	}
+
+	filter, err := newFileFilter(include, exclude)
+	if err != nil {
+		diags.AddError("Invalid Glob Pattern", err.Error())
+		return nil
+	}
+
+	return filter
+}
+
+// GetFiles returns the file hashes of the model keyed by object key.
+func (data ResourceModel) GetFiles(ctx context.Context, diags *diag.Diagnostics) map[string]string {
+	files := make(map[string]string)
+
+	if data.Files.IsNull() || data.Files.IsUnknown() {
+		return files
+	}
+
+	diags.Append(data.Files.ElementsAs(ctx, &files, false)...)
+	return files
+}
+
+func (data *ResourceModel) FlattenFiles(files map[string]string, diags *diag.Diagnostics) {
+	filesValue, d := types.MapValueFrom(context.Background(), types.StringType, files)
+	diags.Append(d...)
+	if diags.HasError() {
+		return
+	}
+
+	data.Files = filesValue
+}
+
+// FlattenRemoteObjects updates the tracked files from the objects found in
+// the bucket, so that changes made outside of Terraform are detected.
+func (data *ResourceModel) FlattenRemoteObjects(
+	ctx context.Context,
+	remote map[string]string,
+	diags *diag.Diagnostics,
+) {
+	current := data.GetFiles(ctx, diags)
+	filter := data.GetFilter(ctx, diags)
+	if diags.HasError() {
+		return
+	}
+
+	files := make(map[string]string, len(current))
+
+	for key := range current {
+		// Files missing from the bucket are dropped so they are uploaded again
+		if etag, ok := remote[key]; ok {
+			files[key] = etag
+		}
+	}
+
+	if data.DeleteRemoved.ValueBool() {
+		prefix := data.KeyPrefix.ValueString()
+
+		// Track unmanaged keys so they are planned for deletion
+		for key, etag := range remote {
+			if _, ok := files[key]; !ok && filter.Match(key[len(prefix):]) {
+				files[key] = etag
+			}
+		}
+	}
+
+	data.FlattenFiles(files, diags)
+}
+
+func (data *ResourceModel) ComputeEndpointIfUnknown(
+	ctx context.Context,
+	client *linodego.Client,
+	diags *diag.Diagnostics,
+) {
+	data.Endpoint = obj.FwComputeEndpointIfUnknown(
+		ctx, client, data.Endpoint, data.Bucket.ValueString(), data.Region.ValueString(), diags,
+	)
+}
+
+func (data ResourceModel) getS3Client(
+	ctx context.Context,
+	client *linodego.Client,
+	config *helper.FrameworkProviderModel,
+	permission string,
+	diags *diag.Diagnostics,
+) (*s3.Client, func()) {
+	return obj.FwGetS3Client(
+		ctx, client, config,
+		data.AccessKey, data.SecretKey,
+		data.Bucket.ValueString(), data.Region.ValueString(), data.Endpoint.ValueString(),
+		permission, diags,
+	)
+}
@@ -0,0 +1,356 @@
+package objsync
+
+import (
+	"context"
+	"slices"
+
+	"github.com/aws/aws-sdk-go-v2/service/s3"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/linode/terraform-provider-linode/v2/linode/helper"
+	"github.com/linode/terraform-provider-linode/v2/linode/obj"
+)
+
+func NewResource() resource.Resource {
+	return &Resource{
+		BaseResource: helper.NewBaseResource(
+			helper.BaseResourceConfig{
+				Name:   "linode_object_storage_sync",
+				IDType: types.StringType,
+				Schema: &frameworkResourceSchema,
+			},
+		),
+	}
+}
+
+type Resource struct {
+	helper.BaseResource
+}
+
+func (r *Resource) ModifyPlan(
+	ctx context.Context,
+	req resource.ModifyPlanRequest,
+	resp *resource.ModifyPlanResponse,
+) {
+	// Nothing to compute when the resource is being destroyed
+	if req.Plan.Raw.IsNull() {
+		return
+	}
+
+	var plan ResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if plan.SourceDir.IsUnknown() || plan.KeyPrefix.IsUnknown() ||
+		plan.Include.IsUnknown() || plan.Exclude.IsUnknown() {
+		resp.Diagnostics.Append(
+			resp.Plan.SetAttribute(ctx, path.Root("files"), types.MapUnknown(types.StringType))...,
+		)
+		return
+	}
+
+	filter := plan.GetFilter(ctx, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	files, err := scanDirectory(plan.SourceDir.ValueString(), plan.KeyPrefix.ValueString(), filter)
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("source_dir"),
+			"Failed to Scan the Source Directory",
+			err.Error(),
+		)
+		return
+	}
+
+	if !req.State.Raw.IsNull() {
+		var state ResourceModel
+
+		resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+
+		toUpload, removed := diffFiles(files, state.GetFiles(ctx, &resp.Diagnostics))
+		if len(toUpload) > 0 || len(removed) > 0 {
+			resp.Diagnostics.AddWarning(
+				"Object Storage Sync Changes",
+				planSummary(toUpload, removed, len(files)-len(toUpload), plan.DeleteRemoved.ValueBool()),
+			)
+		}
+	}
+
+	plan.FlattenFiles(files, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("files"), plan.Files)...)
+}
+
+func (r *Resource) Create(
+	ctx context.Context,
+	req resource.CreateRequest,
+	resp *resource.CreateResponse,
+) {
+	tflog.Debug(ctx, "Create "+r.Config.Name)
+
+	var plan ResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx = populateLogAttributes(ctx, plan)
+
+	plan.ComputeEndpointIfUnknown(ctx, r.Meta.Client, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	s3client, teardownKeys := plan.getS3Client(
+		ctx, r.Meta.Client, r.Meta.Config, obj.READ_WRITE_PERMISSION, &resp.Diagnostics,
+	)
+	if teardownKeys != nil {
+		defer teardownKeys()
+	}
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	files := plan.GetFiles(ctx, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var removed []string
+
+	if plan.DeleteRemoved.ValueBool() {
+		remote, err := listRemoteObjects(ctx, s3client, plan.Bucket.ValueString(), plan.KeyPrefix.ValueString())
+		if err != nil {
+			resp.Diagnostics.AddError("Failed to List the Objects", err.Error())
+			return
+		}
+
+		tracked := plan
+		tracked.Files = types.MapValueMust(types.StringType, nil)
+		tracked.FlattenRemoteObjects(ctx, remote, &resp.Diagnostics)
+
+		_, removed = diffFiles(files, tracked.GetFiles(ctx, &resp.Diagnostics))
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+
+	toUpload, _ := diffFiles(files, nil)
+
+	syncFiles(ctx, plan, s3client, files, toUpload, removed, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	plan.GenerateID()
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *Resource) Read(
+	ctx context.Context,
+	req resource.ReadRequest,
+	resp *resource.ReadResponse,
+) {
+	tflog.Debug(ctx, "Read "+r.Config.Name)
+
+	var state ResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx = populateLogAttributes(ctx, state)
+
+	state.ComputeEndpointIfUnknown(ctx, r.Meta.Client, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		if newDiags := obj.DeleteBucketNotFound(resp.Diagnostics); len(newDiags) < len(resp.Diagnostics) {
+			resp.Diagnostics = newDiags
+			resp.Diagnostics.AddWarning(
+				"The Bucket No Longer Exists",
+				"Removing the sync from state because the bucket no longer exists",
+			)
+			resp.State.RemoveResource(ctx)
+		}
+		return
+	}
+
+	s3client, teardownKeys := state.getS3Client(
+		ctx, r.Meta.Client, r.Meta.Config, obj.READ_PERMISSION, &resp.Diagnostics,
+	)
+	if teardownKeys != nil {
+		defer teardownKeys()
+	}
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	remote, err := listRemoteObjects(ctx, s3client, state.Bucket.ValueString(), state.KeyPrefix.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to List the Objects", err.Error())
+		return
+	}
+
+	state.FlattenRemoteObjects(ctx, remote, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+func (r *Resource) Update(
+	ctx context.Context,
+	req resource.UpdateRequest,
+	resp *resource.UpdateResponse,
+) {
+	tflog.Debug(ctx, "Update "+r.Config.Name)
+
+	var plan, state ResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx = populateLogAttributes(ctx, plan)
+
+	plan.ComputeEndpointIfUnknown(ctx, r.Meta.Client, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	files := plan.GetFiles(ctx, &resp.Diagnostics)
+	current := state.GetFiles(ctx, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	toUpload, removed := diffFiles(files, current)
+
+	// Object settings changed, so every file has to be uploaded again
+	if !plan.ACL.Equal(state.ACL) || !plan.CacheControl.Equal(state.CacheControl) {
+		toUpload, _ = diffFiles(files, nil)
+	}
+
+	if !plan.DeleteRemoved.ValueBool() {
+		removed = nil
+	}
+
+	if len(toUpload) > 0 || len(removed) > 0 {
+		s3client, teardownKeys := plan.getS3Client(
+			ctx, r.Meta.Client, r.Meta.Config, obj.READ_WRITE_PERMISSION, &resp.Diagnostics,
+		)
+		if teardownKeys != nil {
+			defer teardownKeys()
+		}
+		if resp.Diagnostics.HasError() {
+			return
+		}
+
+		syncFiles(ctx, plan, s3client, files, toUpload, removed, &resp.Diagnostics)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+
+	plan.GenerateID()
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *Resource) Delete(
+	ctx context.Context,
+	req resource.DeleteRequest,
+	resp *resource.DeleteResponse,
+) {
+	tflog.Debug(ctx, "Delete "+r.Config.Name)
+
+	var state ResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx = populateLogAttributes(ctx, state)
+
+	state.ComputeEndpointIfUnknown(ctx, r.Meta.Client, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		// Nothing to delete if the bucket itself is gone.
+		resp.Diagnostics = obj.DeleteBucketNotFound(resp.Diagnostics)
+		return
+	}
+
+	s3client, teardownKeys := state.getS3Client(
+		ctx, r.Meta.Client, r.Meta.Config, obj.READ_WRITE_PERMISSION, &resp.Diagnostics,
+	)
+	if teardownKeys != nil {
+		defer teardownKeys()
+	}
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	_, keys := diffFiles(nil, state.GetFiles(ctx, &resp.Diagnostics))
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if err := deleteKeys(ctx, s3client, state.Bucket.ValueString(), keys); err != nil {
+		resp.Diagnostics.AddError("Failed to Delete the Synced Objects", err.Error())
+	}
+}
+
+// syncFiles uploads the changed files and deletes the removed keys.
+func syncFiles(
+	ctx context.Context,
+	plan ResourceModel,
+	s3client *s3.Client,
+	files map[string]string,
+	toUpload, removed []string,
+	diags *diag.Diagnostics,
+) {
+	slices.Sort(toUpload)
+	slices.Sort(removed)
+
+	tflog.Info(ctx, "Syncing Object Storage objects", map[string]any{
+		"to_upload": len(toUpload),
+		"to_delete": len(removed),
+	})
+
+	if err := uploadFiles(ctx, s3client, plan, files, toUpload); err != nil {
+		diags.AddError("Failed to Upload the Files", err.Error())
+		return
+	}
+
+	if err := deleteKeys(ctx, s3client, plan.Bucket.ValueString(), removed); err != nil {
+		diags.AddError("Failed to Delete the Removed Objects", err.Error())
+	}
+}
+
+func populateLogAttributes(ctx context.Context, data ResourceModel) context.Context {
+	return helper.SetLogFieldBulk(ctx, map[string]any{
+		"bucket":     data.Bucket.ValueString(),
+		"region":     data.Region.ValueString(),
+		"key_prefix": data.KeyPrefix.ValueString(),
+	})
+}
@@ -0,0 +1,115 @@
+package objsync
+
+import (
+	s3types "github.com/aws/aws-sdk-go-v2/service/s3/types"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/linode/terraform-provider-linode/v2/linode/helper"
+)
+
+var frameworkResourceSchema = schema.Schema{
+	Attributes: map[string]schema.Attribute{
+		"id": schema.StringAttribute{
+			Description: "The ID of the sync, in the format of `bucket/key_prefix`.",
+			Computed:    true,
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.UseStateForUnknown(),
+			},
+		},
+		"bucket": schema.StringAttribute{
+			Description: "The label of the bucket to sync the directory to.",
+			Required:    true,
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.RequiresReplace(),
+			},
+		},
+		"region": schema.StringAttribute{
+			Description: "The region of the bucket.",
+			Required:    true,
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.RequiresReplace(),
+			},
+		},
+		"source_dir": schema.StringAttribute{
+			Description: "The local directory to upload.",
+			Required:    true,
+		},
+		"key_prefix": schema.StringAttribute{
+			Description: "The prefix prepended to the key of every uploaded file, e.g. `site/`.",
+			Optional:    true,
+			Computed:    true,
+			Default:     stringdefault.StaticString(""),
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.RequiresReplace(),
+			},
+		},
+		"include": schema.ListAttribute{
+			Description: "Glob patterns of the files to upload, relative to source_dir. " +
+				"All files are uploaded if not specified.",
+			Optional:    true,
+			ElementType: types.StringType,
+		},
+		"exclude": schema.ListAttribute{
+			Description: "Glob patterns of the files to skip, relative to source_dir.",
+			Optional:    true,
+			ElementType: types.StringType,
+		},
+		"acl": schema.StringAttribute{
+			Description: "The canned ACL applied to the uploaded objects.",
+			Optional:    true,
+			Computed:    true,
+			Default: stringdefault.StaticString(
+				string(s3types.ObjectCannedACLPrivate),
+			),
+			Validators: []validator.String{
+				stringvalidator.OneOf(
+					helper.StringAliasSliceToStringSlice(
+						s3types.ObjectCannedACLPrivate.Values(),
+					)...,
+				),
+			},
+		},
+		"cache_control": schema.StringAttribute{
+			Description: "The cache_control configuration applied to the uploaded objects.",
+			Optional:    true,
+		},
+		"delete_removed": schema.BoolAttribute{
+			Description: "Whether to delete remote keys under key_prefix that don't exist in source_dir.",
+			Optional:    true,
+			Computed:    true,
+			Default:     booldefault.StaticBool(false),
+		},
+		"files": schema.MapAttribute{
+			Description: "The MD5 hashes of the synced files, keyed by object key.",
+			Computed:    true,
+			ElementType: types.StringType,
+		},
+		"access_key": schema.StringAttribute{
+			Description: "The S3 access key with access to the target bucket. " +
+				"If not specified with the resource, you must provide its value by configuring the obj_access_key, " +
+				"or, opting-in generating it implicitly at apply-time using obj_use_temp_keys at provider-level.",
+			Optional: true,
+		},
+		"secret_key": schema.StringAttribute{
+			Description: "The S3 secret key with access to the target bucket. " +
+				"If not specified with the resource, you must provide its value by configuring the obj_secret_key, " +
+				"or, opting-in generating it implicitly at apply-time using obj_use_temp_keys at provider-level.",
+			Optional:  true,
+			Sensitive: true,
+		},
+		"endpoint": schema.StringAttribute{
+			Description: "The endpoint for the bucket used for s3 connections.",
+			Optional:    true,
+			Computed:    true,
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.UseStateForUnknown(),
+			},
+		},
+	},
+}
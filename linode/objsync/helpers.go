@@ -0,0 +1,349 @@
+package objsync
+
+import (
+	"context"
+	"crypto/md5" // #nosec G501 -- MD5 is required to compare with S3 ETags
+	"encoding/base64"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"io/fs"
+	"mime"
+	"os"
+	"path"
+	"path/filepath"
+	"regexp"
+	"strings"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/s3"
+	s3types "github.com/aws/aws-sdk-go-v2/service/s3/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"golang.org/x/sync/errgroup"
+)
+
+const (
+	// maxConcurrentUploads limits the number of objects uploaded at the same time.
+	maxConcurrentUploads = 8
+
+	// maxDeleteObjectsKeys is the maximum number of keys accepted by a DeleteObjects request.
+	maxDeleteObjectsKeys = 1000
+
+	defaultContentType = "application/octet-stream"
+)
+
+// fileFilter decides whether a file should be synced based on include and
+// exclude glob patterns relative to the source directory.
+type fileFilter struct {
+	include []*regexp.Regexp
+	exclude []*regexp.Regexp
+}
+
+func newFileFilter(include, exclude []string) (*fileFilter, error) {
+	var filter fileFilter
+
+	for _, pattern := range include {
+		re, err := globToRegexp(pattern)
+		if err != nil {
+			return nil, err
+		}
+		filter.include = append(filter.include, re)
+	}
+
+	for _, pattern := range exclude {
+		re, err := globToRegexp(pattern)
+		if err != nil {
+			return nil, err
+		}
+		filter.exclude = append(filter.exclude, re)
+	}
+
+	return &filter, nil
+}
+
+// Match returns whether the given slash-separated relative path should be synced.
+func (f fileFilter) Match(relPath string) bool {
+	if len(f.include) > 0 && !matchAny(f.include, relPath) {
+		return false
+	}
+
+	return !matchAny(f.exclude, relPath)
+}
+
+func matchAny(patterns []*regexp.Regexp, relPath string) bool {
+	for _, re := range patterns {
+		if re.MatchString(relPath) {
+			return true
+		}
+	}
+	return false
+}
+
+// globToRegexp converts a glob pattern to a regular expression.
+// `*` and `?` don't match `/`, while `**` matches any number of directories.
+// Patterns without a `/` are matched against the file name in any directory.
+func globToRegexp(pattern string) (*regexp.Regexp, error) {
+	var sb strings.Builder
+
+	sb.WriteString("^")
+	if !strings.Contains(pattern, "/") {
+		sb.WriteString("(?:.*/)?")
+	}
+
+	for i := 0; i < len(pattern); i++ {
+		c := pattern[i]
+
+		switch c {
+		case '*':
+			if i+1 < len(pattern) && pattern[i+1] == '*' {
+				i++
+				// `**/` also matches no directory at all
+				if i+1 < len(pattern) && pattern[i+1] == '/' {
+					i++
+					sb.WriteString("(?:.*/)?")
+				} else {
+					sb.WriteString(".*")
+				}
+			} else {
+				sb.WriteString("[^/]*")
+			}
+		case '?':
+			sb.WriteString("[^/]")
+		default:
+			sb.WriteString(regexp.QuoteMeta(string(c)))
+		}
+	}
+
+	sb.WriteString("$")
+
+	re, err := regexp.Compile(sb.String())
+	if err != nil {
+		return nil, fmt.Errorf("invalid glob pattern %q: %w", pattern, err)
+	}
+
+	return re, nil
+}
+
+// scanDirectory walks the source directory and returns the MD5 hashes of
+// all matching files, keyed by the object key they will be uploaded to.
+func scanDirectory(sourceDir, keyPrefix string, filter *fileFilter) (map[string]string, error) {
+	result := make(map[string]string)
+
+	err := filepath.WalkDir(sourceDir, func(filePath string, entry fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+
+		if !entry.Type().IsRegular() {
+			return nil
+		}
+
+		relPath, err := filepath.Rel(sourceDir, filePath)
+		if err != nil {
+			return err
+		}
+		relPath = filepath.ToSlash(relPath)
+
+		if !filter.Match(relPath) {
+			return nil
+		}
+
+		hash, err := fileMD5(filePath)
+		if err != nil {
+			return err
+		}
+
+		result[keyPrefix+relPath] = hash
+		return nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to scan directory %q: %w", sourceDir, err)
+	}
+
+	return result, nil
+}
+
+func fileMD5(filePath string) (string, error) {
+	file, err := os.Open(filepath.Clean(filePath))
+	if err != nil {
+		return "", err
+	}
+	defer file.Close()
+
+	hash := md5.New() // #nosec G401 -- MD5 is required to compare with S3 ETags
+	if _, err := io.Copy(hash, file); err != nil {
+		return "", err
+	}
+
+	return hex.EncodeToString(hash.Sum(nil)), nil
+}
+
+// inferContentType returns the MIME type of a file based on its extension.
+func inferContentType(key string) string {
+	if contentType := mime.TypeByExtension(path.Ext(key)); contentType != "" {
+		return contentType
+	}
+
+	return defaultContentType
+}
+
+// diffFiles compares the desired files with the current ones and returns
+// the keys to upload and the keys that no longer exist locally.
+func diffFiles(desired, current map[string]string) (toUpload, removed []string) {
+	for key, hash := range desired {
+		if currentHash, ok := current[key]; !ok || currentHash != hash {
+			toUpload = append(toUpload, key)
+		}
+	}
+
+	for key := range current {
+		if _, ok := desired[key]; !ok {
+			removed = append(removed, key)
+		}
+	}
+
+	return toUpload, removed
+}
+
+// planSummary describes the objects a sync will upload and delete.
+func planSummary(toUpload, removed []string, unchanged int, deleteRemoved bool) string {
+	summary := fmt.Sprintf("%d object(s) will be uploaded and %d left unchanged.", len(toUpload), unchanged)
+
+	if len(removed) > 0 {
+		if deleteRemoved {
+			summary += fmt.Sprintf(" %d object(s) will be deleted.", len(removed))
+		} else {
+			summary += fmt.Sprintf(
+				" %d object(s) no longer exist locally and will be kept in the bucket.", len(removed),
+			)
+		}
+	}
+
+	return summary
+}
+
+// listRemoteObjects returns the ETags of all objects under the given prefix,
+// keyed by object key.
+func listRemoteObjects(
+	ctx context.Context,
+	s3client *s3.Client,
+	bucket, prefix string,
+) (map[string]string, error) {
+	result := make(map[string]string)
+
+	paginator := s3.NewListObjectsV2Paginator(s3client, &s3.ListObjectsV2Input{
+		Bucket: &bucket,
+		Prefix: &prefix,
+	})
+
+	for paginator.HasMorePages() {
+		page, err := paginator.NextPage(ctx)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, object := range page.Contents {
+			result[aws.ToString(object.Key)] = strings.Trim(aws.ToString(object.ETag), `"`)
+		}
+	}
+
+	return result, nil
+}
+
+// uploadFiles concurrently uploads the given keys from the source directory.
+func uploadFiles(
+	ctx context.Context,
+	s3client *s3.Client,
+	data ResourceModel,
+	hashes map[string]string,
+	keys []string,
+) error {
+	eg, ctx := errgroup.WithContext(ctx)
+	eg.SetLimit(maxConcurrentUploads)
+
+	for _, key := range keys {
+		eg.Go(func() error {
+			return uploadFile(ctx, s3client, data, key, hashes[key])
+		})
+	}
+
+	return eg.Wait()
+}
+
+func uploadFile(
+	ctx context.Context,
+	s3client *s3.Client,
+	data ResourceModel,
+	key, hash string,
+) error {
+	relPath := strings.TrimPrefix(key, data.KeyPrefix.ValueString())
+	filePath := filepath.Join(data.SourceDir.ValueString(), filepath.FromSlash(relPath))
+
+	file, err := os.Open(filepath.Clean(filePath))
+	if err != nil {
+		return fmt.Errorf("failed to open %q: %w", filePath, err)
+	}
+	defer file.Close()
+
+	sum, err := hex.DecodeString(hash)
+	if err != nil {
+		return fmt.Errorf("invalid hash %q of %q: %w", hash, filePath, err)
+	}
+
+	// S3 rejects the upload if the file changed after the plan was created
+	contentMD5 := base64.StdEncoding.EncodeToString(sum)
+
+	putInput := &s3.PutObjectInput{
+		Bucket:       data.Bucket.ValueStringPointer(),
+		Key:          &key,
+		Body:         file,
+		ACL:          s3types.ObjectCannedACL(data.ACL.ValueString()),
+		CacheControl: data.CacheControl.ValueStringPointer(),
+		ContentMD5:   &contentMD5,
+		ContentType:  aws.String(inferContentType(key)),
+	}
+
+	tflog.Debug(ctx, "client.PutObject(...)", map[string]any{"key": key})
+	if _, err := s3client.PutObject(ctx, putInput); err != nil {
+		return fmt.Errorf("failed to upload %q to %q: %w", filePath, key, err)
+	}
+
+	return nil
+}
+
+// deleteKeys deletes the given keys in batches.
+func deleteKeys(
+	ctx context.Context,
+	s3client *s3.Client,
+	bucket string,
+	keys []string,
+) error {
+	for start := 0; start < len(keys); start += maxDeleteObjectsKeys {
+		end := min(start+maxDeleteObjectsKeys, len(keys))
+
+		objects := make([]s3types.ObjectIdentifier, 0, end-start)
+		for _, key := range keys[start:end] {
+			objects = append(objects, s3types.ObjectIdentifier{Key: aws.String(key)})
+		}
+
+		tflog.Debug(ctx, "client.DeleteObjects(...)", map[string]any{"keys": keys[start:end]})
+
+		output, err := s3client.DeleteObjects(ctx, &s3.DeleteObjectsInput{
+			Bucket: &bucket,
+			Delete: &s3types.Delete{Objects: objects, Quiet: aws.Bool(true)},
+		})
+		if err != nil {
+			return fmt.Errorf("failed to delete objects: %w", err)
+		}
+
+		if len(output.Errors) > 0 {
+			objErr := output.Errors[0]
+			return fmt.Errorf(
+				"failed to delete %d object(s), first error on %q: %s",
+				len(output.Errors), aws.ToString(objErr.Key), aws.ToString(objErr.Message),
+			)
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,80 @@
+//go:build unit
+
+package objsync
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestFileFilter(t *testing.T) {
+	filter, err := newFileFilter([]string{"**/*.html", "assets/**"}, []string{"*.map", "drafts/**"})
+	require.NoError(t, err)
+
+	assert.True(t, filter.Match("index.html"))
+	assert.True(t, filter.Match("blog/post/index.html"))
+	assert.True(t, filter.Match("assets/js/app.js"))
+	assert.False(t, filter.Match("assets/js/app.js.map"))
+	assert.False(t, filter.Match("drafts/index.html"))
+	assert.False(t, filter.Match("README.md"))
+
+	filter, err = newFileFilter(nil, []string{"?.txt"})
+	require.NoError(t, err)
+
+	assert.True(t, filter.Match("ab.txt"))
+	assert.False(t, filter.Match("dir/a.txt"))
+}
+
+func TestScanDirectory(t *testing.T) {
+	dir := t.TempDir()
+
+	require.NoError(t, os.MkdirAll(filepath.Join(dir, "css"), 0o755))
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "index.html"), []byte("hello"), 0o600))
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "css", "site.css"), []byte(""), 0o600))
+	require.NoError(t, os.WriteFile(filepath.Join(dir, ".DS_Store"), []byte("x"), 0o600))
+
+	filter, err := newFileFilter(nil, []string{".DS_Store"})
+	require.NoError(t, err)
+
+	files, err := scanDirectory(dir, "site/", filter)
+	require.NoError(t, err)
+
+	assert.Equal(t, map[string]string{
+		"site/index.html":   "5d41402abc4b2a76b9719d911017c592",
+		"site/css/site.css": "d41d8cd98f00b204e9800998ecf8427e",
+	}, files)
+
+	_, err = scanDirectory(filepath.Join(dir, "missing"), "", filter)
+	assert.Error(t, err)
+}
+
+func TestDiffFiles(t *testing.T) {
+	toUpload, removed := diffFiles(
+		map[string]string{"a": "1", "b": "2", "c": "3"},
+		map[string]string{"a": "1", "b": "0", "d": "4"},
+	)
+
+	assert.ElementsMatch(t, []string{"b", "c"}, toUpload)
+	assert.ElementsMatch(t, []string{"d"}, removed)
+}
+
+func TestPlanSummary(t *testing.T) {
+	assert.Equal(t,
+		"2 object(s) will be uploaded and 1 left unchanged. 1 object(s) will be deleted.",
+		planSummary([]string{"b", "c"}, []string{"d"}, 1, true),
+	)
+	assert.Equal(t,
+		"0 object(s) will be uploaded and 3 left unchanged. 1 object(s) no longer exist locally and will be kept in the bucket.",
+		planSummary(nil, []string{"d"}, 3, false),
+	)
+}
+
+func TestInferContentType(t *testing.T) {
+	assert.Equal(t, "text/html; charset=utf-8", inferContentType("site/index.html"))
+	assert.Equal(t, "image/png", inferContentType("logo.png"))
+	assert.Equal(t, defaultContentType, inferContentType("LICENSE"))
+}
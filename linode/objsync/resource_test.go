@@ -0,0 +1,92 @@
+//go:build integration || objsync
+
+package objsync_test
+
+import (
+	"log"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/linode/terraform-provider-linode/v2/linode/acceptance"
+	"github.com/linode/terraform-provider-linode/v2/linode/objsync/tmpl"
+)
+
+var testRegion string
+
+func init() {
+	endpoint, err := acceptance.GetRandomObjectStorageEndpoint()
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	testRegion = acceptance.GetEndpointRegion(*endpoint)
+}
+
+func writeFile(t testing.TB, dir, name, content string) {
+	t.Helper()
+
+	filePath := filepath.Join(dir, filepath.FromSlash(name))
+	if err := os.MkdirAll(filepath.Dir(filePath), 0o755); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := os.WriteFile(filePath, []byte(content), 0o600); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestAccResourceSync_basic(t *testing.T) {
+	t.Parallel()
+
+	resName := "linode_object_storage_sync.foobar"
+
+	acceptance.RunTestWithRetries(t, 6, func(t *acceptance.WrappedT) {
+		bucketName := acctest.RandomWithPrefix("tf-test")
+		keyName := acctest.RandomWithPrefix("tf_test")
+
+		sourceDir := t.TempDir()
+		writeFile(t, sourceDir, "index.html", "<h1>hello</h1>")
+		writeFile(t, sourceDir, "css/site.css", "body {}")
+		writeFile(t, sourceDir, "scratch.tmp", "ignored")
+
+		resource.Test(t, resource.TestCase{
+			PreCheck:                 func() { acceptance.PreCheck(t) },
+			ProtoV5ProviderFactories: acceptance.ProtoV5ProviderFactories,
+			Steps: []resource.TestStep{
+				{
+					Config: tmpl.Basic(t, bucketName, testRegion, keyName, sourceDir),
+					Check: resource.ComposeTestCheckFunc(
+						resource.TestCheckResourceAttr(resName, "id", bucketName+"/site/"),
+						resource.TestCheckResourceAttr(resName, "files.%", "2"),
+						resource.TestCheckResourceAttr(
+							resName, "files.site/index.html", "a01618fc9b714c0e530f525e1bd6b123",
+						),
+						resource.TestCheckResourceAttrSet(resName, "files.site/css/site.css"),
+						resource.TestCheckNoResourceAttr(resName, "files.site/scratch.tmp"),
+					),
+				},
+				{
+					// Unchanged files must not produce a diff
+					Config:   tmpl.Basic(t, bucketName, testRegion, keyName, sourceDir),
+					PlanOnly: true,
+				},
+				{
+					PreConfig: func() {
+						writeFile(t, sourceDir, "index.html", "<h1>updated</h1>")
+						if err := os.Remove(filepath.Join(sourceDir, "css", "site.css")); err != nil {
+							t.Fatal(err)
+						}
+					},
+					Config: tmpl.Basic(t, bucketName, testRegion, keyName, sourceDir),
+					Check: resource.ComposeTestCheckFunc(
+						resource.TestCheckResourceAttr(resName, "files.%", "1"),
+						resource.TestCheckNoResourceAttr(resName, "files.site/css/site.css"),
+					),
+				},
+			},
+		})
+	})
+}
@@ -19,7 +19,6 @@ import (
 	"github.com/linode/terraform-provider-linode/v2/linode/helper"
 	"github.com/linode/terraform-provider-linode/v2/linode/instance"
 	"github.com/linode/terraform-provider-linode/v2/linode/instanceconfig"
-	"github.com/linode/terraform-provider-linode/v2/linode/lke"
 	"github.com/linode/terraform-provider-linode/v2/linode/objbucket"
 	"github.com/linode/terraform-provider-linode/v2/linode/user"
 )
@@ -137,6 +136,45 @@ func Provider() *schema.Provider {
 				Description: "If true, when deleting a linode_object_storage_bucket any objects " +
 					"and versions will be force deleted.",
 			},
+			"retry": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				Description: "Retry policies applied to Linode API requests, in addition to the built-in ones.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"status_codes": {
+							Type:        schema.TypeList,
+							Required:    true,
+							Description: "The HTTP status codes of the responses to retry.",
+							MinItems:    1,
+							Elem: &schema.Schema{
+								Type:         schema.TypeInt,
+								ValidateFunc: validation.IntBetween(100, 599),
+							},
+						},
+						"path_pattern": {
+							Type:     schema.TypeString,
+							Optional: true,
+							Description: "A regular expression matched against the path of the request URL. " +
+								"Matches all requests if unset.",
+							ValidateFunc: validation.StringIsValidRegExp,
+						},
+						"max_attempts": {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							Description:  "The maximum number of attempts for a matching request, including the first one.",
+							ValidateFunc: validation.IntAtLeast(1),
+						},
+						"backoff_ms": {
+							Type:     schema.TypeInt,
+							Optional: true,
+							Description: "The delay in milliseconds between attempts. Uses the exponential backoff " +
+								"bounded by min_retry_delay_ms and max_retry_delay_ms if unset.",
+							ValidateFunc: validation.IntAtLeast(0),
+						},
+					},
+				},
+			},
 		},
 
 		DataSourcesMap: map[string]*schema.Resource{
@@ -150,9 +188,7 @@ func Provider() *schema.Provider {
 			"linode_database_postgresql":      databasepostgresql.Resource(),
 			"linode_domain":                   domain.Resource(),
 			"linode_domain_record":            domainrecord.Resource(),
-			"linode_instance":                 instance.Resource(),
 			"linode_instance_config":          instanceconfig.Resource(),
-			"linode_lke_cluster":              lke.Resource(),
 			"linode_object_storage_bucket":    objbucket.Resource(),
 			"linode_user":                     user.Resource(),
 		},
@@ -272,6 +308,8 @@ func providerConfigure(
 
 		ObjUseTempKeys:       d.Get("obj_use_temp_keys").(bool),
 		ObjBucketForceDelete: d.Get("obj_bucket_force_delete").(bool),
+
+		Retry: expandRetryConfigs(d.Get("retry").([]any)),
 	}
 
 	handleDefault(config, d)
@@ -291,3 +329,23 @@ func providerConfigure(
 		Config: config,
 	}, nil
 }
+
+func expandRetryConfigs(retries []any) []helper.RetryConfig {
+	result := make([]helper.RetryConfig, 0, len(retries))
+
+	for _, retry := range retries {
+		retryMap, ok := retry.(map[string]any)
+		if !ok {
+			continue
+		}
+
+		result = append(result, helper.RetryConfig{
+			StatusCodes:         helper.ExpandIntList(retryMap["status_codes"].([]any)),
+			PathPattern:         retryMap["path_pattern"].(string),
+			MaxAttempts:         retryMap["max_attempts"].(int),
+			BackoffMilliseconds: retryMap["backoff_ms"].(int),
+		})
+	}
+
+	return result
+}
@@ -31,7 +31,9 @@ func flattenGrantsEntity(entity *linodego.GrantedEntity) map[string]interface{}
 	return result
 }
 
-func flattenGrantsGlobal(global *linodego.GlobalUserGrants) map[string]interface{} {
+// flattenGrantsGlobal flattens the global grants of a user. add_placement_groups is being
+// removed from the API, so its configured value is kept to avoid a permanent diff.
+func flattenGrantsGlobal(global *linodego.GlobalUserGrants, addPlacementGroups bool) map[string]interface{} {
 	result := make(map[string]interface{})
 
 	result["account_access"] = global.AccountAccess
@@ -42,7 +44,7 @@ func flattenGrantsGlobal(global *linodego.GlobalUserGrants) map[string]interface
 	result["add_linodes"] = global.AddLinodes
 	result["add_longview"] = global.AddLongview
 	result["add_nodebalancers"] = global.AddNodeBalancers
-	result["add_placement_groups"] = global.AddPlacementGroups
+	result["add_placement_groups"] = addPlacementGroups
 	result["add_stackscripts"] = global.AddStackScripts
 	result["add_volumes"] = global.AddVolumes
 	result["add_vpcs"] = global.AddVPCs
@@ -76,7 +76,6 @@ func TestParseUserGrants(t *testing.T) {
 			AddLinodes:           true,
 			AddLongview:          true,
 			AddNodeBalancers:     true,
-			AddPlacementGroups:   true,
 			AddStackScripts:      true,
 			AddVolumes:           true,
 			AddVPCs:              true,
@@ -165,7 +164,8 @@ func TestParseUserGrants(t *testing.T) {
 	assert.Contains(t, dataModel.GlobalGrants.String(), "\"add_linodes\":true")
 	assert.Contains(t, dataModel.GlobalGrants.String(), "\"add_longview\":true")
 	assert.Contains(t, dataModel.GlobalGrants.String(), "\"add_nodebalancers\":true")
-	assert.Contains(t, dataModel.GlobalGrants.String(), "\"add_placement_groups\":true")
+	// add_placement_groups isn't reported by the API anymore
+	assert.Contains(t, dataModel.GlobalGrants.String(), "\"add_placement_groups\":false")
 	assert.Contains(t, dataModel.GlobalGrants.String(), "\"add_stackscripts\":true")
 	assert.Contains(t, dataModel.GlobalGrants.String(), "\"add_volumes\":true")
 	assert.Contains(t, dataModel.GlobalGrants.String(), "\"add_vpcs\":true")
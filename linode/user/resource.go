@@ -88,7 +88,8 @@ func readResource(ctx context.Context, d *schema.ResourceData, meta interface{})
 			return diag.Errorf("failed to get user grants (%s): %s", username, err)
 		}
 
-		d.Set("global_grants", []interface{}{flattenGrantsGlobal(&grants.Global)})
+		addPlacementGroups := d.Get("global_grants.0.add_placement_groups").(bool)
+		d.Set("global_grants", []interface{}{flattenGrantsGlobal(&grants.Global, addPlacementGroups)})
 
 		d.Set("domain_grant", flattenGrantsEntities(grants.Domain))
 		d.Set("firewall_grant", flattenGrantsEntities(grants.Firewall))
@@ -240,7 +241,6 @@ func expandGrantsGlobal(global map[string]interface{}) linodego.GlobalUserGrants
 	result.AddLinodes = global["add_linodes"].(bool)
 	result.AddLongview = global["add_longview"].(bool)
 	result.AddNodeBalancers = global["add_nodebalancers"].(bool)
-	result.AddPlacementGroups = global["add_placement_groups"].(bool)
 	result.AddStackScripts = global["add_stackscripts"].(bool)
 	result.AddVolumes = global["add_volumes"].(bool)
 	result.AddVPCs = global["add_vpcs"].(bool)
@@ -112,6 +112,8 @@ func resourceLinodeUserGrantsGlobal() *schema.Resource {
 				Description: "If true, this User may add Placement Groups.",
 				Optional:    true,
 				Default:     false,
+				Deprecated: "Placement groups can no longer be granted globally, " +
+					"so this attribute will be removed in the next major version.",
 			},
 			"add_stackscripts": {
 				Type:        schema.TypeBool,
@@ -8,6 +8,7 @@ import (
 
 	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
@@ -260,6 +261,50 @@ func (r *Resource) CreateVolume(
 	return volume
 }
 
+func (r *Resource) ModifyPlan(
+	ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse,
+) {
+	// Nothing to validate when the resource is being destroyed
+	// or the provider hasn't been configured yet
+	if req.Plan.Raw.IsNull() || r.Meta == nil {
+		return
+	}
+
+	var region, encryption types.String
+
+	resp.Diagnostics.Append(req.Plan.GetAttribute(ctx, path.Root("region"), &region)...)
+	resp.Diagnostics.Append(req.Plan.GetAttribute(ctx, path.Root("encryption"), &encryption)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if region.IsUnknown() || encryption.ValueString() != "enabled" {
+		return
+	}
+
+	if !req.State.Raw.IsNull() {
+		var stateRegion, stateEncryption types.String
+
+		resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root("region"), &stateRegion)...)
+		resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root("encryption"), &stateEncryption)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+
+		if region.Equal(stateRegion) && encryption.Equal(stateEncryption) {
+			return
+		}
+	}
+
+	helper.ValidateRegionCapabilities(ctx, r.Meta.Client, region.ValueString(), []helper.RegionCapabilityRequirement{
+		{
+			Capability: linodego.CapabilityBlockStorageEncryption,
+			Path:       path.Root("encryption"),
+			Reason:     `encryption = "enabled"`,
+		},
+	}, &resp.Diagnostics)
+}
+
 func (r *Resource) Create(
 	ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse,
 ) {